@@ -1,9 +1,7 @@
 package bql
 
 import (
-	"bufio"
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -45,6 +43,11 @@ type readerSource struct {
 	tsField  data.Path
 	ioParams *IOParams
 
+	formatName  string
+	format      RecordFormat
+	params      data.Map
+	compression string
+
 	// repeat is the number of times that the input data is read. When its value
 	// is less than 0, the source will read the input again and again until it's
 	// stopped. When the value is 0, the source only read the input once. When
@@ -79,28 +82,31 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 		}
 	}()
 
-	r := bufio.NewReader(f)
-	next := time.Now()
-	for lineNumber := 0; ; lineNumber++ {
-		line, err := r.ReadBytes('\n')
-		if err != nil && err != io.EOF {
-			return err
-		}
+	r, err := newDecompressReader(f, s.compression)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
 
-		line = bytes.TrimSpace(line)
-		if len(line) == 0 {
+	dec, err := s.format.NewDecoder(r, s.params)
+	if err != nil {
+		return err
+	}
+	next := time.Now()
+	for recordNumber := 0; ; recordNumber++ {
+		m, err := dec.Decode()
+		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			continue
-		}
-
-		m := data.Map{}
-		if err := json.Unmarshal(line, &m); err != nil {
-			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-				WithField("jsonl_line_number", lineNumber).
-				WithField("body", string(line)).Warning("Ignoring the line due to a json parse error")
-			continue
+			if e, ok := err.(*RecordError); ok {
+				ctx.ErrLog(e.Err).WithField("node_name", s.ioParams.Name).
+					WithField("format", s.formatName).
+					WithField("record_number", recordNumber).
+					WithField("body", e.Record).Warning("Ignoring the record due to a parse error")
+				continue
+			}
+			return err
 		}
 
 		t := core.NewTuple(m)
@@ -113,7 +119,7 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 			if v, err := t.Data.Get(s.tsField); err == nil {
 				if ts, err := data.ToTimestamp(v); err != nil {
 					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-						WithField("jsonl_line_number", lineNumber).
+						WithField("record_number", recordNumber).
 						WithField("timestamp_field", s.tsField).
 						WithField("timestamp_field_value", v).
 						Warning("Cannot convert a value in timestamp_field to a timestamp")
@@ -154,14 +160,13 @@ func (s *readerSource) Stop(ctx *core.Context) error {
 }
 
 func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
-	// TODO: add format parameter
-
 	v := &struct {
 		Path           string `bql:",required"`
 		Rewindable     bool
 		TimestampField string
 		Repeat         int64
 		Interval       time.Duration
+		Compression    string
 	}{
 		Rewindable:     false,
 		TimestampField: "",
@@ -179,14 +184,30 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
 		}
 	}
+	if err := validateCompression(v.Compression); err != nil {
+		return nil, err
+	}
+
+	formatName, format, err := lookupRecordFormatParam(params)
+	if err != nil {
+		return nil, err
+	}
+	// validate format parameters before the source starts
+	if _, err := format.NewDecoder(bytes.NewReader(nil), params); err != nil {
+		return nil, err
+	}
 
 	s := &readerSource{
-		filename: v.Path,
-		tsField:  tsField,
-		ioParams: ioParams,
-		repeat:   v.Repeat,
-		interval: v.Interval,
-		stopCh:   make(chan struct{}),
+		filename:    v.Path,
+		tsField:     tsField,
+		ioParams:    ioParams,
+		formatName:  formatName,
+		format:      format,
+		params:      params,
+		compression: v.Compression,
+		repeat:      v.Repeat,
+		interval:    v.Interval,
+		stopCh:      make(chan struct{}),
 	}
 	if v.Rewindable {
 		return core.NewRewindableSource(s), nil
@@ -201,25 +222,37 @@ func init() {
 type writerSink struct {
 	m           sync.Mutex
 	w           io.Writer
+	enc         RecordEncoder
 	shouldClose bool
 }
 
-func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
-	// TODO: support custom formatting. There're several things that need to
-	// be considered such as concurrent formatting, zero-copy write, and so on.
-	// While encoding tuples outside the lock supports concurrent formatting,
-	// it makes it difficult to support zero-copy write.
+func newWriterSink(w io.Writer, params data.Map) (*writerSink, error) {
+	_, format, err := lookupRecordFormatParam(params)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := format.NewEncoder(w, params)
+	if err != nil {
+		return nil, err
+	}
+	return &writerSink{
+		w:   w,
+		enc: enc,
+	}, nil
+}
 
-	js := t.Data.String() // Format this outside the lock
+func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
+	// TODO: support concurrent formatting. Encoders are stateful (e.g. CSV
+	// writes a header first) and write records directly to the writer, so
+	// tuples are encoded inside the lock.
 
-	// This lock is required to avoid interleaving JSONs.
+	// This lock is required to avoid interleaving records.
 	s.m.Lock()
 	defer s.m.Unlock()
 	if s.w == nil {
 		return errors.New("the sink is already closed")
 	}
-	_, err := fmt.Fprintln(s.w, js)
-	return err
+	return s.enc.Encode(t.Data)
 }
 
 func (s *writerSink) Close(ctx *core.Context) error {
@@ -237,17 +270,12 @@ func (s *writerSink) Close(ctx *core.Context) error {
 }
 
 func createStdoutSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
-	return &writerSink{
-		w: os.Stdout,
-	}, nil
+	return newWriterSink(os.Stdout, params)
 }
 
 func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	// TODO: currently this sink isn't secure because it accepts any path.
 	// TODO: support buffering
-	// TODO: provide "format" parameter to support output formats other than "jsonl".
-	//       "jsonl" should be the default value.
-	// TODO: support "compression" parameter with values like "gz".
 
 	v := &struct {
 		Path     string `bql:",required"`
@@ -256,6 +284,8 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 		MaxSize    int
 		MaxAge     int
 		MaxBackups int
+
+		Compression string
 	}{
 		Truncate: false,
 		MaxSize:  0,
@@ -264,6 +294,13 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 	if err := dec.Decode(params, v); err != nil {
 		return nil, err
 	}
+	if err := validateCompression(v.Compression); err != nil {
+		return nil, err
+	}
+	compression := v.Compression
+	if compression == compressionAuto {
+		compression = compressionFromPath(v.Path)
+	}
 
 	var w io.Writer
 	if v.MaxSize > 0 {
@@ -276,12 +313,24 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 		if v.MaxBackups > 0 {
 			l.MaxBackups = v.MaxBackups
 		}
-		if _, err := os.Stat(v.Path); err == nil && v.Truncate {
-			if err := os.Truncate(v.Path, 0); err != nil {
-				return nil, err
+		var size int64
+		if info, err := os.Stat(v.Path); err == nil {
+			if v.Truncate {
+				if err := os.Truncate(v.Path, 0); err != nil {
+					return nil, err
+				}
+			} else {
+				size = info.Size()
 			}
 		}
 		w = &l
+		if compression != compressionNone {
+			cw, err := newRotatingCompressWriter(&l, compression, int64(v.MaxSize)*1024*1024, size)
+			if err != nil {
+				return nil, err
+			}
+			w = cw
+		}
 	} else {
 		flags := os.O_WRONLY | os.O_APPEND | os.O_CREATE
 		if v.Truncate {
@@ -293,11 +342,24 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 			return nil, err
 		}
 		w = file
+		if compression != compressionNone {
+			cw, err := newCompressWriter(file, compression)
+			if err != nil {
+				file.Close()
+				return nil, err
+			}
+			w = cw
+		}
 	}
-	return &writerSink{
-		w:           w,
-		shouldClose: true,
-	}, nil
+	s, err := newWriterSink(w, params)
+	if err != nil {
+		if c, ok := w.(io.Closer); ok {
+			c.Close()
+		}
+		return nil, err
+	}
+	s.shouldClose = true
+	return s, nil
 }
 
 func init() {
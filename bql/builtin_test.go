@@ -1,6 +1,8 @@
 package bql
 
 import (
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -84,6 +86,52 @@ func TestFileSource(t *testing.T) {
 			})
 		})
 
+		Convey("When reading the file with the raw format", func() {
+			params["format"] = data.String("raw")
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			err = s.GenerateStream(ctx, w)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit all lines including the empty one", func() {
+				So(w.cnt, ShouldEqual, 4)
+			})
+		})
+
+		Convey("When reading a gzipped file with auto compression", func() {
+			gzName := name + ".gz"
+			b, err := ioutil.ReadFile(name)
+			So(err, ShouldBeNil)
+			buf := bytes.NewBuffer(nil)
+			gw := gzip.NewWriter(buf)
+			_, err = gw.Write(b)
+			So(err, ShouldBeNil)
+			So(gw.Close(), ShouldBeNil)
+			So(ioutil.WriteFile(gzName, buf.Bytes(), 0644), ShouldBeNil)
+			Reset(func() {
+				os.Remove(gzName)
+			})
+
+			params["path"] = data.String(gzName)
+			params["compression"] = data.String("auto")
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			err = s.GenerateStream(ctx, w)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit all tuples", func() {
+				So(w.cnt, ShouldEqual, 3)
+			})
+		})
+
 		Convey("When reading the file with custom timestamp field", func() {
 			params["timestamp_field"] = data.String("ts")
 			s, err := createFileSource(ctx, &IOParams{}, params)
@@ -256,6 +304,25 @@ func TestFileSource(t *testing.T) {
 				_, err := createFileSource(ctx, &IOParams{}, params)
 				So(err, ShouldNotBeNil)
 			})
+
+			Convey("Then unsupported compression should result in an error", func() {
+				params["compression"] = data.String("lzma")
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then unknown format should result in an error", func() {
+				params["format"] = data.String("no_such_format")
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then invalid format parameters should result in an error", func() {
+				params["format"] = data.String("csv")
+				params["header"] = data.False
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
 		})
 	})
 }
@@ -393,6 +460,101 @@ func TestFileSink(t *testing.T) {
 			})
 		})
 
+		Convey("When create file sink with the csv format", func() {
+			fn := filepath.Join(tdir, "file_sink.csv")
+			params := data.Map{
+				"path":    data.String(fn),
+				"format":  data.String("csv"),
+				"columns": data.Array{data.String("k"), data.String("v")},
+			}
+			si, err := createFileSink(ctx, ioParams, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				si.Close(ctx)
+			})
+			Convey("And when write tuples to the sink", func() {
+				So(si.Write(ctx, core.NewTuple(data.Map{"k": data.Int(-1), "v": data.String("a")})), ShouldBeNil)
+				So(si.Write(ctx, core.NewTuple(data.Map{"k": data.Int(2)})), ShouldBeNil)
+				Convey("Then the tuples should be written in the file with a header", func() {
+					actualByte, err := ioutil.ReadFile(fn)
+					So(err, ShouldBeNil)
+					So(string(actualByte), ShouldEqual, "k,v\n-1,a\n2,\n")
+				})
+			})
+		})
+
+		Convey("When create file sink with auto compression", func() {
+			fn := filepath.Join(tdir, "file_sink.jsonl.zst")
+			params := data.Map{
+				"path":        data.String(fn),
+				"compression": data.String("auto"),
+			}
+			si, err := createFileSink(ctx, ioParams, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				si.Close(ctx)
+			})
+			Convey("And when write tuples to the sink and close it", func() {
+				So(si.Write(ctx, core.NewTuple(data.Map{"k": data.Int(-1)})), ShouldBeNil)
+				So(si.Write(ctx, core.NewTuple(data.Map{"k": data.Int(2)})), ShouldBeNil)
+				So(si.Close(ctx), ShouldBeNil)
+				Convey("Then the tuples should be written in the file with zstd", func() {
+					actualByte, err := ioutil.ReadFile(fn)
+					So(err, ShouldBeNil)
+					s, err := decompressAll(actualByte, "zstd")
+					So(err, ShouldBeNil)
+					So(s, ShouldEqual, "{\"k\":-1}\n{\"k\":2}\n")
+				})
+			})
+		})
+
+		Convey("When create file sink with gzip compression and rotate option", func() {
+			fn := filepath.Join(tdir, "file_sink6.jsonl")
+			params := data.Map{
+				"path":        data.String(fn),
+				"compression": data.String("gz"),
+				"max_size":    data.Int(10),
+			}
+			si, err := createFileSink(ctx, ioParams, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				si.Close(ctx)
+			})
+			Convey("And when write a tuple to the sink and close it", func() {
+				So(si.Write(ctx, core.NewTuple(data.Map{"k": data.Int(-1)})), ShouldBeNil)
+				So(si.Close(ctx), ShouldBeNil)
+				Convey("Then the tuple should be written in the file with gzip", func() {
+					actualByte, err := ioutil.ReadFile(fn)
+					So(err, ShouldBeNil)
+					s, err := decompressAll(actualByte, "gz")
+					So(err, ShouldBeNil)
+					So(s, ShouldEqual, "{\"k\":-1}\n")
+				})
+			})
+		})
+
+		Convey("When create file sink with unsupported compression", func() {
+			params := data.Map{
+				"path":        data.String(filepath.Join(tdir, "file_sink.lzma")),
+				"compression": data.String("lzma"),
+			}
+			_, err := createFileSink(ctx, ioParams, params)
+			Convey("Then the sink should not be created", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When create file sink with unknown format", func() {
+			params := data.Map{
+				"path":   data.String(filepath.Join(tdir, "file_sink.unknown")),
+				"format": data.String("no_such_format"),
+			}
+			_, err := createFileSink(ctx, ioParams, params)
+			Convey("Then the sink should not be created", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
 		Convey("When create file sink with rotate option and truncated", func() {
 			fn := filepath.Join(tdir, "file_sink5.jsonl")
 			So(ioutil.WriteFile(fn, []byte(`{"k":-2}
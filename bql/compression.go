@@ -0,0 +1,203 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Supported values of "compression" parameters. An empty string means no
+// compression. "auto" detects the compression from magic bytes when reading
+// and from the file extension when writing.
+const (
+	compressionNone = ""
+	compressionGzip = "gz"
+	compressionZstd = "zstd"
+	compressionAuto = "auto"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+func validateCompression(c string) error {
+	switch c {
+	case compressionNone, compressionGzip, compressionZstd, compressionAuto:
+		return nil
+	}
+	return fmt.Errorf("unsupported compression: %v", c)
+}
+
+// detectCompression detects the compression of the data that r will read
+// from its magic bytes. r must be used to read the data afterwards.
+func detectCompression(r *bufio.Reader) string {
+	// Peek returns an error with fewer bytes when the input is short, which
+	// can be ignored here.
+	b, _ := r.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(b, gzipMagic):
+		return compressionGzip
+	case bytes.HasPrefix(b, zstdMagic):
+		return compressionZstd
+	}
+	return compressionNone
+}
+
+// compressionFromPath returns the compression implied by the extension of
+// the path.
+func compressionFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gz", ".gzip":
+		return compressionGzip
+	case ".zst", ".zstd":
+		return compressionZstd
+	}
+	return compressionNone
+}
+
+// newDecompressReader returns a reader decompressing data read from r.
+// Concatenated gzip members or zstd frames are read as one stream.
+func newDecompressReader(r io.Reader, compression string) (io.ReadCloser, error) {
+	if compression == compressionAuto {
+		br := bufio.NewReader(r)
+		compression = detectCompression(br)
+		r = br
+	}
+
+	switch compression {
+	case compressionNone:
+		return ioutil.NopCloser(r), nil
+	case compressionGzip:
+		return gzip.NewReader(r)
+	case compressionZstd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+	return nil, fmt.Errorf("unsupported compression: %v", compression)
+}
+
+// compressor is implemented by gzip.Writer and zstd.Encoder.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
+func newCompressor(w io.Writer, compression string) (compressor, error) {
+	switch compression {
+	case compressionGzip:
+		return gzip.NewWriter(w), nil
+	case compressionZstd:
+		return zstd.NewWriter(w)
+	}
+	return nil, fmt.Errorf("unsupported compression: %v", compression)
+}
+
+// countingWriter counts the number of bytes written to the underlying
+// writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// compressWriter compresses data and writes it to a file. It flushes the
+// compressed data on each Write so that records written to a sink reach
+// the file immediately.
+//
+// When the file is a lumberjack.Logger, compressWriter rotates the file by
+// itself instead of the logger, because a rotation done by the logger may
+// split a gzip member or a zstd frame into two files. Each rotated file is
+// a complete compressed stream. The size of a file can exceed maxSize by
+// the size of the last write.
+type compressWriter struct {
+	compression string
+	w           io.WriteCloser
+	counter     *countingWriter
+	c           compressor
+
+	logger  *lumberjack.Logger
+	maxSize int64
+}
+
+func newCompressWriter(w io.WriteCloser, compression string) (*compressWriter, error) {
+	counter := &countingWriter{w: w}
+	c, err := newCompressor(counter, compression)
+	if err != nil {
+		return nil, err
+	}
+	return &compressWriter{
+		compression: compression,
+		w:           w,
+		counter:     counter,
+		c:           c,
+	}, nil
+}
+
+// newRotatingCompressWriter creates a compressWriter rotating l when the
+// compressed size of the current file reaches maxSize bytes. size is the
+// current size of the file. The MaxSize of l is overwritten so that l
+// doesn't rotate the file by itself.
+func newRotatingCompressWriter(l *lumberjack.Logger, compression string, maxSize, size int64) (*compressWriter, error) {
+	l.MaxSize = math.MaxInt32
+	w, err := newCompressWriter(l, compression)
+	if err != nil {
+		return nil, err
+	}
+	w.logger = l
+	w.maxSize = maxSize
+	w.counter.n = size
+	return w, nil
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	n, err := w.c.Write(p)
+	if err != nil {
+		return n, err
+	}
+	if err := w.c.Flush(); err != nil {
+		return n, err
+	}
+
+	if w.logger == nil || w.counter.n < w.maxSize {
+		return n, nil
+	}
+	if err := w.c.Close(); err != nil {
+		return n, err
+	}
+	if err := w.logger.Rotate(); err != nil {
+		return n, err
+	}
+	w.counter.n = 0
+	c, err := newCompressor(w.counter, w.compression)
+	if err != nil {
+		return n, err
+	}
+	w.c = c
+	return n, nil
+}
+
+func (w *compressWriter) Close() error {
+	err := w.c.Close()
+	if e := w.w.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,148 @@
+package bql
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func decompressAll(b []byte, compression string) (string, error) {
+	r, err := newDecompressReader(bytes.NewReader(b), compression)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	res, err := ioutil.ReadAll(r)
+	return string(res), err
+}
+
+func TestDecompressReader(t *testing.T) {
+	Convey("Given compressed data", t, func() {
+		gzBuf := bytes.NewBuffer(nil)
+		gw := gzip.NewWriter(gzBuf)
+		_, err := gw.Write([]byte("gzip data\n"))
+		So(err, ShouldBeNil)
+		So(gw.Close(), ShouldBeNil)
+
+		zstdBuf := bytes.NewBuffer(nil)
+		zw, err := zstd.NewWriter(zstdBuf)
+		So(err, ShouldBeNil)
+		_, err = zw.Write([]byte("zstd data\n"))
+		So(err, ShouldBeNil)
+		So(zw.Close(), ShouldBeNil)
+
+		Convey("When decompressing them with the compression", func() {
+			Convey("Then the original data should be read", func() {
+				s, err := decompressAll(gzBuf.Bytes(), "gz")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "gzip data\n")
+
+				s, err = decompressAll(zstdBuf.Bytes(), "zstd")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "zstd data\n")
+			})
+		})
+
+		Convey("When decompressing them with auto", func() {
+			Convey("Then the compression should be detected", func() {
+				s, err := decompressAll(gzBuf.Bytes(), "auto")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "gzip data\n")
+
+				s, err = decompressAll(zstdBuf.Bytes(), "auto")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "zstd data\n")
+			})
+
+			Convey("Then plain data should be read as is", func() {
+				s, err := decompressAll([]byte("a"), "auto")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "a")
+
+				s, err = decompressAll(nil, "auto")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "")
+			})
+		})
+
+		Convey("When decompressing concatenated gzip members", func() {
+			b := append(append([]byte{}, gzBuf.Bytes()...), gzBuf.Bytes()...)
+			s, err := decompressAll(b, "gz")
+
+			Convey("Then they should be read as one stream", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "gzip data\ngzip data\n")
+			})
+		})
+
+		Convey("When decompressing data with a wrong compression", func() {
+			_, err := decompressAll(zstdBuf.Bytes(), "gz")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given an unsupported compression", t, func() {
+		Convey("When validating it", func() {
+			err := validateCompression("lzma")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestRotatingCompressWriter(t *testing.T) {
+	Convey("Given a rotating compress writer", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_compress_writer")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+		fn := filepath.Join(tdir, "out.jsonl.gz")
+		l := &lumberjack.Logger{
+			Filename: fn,
+			MaxSize:  1,
+		}
+		w, err := newRotatingCompressWriter(l, "gz", 100, 0)
+		So(err, ShouldBeNil)
+
+		Convey("When writing data exceeding the max size", func() {
+			line := strings.Repeat("a", 20) + strings.Repeat("b", 20) + "\n"
+			for i := 0; i < 10; i++ {
+				_, err := w.Write([]byte(line))
+				So(err, ShouldBeNil)
+			}
+			So(w.Close(), ShouldBeNil)
+
+			Convey("Then the file should be rotated", func() {
+				fs, err := ioutil.ReadDir(tdir)
+				So(err, ShouldBeNil)
+				So(len(fs), ShouldBeGreaterThan, 1)
+
+				Convey("And each file should be a complete gzip stream", func() {
+					// Backups rotated within the same millisecond have the
+					// same name, so only the content of each file is checked.
+					for _, f := range fs {
+						b, err := ioutil.ReadFile(filepath.Join(tdir, f.Name()))
+						So(err, ShouldBeNil)
+						s, err := decompressAll(b, "gz")
+						So(err, ShouldBeNil)
+						So(strings.Replace(s, line, "", -1), ShouldBeEmpty)
+					}
+				})
+			})
+		})
+	})
+}
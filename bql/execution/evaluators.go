@@ -206,6 +206,20 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return nil, err
 		}
 		return newTypeCast(expr, obj.Target)
+	case tryAST:
+		// recurse
+		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		var fallback Evaluator
+		if obj.Fallback != nil {
+			fallback, err = ExpressionToEvaluator(obj.Fallback, reg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &try{expr, fallback}, nil
 	case funcAppSelectorAST:
 		// recurse
 		expr, err := ExpressionToEvaluator(obj.Expr, reg)
@@ -235,6 +249,8 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		return FuncApp(fName, f, reg.Context(), evals), nil
 	case aggregateInputSorter:
 		return newSortedInputAggFuncApp(obj.funcAppAST, obj.ID, obj.Ordering, reg)
+	case aggregateInputFilter:
+		return newFilteredInputAggFuncApp(obj, reg)
 	case arrayAST:
 		// compute child Evaluators
 		evals := make([]Evaluator, len(obj.Expressions))
@@ -405,6 +421,24 @@ func (t *typeCast) Eval(input data.Value) (data.Value, error) {
 	return t.converter(val)
 }
 
+// try evaluates the fallback Evaluator, or returns NULL if it is nil,
+// when the evaluation of the underlying Evaluator fails.
+type try struct {
+	underlying Evaluator
+	fallback   Evaluator
+}
+
+func (t *try) Eval(input data.Value) (data.Value, error) {
+	val, err := t.underlying.Eval(input)
+	if err == nil {
+		return val, nil
+	}
+	if t.fallback == nil {
+		return data.Null{}, nil
+	}
+	return t.fallback.Eval(input)
+}
+
 func newTypeCast(e Evaluator, t parser.Type) (Evaluator, error) {
 	switch t {
 	case parser.Bool:
@@ -1157,6 +1191,120 @@ func newSortedInputAggFuncApp(obj funcAppAST, id string, ordering []sortExpressi
 	return &sortedInputAggFuncApp{backendFun, inOutKeys, sortEvals}, nil
 }
 
+/// Aggregate Function with Filtered Input
+
+type filteredInputAggFuncApp struct {
+	f         Evaluator
+	inOutKeys map[string]string
+	filter    Evaluator
+}
+
+func (s *filteredInputAggFuncApp) Eval(input data.Value) (data.Value, error) {
+	inputMap, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	// extract the array that contains the filter condition for each row
+	val, err := s.filter.Eval(input)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data for filtering: %s", err.Error())
+	}
+	conds, err := data.AsArray(val)
+	if err != nil {
+		return nil, err
+	}
+	// a NULL value is definitely not "true", so as in the WHERE
+	// clause we drop rows where the condition evaluates to NULL
+	keep := make([]bool, len(conds))
+	for i, cond := range conds {
+		if cond.Type() == data.TypeNull {
+			continue
+		}
+		keep[i], err = data.AsBool(cond)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// write a filtered copy of the data that the function uses
+	for unfilteredKey, filteredKey := range s.inOutKeys {
+		unfilteredData, ok := inputMap[unfilteredKey]
+		if !ok {
+			return nil, fmt.Errorf("there was no unfiltered data with key '%s'", unfilteredKey)
+		}
+		unfilteredArr, err := data.AsArray(unfilteredData)
+		if err != nil {
+			return nil, err
+		}
+		if len(unfilteredArr) != len(keep) {
+			return nil, fmt.Errorf("aggregate data with key '%s' had bad length (%d, not %d)",
+				unfilteredKey, len(unfilteredArr), len(keep))
+		}
+		filteredArr := make(data.Array, 0, len(unfilteredArr))
+		for i, v := range unfilteredArr {
+			if keep[i] {
+				filteredArr = append(filteredArr, v)
+			}
+		}
+		inputMap[filteredKey] = filteredArr
+	}
+
+	return s.f.Eval(input)
+}
+
+func newFilteredInputAggFuncApp(obj aggregateInputFilter, reg udf.FunctionRegistry) (Evaluator, error) {
+	// Similar to the sorted input case above, we add a filtered
+	// version of every aggregated array used by the function, suffixed
+	// with a string that identifies the filter, and change the
+	// references in the wrapped function call to use those. Any
+	// ORDER BY arrays must be filtered as well so that they still
+	// have the same length as the data they are used to sort.
+	filter, err := ExpressionToEvaluator(obj.Filter, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	inOutKeys := map[string]string{}
+	rename := func(exprs []FlatExpression) []FlatExpression {
+		newExprs := make([]FlatExpression, len(exprs))
+		for i, ast := range exprs {
+			if inputRef, ok := ast.(aggInputRef); ok {
+				newRef := inputRef.Ref + "_" + obj.ID
+				ast = aggInputRef{newRef}
+				inOutKeys[inputRef.Ref] = newRef
+			}
+			newExprs[i] = ast
+		}
+		return newExprs
+	}
+
+	var inner FlatExpression
+	switch e := obj.Expr.(type) {
+	case funcAppAST:
+		inner = funcAppAST{e.Function, rename(e.Expressions)}
+	case aggregateInputSorter:
+		ordering := make([]sortExpression, len(e.Ordering))
+		for i, sortExpr := range e.Ordering {
+			newRef := sortExpr.Value.Ref + "_" + obj.ID
+			inOutKeys[sortExpr.Value.Ref] = newRef
+			ordering[i] = sortExpression{aggInputRef{newRef}, sortExpr.Ascending}
+		}
+		inner = aggregateInputSorter{
+			funcAppAST{e.Function, rename(e.Expressions)},
+			ordering,
+			e.ID,
+		}
+	default:
+		return nil, fmt.Errorf("cannot use FILTER with %s", obj.Expr.Repr())
+	}
+	f, err := ExpressionToEvaluator(inner, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filteredInputAggFuncApp{f, inOutKeys, filter}, nil
+}
+
 /// JSON-like data structures
 
 type arrayBuilder struct {
@@ -131,28 +131,32 @@ func TestFoldableExecution(t *testing.T) {
 			false, nil},
 		{parser.TypeCastAST{parser.NumericLiteral{7}, parser.Float},
 			true, data.Float(7.0)},
+		{parser.TryAST{parser.TypeCastAST{parser.RowValue{"", "a"}, parser.Int}, nil},
+			false, nil},
+		{parser.TryAST{parser.TypeCastAST{parser.StringLiteral{"a"}, parser.Int}, parser.NumericLiteral{-1}},
+			true, data.Int(-1)},
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil, nil},
 			true, data.Int(8)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.ArrayAST{parser.ExpressionsAST{
 						[]parser.Expression{parser.NumericLiteral{1}}}},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{"[0]"}},
 			true, data.Int(1)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.MapAST{[]parser.KeyValuePairAST{{"a", parser.StringLiteral{"value"}}}},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{".a"}},
 			true, data.String("value")},
 		{parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}},
@@ -244,7 +248,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("plusone"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, nil}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -261,7 +265,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, nil},
 				parser.Raw{".a"}}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
@@ -277,7 +281,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("fun"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -291,7 +295,7 @@ func TestFuncAppConversion(t *testing.T) {
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
 				}},
-				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}}
+				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -308,7 +312,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, nil},
 				parser.Raw{"[0"}}
 
 			Convey("Then converting to an Evaluator should fail", func() {
@@ -322,7 +326,7 @@ func TestFuncAppConversion(t *testing.T) {
 
 		Convey("When the now() function is used", func() {
 			ast := parser.FuncAppAST{parser.FuncName("now"),
-				parser.ExpressionsAST{[]parser.Expression{}}, nil}
+				parser.ExpressionsAST{[]parser.Expression{}}, nil, nil}
 
 			Convey("Then we obtain an evaluatable timestampCast", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -527,6 +531,101 @@ func TestAggFuncAppConversion(t *testing.T) {
 					data.Array{data.Int(2), data.Int(1)}},
 			},
 		},
+
+		// filter the aggregate input
+		{"count(a) FILTER (WHERE b > 0) FROM x [RANGE 1 TUPLES]", "",
+			aggregateInputFilter{
+				funcAppAST{"count", []FlatExpression{aggInputRef{"g_f12cd6bc"}}},
+				aggInputRef{"g_e8e5257b"},
+				"d895bccb",
+			},
+			map[string]FlatExpression{
+				"g_f12cd6bc": rowValue{"x", "a"},
+				"g_e8e5257b": binaryOpAST{parser.Greater, rowValue{"x", "b"}, numericLiteral{0}},
+			},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// map does not contain all correct keys
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2)}}, nil},
+				{data.Map{"g_e8e5257b": data.Array{data.True, data.False}}, nil},
+				// map does not contain an array at that position
+				{data.Map{"g_f12cd6bc": data.Int(17),
+					"g_e8e5257b": data.Array{data.True, data.False}}, nil},
+				// arrays have different lengths
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2)},
+					"g_e8e5257b": data.Array{data.True}}, nil},
+				// condition is not a bool
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2)},
+					"g_e8e5257b": data.Array{data.True, data.Int(1)}}, nil},
+				// correct input
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2), data.Int(3)},
+					"g_e8e5257b": data.Array{data.True, data.False, data.True}},
+					data.Int(2)},
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2), data.Int(3)},
+					"g_e8e5257b": data.Array{data.Null{}, data.False, data.True}},
+					data.Int(1)},
+				{data.Map{"g_f12cd6bc": data.Array{},
+					"g_e8e5257b": data.Array{}},
+					data.Int(0)},
+			},
+		},
+
+		// suppress errors of an aggregate function
+		{"TRY(sum(a), 0) FROM x [RANGE 1 TUPLES]", "",
+			tryAST{
+				funcAppAST{"sum", []FlatExpression{aggInputRef{"g_f12cd6bc"}}},
+				numericLiteral{0},
+			},
+			map[string]FlatExpression{
+				"g_f12cd6bc": rowValue{"x", "a"},
+			},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), data.Int(0)},
+				// map does not contain an array at that position
+				{data.Map{"g_f12cd6bc": data.Int(17)}, data.Int(0)},
+				// correct input
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2)}}, data.Int(3)},
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.String("a")}}, data.Int(0)},
+			},
+		},
+
+		// filter and order the aggregate input
+		{"array_agg(a ORDER BY b DESC) FILTER (WHERE b > 0) FROM x [RANGE 1 TUPLES]", "",
+			aggregateInputFilter{
+				aggregateInputSorter{
+					funcAppAST{"array_agg", []FlatExpression{aggInputRef{"g_f12cd6bc"}}},
+					[]sortExpression{sortExpression{aggInputRef{"g_77d2dd39"}, false}},
+					"d7196f56",
+				},
+				aggInputRef{"g_e8e5257b"},
+				"d895bccb",
+			},
+			map[string]FlatExpression{
+				"g_f12cd6bc": rowValue{"x", "a"},
+				"g_77d2dd39": rowValue{"x", "b"},
+				"g_e8e5257b": binaryOpAST{parser.Greater, rowValue{"x", "b"}, numericLiteral{0}},
+			},
+			[]evalTest{
+				// map does not contain all correct keys
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2)},
+					"g_e8e5257b": data.Array{data.True, data.False}}, nil},
+				// correct input
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+					"g_77d2dd39": data.Array{data.Int(5), data.Int(-1), data.Int(7), data.Int(6)},
+					"g_e8e5257b": data.Array{data.True, data.False, data.True, data.True}},
+					data.Array{data.Int(3), data.Int(4), data.Int(1)}},
+			},
+		},
+
+		// FILTER cannot contain aggregates
+		{"count(a) FILTER (WHERE count(b) > 0) FROM x [RANGE 1 TUPLES]",
+			"aggregate functions cannot be used in FILTER", nil, nil, nil},
+
+		// FILTER cannot be used with non-aggregates
+		{"f(a) FILTER (WHERE b > 0) FROM x [RANGE 1 TUPLES]",
+			"you cannot use FILTER in non-aggregate function 'f'", nil, nil, nil},
 	}
 
 	for _, testCase := range testCases {
@@ -1749,9 +1848,38 @@ func getTestCases() []struct {
 				{data.Map{"a": data.Null{}}, data.Null{}},
 			},
 		},
+		// Try
+		{parser.TryAST{parser.TypeCastAST{parser.RowValue{"", "a"}, parser.Int}, nil},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), data.Null{}},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, data.Null{}},
+				// key present and convertable => ok
+				{data.Map{"a": data.Float(3.14)}, data.Int(3)},
+				{data.Map{"a": data.String("17")}, data.Int(17)},
+				// null propagation
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				// key present and other data type => null
+				{data.Map{"a": data.String("日本語")}, data.Null{}},
+				{data.Map{"a": data.Array{data.Int(2)}}, data.Null{}},
+			},
+		},
+		{parser.TryAST{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.NumericLiteral{1}},
+			parser.RowValue{"", "b"}},
+			[]evalTest{
+				// expression succeeds => result
+				{data.Map{"a": data.Int(1), "b": data.Int(0)}, data.Int(2)},
+				// expression fails => fallback
+				{data.Map{"a": data.String("x"), "b": data.Int(0)}, data.Int(0)},
+				{data.Map{"b": data.String("none")}, data.String("none")},
+				// fallback fails => error
+				{data.Map{"a": data.String("x")}, nil},
+			},
+		},
 		/// Function Application
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, nil},
 			// NB. This only tests the behavior of funcApp.Eval.
 			// It does *not* test the function registry, mismatch
 			// in parameter counts or any particular function.
@@ -1771,7 +1899,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{".key"}},
 			[]evalTest{
 				// function return selected value
@@ -1787,7 +1915,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{"[1]"}},
 			[]evalTest{
 				// function return selected value
@@ -1896,7 +2024,7 @@ func getTestCases() []struct {
 		// Using now() should find the timestamp at the
 		// correct position
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1976,7 +2104,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1993,7 +2121,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
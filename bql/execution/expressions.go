@@ -147,6 +147,20 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			return nil, err
 		}
 		return typeCastAST{expr, obj.Target}, nil
+	case parser.TryAST:
+		// recurse
+		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		var fallback FlatExpression
+		if obj.Fallback != nil {
+			fallback, err = ParserExprToFlatExpr(obj.Fallback, reg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return tryAST{expr, fallback}, nil
 	case parser.FuncAppSelectorAST:
 		// recurse
 		expr, err := ParserExprToFlatExpr(obj.FuncAppAST, reg)
@@ -176,6 +190,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			err := fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
 				"function '%s'", obj.Function)
 			return nil, err
+		} else if obj.Filter != nil {
+			err := fmt.Errorf("you cannot use FILTER in non-aggregate "+
+				"function '%s'", obj.Function)
+			return nil, err
 		}
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -317,6 +335,27 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			return nil, nil, err
 		}
 		return typeCastAST{expr, obj.Target}, agg, nil
+	case parser.TryAST:
+		// recurse
+		expr, agg, err := ParserExprToMaybeAggregate(obj.Expr, aggIdx, reg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if obj.Fallback == nil {
+			return tryAST{expr, nil}, agg, nil
+		}
+		fallback, fallbackAgg, err := ParserExprToMaybeAggregate(obj.Fallback, aggIdx+len(agg), reg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if agg == nil {
+			agg = fallbackAgg
+		} else {
+			for key, val := range fallbackAgg {
+				agg[key] = val
+			}
+		}
+		return tryAST{expr, fallback}, agg, nil
 	case parser.FuncAppSelectorAST:
 		// recurse
 		expr, agg, err := ParserExprToMaybeAggregate(obj.FuncAppAST, aggIdx, reg)
@@ -349,6 +388,7 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
 		returnAgg := map[string]FlatExpression{}
+		var aggFun FlatExpression
 		if isAggregateFunc(function, len(obj.Expressions)) {
 			// we have a setting like
 			//  SELECT udaf(x+1, "state", c ORDER BY d + e, f DESC) ... GROUP BY c
@@ -434,14 +474,53 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 					}
 					returnAgg[exprID] = expr
 				}
-				return aggregateInputSorter{
+				aggFun = aggregateInputSorter{
 					funcAppAST{obj.Function, exprs},
 					ordering,
 					hex.EncodeToString(orderHash.Sum(nil))[:8],
-				}, returnAgg, nil
+				}
+			} else {
+				aggFun = funcAppAST{obj.Function, exprs}
 			}
 
+			// deal with a FILTER clause
+			if obj.Filter != nil {
+				// this expression must be flat, there must not be other aggregates
+				expr, err := ParserExprToFlatExpr(obj.Filter, reg)
+				if err != nil {
+					// return a prettier error message
+					if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+						err = fmt.Errorf("aggregate functions cannot be used in FILTER")
+					}
+					return nil, nil, err
+				}
+				// we will replace this value by a reference to the
+				// aggregated list of values (see above for the naming)
+				h := sha1.New()
+				h.Write([]byte(fmt.Sprintf("%s", expr.Repr())))
+				exprID := "g_" + hex.EncodeToString(h.Sum(nil))[:8]
+				if expr.Volatility() == Volatile {
+					exprID += fmt.Sprintf("_%d", aggIdx+len(returnAgg))
+				}
+				returnAgg[exprID] = expr
+				// the filtered arrays are stored using a suffix that
+				// depends on the filter so that we can use
+				// `SELECT f(a) FILTER (WHERE b), f(a) FILTER (WHERE c)`
+				filterHash := sha1.New()
+				filterHash.Write([]byte(exprID))
+				aggFun = aggregateInputFilter{
+					aggFun,
+					aggInputRef{exprID},
+					hex.EncodeToString(filterHash.Sum(nil))[:8],
+				}
+			}
+			return aggFun, returnAgg, nil
 		} else {
+			if obj.Filter != nil {
+				err := fmt.Errorf("you cannot use FILTER in non-aggregate "+
+					"function '%s'", obj.Function)
+				return nil, nil, err
+			}
 			for i, ast := range obj.Expressions {
 				expr, agg, err := ParserExprToMaybeAggregate(ast, aggIdx, reg)
 				if err != nil {
@@ -699,6 +778,39 @@ func (t typeCastAST) ContainsWildcard() bool {
 	return t.Expr.ContainsWildcard()
 }
 
+type tryAST struct {
+	Expr     FlatExpression
+	Fallback FlatExpression
+}
+
+func (t tryAST) Repr() string {
+	if t.Fallback != nil {
+		return fmt.Sprintf("TRY(%s,%s)", t.Expr.Repr(), t.Fallback.Repr())
+	}
+	return fmt.Sprintf("TRY(%s)", t.Expr.Repr())
+}
+
+func (t tryAST) Columns() []rowValue {
+	if t.Fallback != nil {
+		return append(t.Expr.Columns(), t.Fallback.Columns()...)
+	}
+	return t.Expr.Columns()
+}
+
+func (t tryAST) Volatility() VolatilityType {
+	v := t.Expr.Volatility()
+	if t.Fallback != nil {
+		if f := t.Fallback.Volatility(); f < v {
+			return f
+		}
+	}
+	return v
+}
+
+func (t tryAST) ContainsWildcard() bool {
+	return t.Expr.ContainsWildcard() || (t.Fallback != nil && t.Fallback.ContainsWildcard())
+}
+
 type funcAppAST struct {
 	Function    parser.FuncName
 	Expressions []FlatExpression
@@ -786,6 +898,31 @@ func (a aggregateInputSorter) Repr() string {
 		strings.Join(reprs, ","), strings.Join(ordering, ","))
 }
 
+// aggregateInputFilter wraps an aggregate function call (which may
+// be a plain funcAppAST or an aggregateInputSorter) and restricts the
+// aggregated values to those where Filter evaluated to true.
+type aggregateInputFilter struct {
+	Expr   FlatExpression
+	Filter aggInputRef
+	ID     string
+}
+
+func (a aggregateInputFilter) Repr() string {
+	return fmt.Sprintf("%s FILTER (WHERE %s)", a.Expr.Repr(), a.Filter.Repr())
+}
+
+func (a aggregateInputFilter) Columns() []rowValue {
+	return a.Expr.Columns()
+}
+
+func (a aggregateInputFilter) Volatility() VolatilityType {
+	return a.Expr.Volatility()
+}
+
+func (a aggregateInputFilter) ContainsWildcard() bool {
+	return a.Expr.ContainsWildcard()
+}
+
 type arrayAST struct {
 	Expressions []FlatExpression
 }
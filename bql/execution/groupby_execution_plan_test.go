@@ -906,6 +906,74 @@ func TestAggregateFunctions(t *testing.T) {
 		})
 	})
 
+	Convey("Given a SELECT clause with aggregates and FILTER", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM
+			array_agg(int ORDER BY bar DESC) FILTER (WHERE int % 2 = 0) AS result,
+			count(*) FILTER (WHERE int > 2) AS c
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					if idx == 0 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Null{},
+							"c": data.Int(0)})
+					} else if idx == 1 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Int(2)}, "c": data.Int(0)})
+					} else if idx == 2 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Int(2)}, "c": data.Int(1)})
+					} else if idx == 3 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Int(4), data.Int(2)}, "c": data.Int(2)})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with percentile_cont and ORDER BY", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM
+			percentile_cont(int, [0.5, 1.0] ORDER BY int DESC) AS result
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					if idx == 0 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Float(1), data.Float(1)}})
+					} else if idx == 1 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Float(1.5), data.Float(1)}})
+					} else if idx == 3 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Float(3), data.Float(2)}})
+					}
+				})
+			}
+		})
+	})
+
 	Convey("Given a SELECT clause with array_agg and wildcard", t, func() {
 		tuples := getExtTuples()
 
@@ -121,7 +121,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, ""},
@@ -179,7 +179,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -187,7 +187,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{tA}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
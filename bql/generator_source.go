@@ -0,0 +1,419 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// fieldGenerator generates values of a field of tuples emitted from the
+// generator source. Its exported fields are parameters decoded by
+// data.Decoder.
+type fieldGenerator interface {
+	// start returns a function returning the value of the next tuple. It's
+	// called when the source starts or is rewound, and each function has
+	// its own state. now is the time when it's called.
+	start(now time.Time) func(r *rand.Rand) data.Value
+
+	// validate validates parameters.
+	validate() error
+}
+
+// sequenceGenerator generates start, start+step, start+2*step, and so on.
+// Values are ints when both start and step are ints, and floats otherwise.
+type sequenceGenerator struct {
+	Start data.Value
+	Step  data.Value
+}
+
+func (g *sequenceGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	i := int64(0)
+	if g.Start.Type() == data.TypeInt && g.Step.Type() == data.TypeInt {
+		start, _ := data.AsInt(g.Start)
+		step, _ := data.AsInt(g.Step)
+		return func(r *rand.Rand) data.Value {
+			v := start + i*step
+			i++
+			return data.Int(v)
+		}
+	}
+	start, _ := data.ToFloat(g.Start)
+	step, _ := data.ToFloat(g.Step)
+	return func(r *rand.Rand) data.Value {
+		v := start + float64(i)*step
+		i++
+		return data.Float(v)
+	}
+}
+
+func (g *sequenceGenerator) validate() error {
+	for _, v := range []data.Value{g.Start, g.Step} {
+		if t := v.Type(); t != data.TypeInt && t != data.TypeFloat {
+			return errors.New("'start' and 'step' must be numbers")
+		}
+	}
+	return nil
+}
+
+// uniformGenerator generates random values uniformly distributed in
+// [min, max). When Integer is true, it generates ints in [min, max].
+type uniformGenerator struct {
+	Min     float64
+	Max     float64
+	Integer bool
+}
+
+func (g *uniformGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	if g.Integer {
+		min, max := int64(math.Ceil(g.Min)), int64(math.Floor(g.Max))
+		return func(r *rand.Rand) data.Value {
+			return data.Int(min + r.Int63n(max-min+1))
+		}
+	}
+	return func(r *rand.Rand) data.Value {
+		return data.Float(g.Min + r.Float64()*(g.Max-g.Min))
+	}
+}
+
+func (g *uniformGenerator) validate() error {
+	if g.Min > g.Max {
+		return fmt.Errorf("'min' must not be greater than 'max': %v > %v", g.Min, g.Max)
+	}
+	if g.Integer && math.Ceil(g.Min) > math.Floor(g.Max) {
+		return fmt.Errorf("there's no integer between 'min' and 'max': %v, %v", g.Min, g.Max)
+	}
+	return nil
+}
+
+// normalGenerator generates random values from a normal distribution.
+type normalGenerator struct {
+	Mean   float64
+	Stddev float64
+}
+
+func (g *normalGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	return func(r *rand.Rand) data.Value {
+		return data.Float(g.Mean + r.NormFloat64()*g.Stddev)
+	}
+}
+
+func (g *normalGenerator) validate() error {
+	if g.Stddev < 0 {
+		return fmt.Errorf("'stddev' must not be negative: %v", g.Stddev)
+	}
+	return nil
+}
+
+// choiceGenerator chooses a value from Values at random. When Weights are
+// given, each value is chosen with the probability proportional to its
+// weight.
+type choiceGenerator struct {
+	Values  []data.Value `bql:",required"`
+	Weights []float64
+}
+
+func (g *choiceGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	if g.Weights == nil {
+		return func(r *rand.Rand) data.Value {
+			return g.Values[r.Intn(len(g.Values))]
+		}
+	}
+
+	cum := make([]float64, len(g.Weights))
+	sum := 0.0
+	for i, w := range g.Weights {
+		sum += w
+		cum[i] = sum
+	}
+	return func(r *rand.Rand) data.Value {
+		x := r.Float64() * sum
+		// The value at i has the range [cum[i-1], cum[i]).
+		i := sort.Search(len(cum), func(i int) bool { return cum[i] > x })
+		if i == len(cum) { // just in case of rounding errors
+			i--
+		}
+		return g.Values[i]
+	}
+}
+
+func (g *choiceGenerator) validate() error {
+	if len(g.Values) == 0 {
+		return errors.New("'values' must not be empty")
+	}
+	if g.Weights == nil {
+		return nil
+	}
+	if len(g.Weights) != len(g.Values) {
+		return errors.New("'weights' must have the same number of elements as 'values'")
+	}
+	sum := 0.0
+	for _, w := range g.Weights {
+		if w < 0 {
+			return fmt.Errorf("'weights' must not have a negative value: %v", w)
+		}
+		sum += w
+	}
+	if sum <= 0 {
+		return errors.New("'weights' must have a positive value")
+	}
+	return nil
+}
+
+// timestampGenerator generates timestamps advancing by Interval from Start.
+// Each timestamp is shifted by a random duration in [-Jitter, Jitter]. When
+// Start isn't given, the time when the source starts is used.
+type timestampGenerator struct {
+	Start    time.Time
+	Interval time.Duration
+	Jitter   time.Duration
+}
+
+func (g *timestampGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	base := g.Start
+	if base.IsZero() {
+		base = now
+	}
+	i := int64(0)
+	return func(r *rand.Rand) data.Value {
+		t := base.Add(time.Duration(i) * g.Interval)
+		i++
+		if g.Jitter > 0 {
+			t = t.Add(time.Duration((r.Float64()*2 - 1) * float64(g.Jitter)))
+		}
+		return data.Timestamp(t)
+	}
+}
+
+func (g *timestampGenerator) validate() error {
+	if g.Interval < 0 || g.Jitter < 0 {
+		return errors.New("'interval' and 'jitter' must not be negative")
+	}
+	return nil
+}
+
+// randomWalkGenerator generates a random walk starting from Start. Each
+// value moves from the previous one by a random amount in [-Step, Step],
+// and it's clamped to [Min, Max] when they're given.
+type randomWalkGenerator struct {
+	Start float64
+	Step  float64
+	Min   *float64
+	Max   *float64
+}
+
+func (g *randomWalkGenerator) start(now time.Time) func(r *rand.Rand) data.Value {
+	cur := g.Start
+	started := false
+	return func(r *rand.Rand) data.Value {
+		if !started {
+			started = true
+			return data.Float(cur)
+		}
+		cur += (r.Float64()*2 - 1) * g.Step
+		if g.Min != nil && cur < *g.Min {
+			cur = *g.Min
+		}
+		if g.Max != nil && cur > *g.Max {
+			cur = *g.Max
+		}
+		return data.Float(cur)
+	}
+}
+
+func (g *randomWalkGenerator) validate() error {
+	if g.Step < 0 {
+		return fmt.Errorf("'step' must not be negative: %v", g.Step)
+	}
+	if g.Min != nil && g.Max != nil && *g.Min > *g.Max {
+		return fmt.Errorf("'min' must not be greater than 'max': %v > %v", *g.Min, *g.Max)
+	}
+	return nil
+}
+
+// newFieldGenerator creates a fieldGenerator from its parameters. The type
+// of the generator is specified by the "type" parameter.
+func newFieldGenerator(params data.Map) (fieldGenerator, error) {
+	v, ok := params["type"]
+	if !ok {
+		return nil, errors.New("'type' is required")
+	}
+	typ, err := data.AsString(v)
+	if err != nil {
+		return nil, fmt.Errorf("'type' must be a string: %v", err)
+	}
+
+	var g fieldGenerator
+	switch typ {
+	case "sequence":
+		g = &sequenceGenerator{Start: data.Int(0), Step: data.Int(1)}
+	case "uniform":
+		g = &uniformGenerator{Min: 0, Max: 1}
+	case "normal":
+		g = &normalGenerator{Mean: 0, Stddev: 1}
+	case "choice":
+		g = &choiceGenerator{}
+	case "timestamp":
+		g = &timestampGenerator{Interval: time.Second}
+	case "random_walk":
+		g = &randomWalkGenerator{Start: 0, Step: 1}
+	default:
+		return nil, fmt.Errorf("unsupported type: %v", typ)
+	}
+	if err := data.NewDecoder(nil).Decode(params, g); err != nil {
+		return nil, err
+	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+type generatorField struct {
+	name string
+	gen  fieldGenerator
+}
+
+// generatorSource emits tuples whose fields are generated by
+// fieldGenerators. Random values are generated from seed, so the source
+// emits the same tuples every time it starts or is rewound, except for
+// timestamps relative to the current time.
+type generatorSource struct {
+	// fields are sorted by their names so that random values are always
+	// generated in the same order.
+	fields   []*generatorField
+	rate     float64
+	count    int64
+	seed     int64
+	tsField  data.Path
+	ioParams *IOParams
+	stopCh   chan struct{}
+}
+
+func (s *generatorSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	r := rand.New(rand.NewSource(s.seed))
+	start := time.Now()
+	gens := make([]func(r *rand.Rand) data.Value, len(s.fields))
+	for i, f := range s.fields {
+		gens[i] = f.gen.start(start)
+	}
+
+	var interval time.Duration
+	if s.rate > 0 {
+		interval = time.Duration(float64(time.Second) / s.rate)
+	}
+	for i := int64(0); s.count <= 0 || i < s.count; i++ {
+		if interval > 0 {
+			// The schedule is based on the start time so that the rate is
+			// kept accurately even when each interval is very short.
+			next := start.Add(time.Duration(i) * interval)
+			now := time.Now()
+			if d := next.Sub(now); d > 0 {
+				select {
+				case <-s.stopCh:
+					// This works as long as createGeneratorSource returns
+					// a source wrapped with core.NewRewindableSource.
+					return core.ErrSourceStopped
+				case <-time.After(d):
+				}
+			} else if -d > time.Second {
+				// delayed too much (e.g. the source has been paused) and
+				// should be rescheduled.
+				start = now.Add(-time.Duration(i) * interval)
+			}
+		}
+
+		m := make(data.Map, len(s.fields))
+		for j, f := range s.fields {
+			m[f.name] = gens[j](r)
+		}
+		t := core.NewTuple(m)
+		if s.tsField != nil {
+			if v, err := t.Data.Get(s.tsField); err == nil {
+				if ts, err := data.ToTimestamp(v); err != nil {
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						WithField("timestamp_field", s.tsField).
+						WithField("timestamp_field_value", v).
+						Warning("Cannot convert a value in timestamp_field to a timestamp")
+				} else {
+					t.Timestamp = ts
+				}
+			}
+		}
+		if err := w.Write(ctx, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *generatorSource) Stop(ctx *core.Context) error {
+	close(s.stopCh)
+	return nil
+}
+
+func createGeneratorSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		Fields         map[string]data.Map `bql:",required"`
+		Rate           float64
+		Count          int64
+		Seed           int64
+		TimestampField string
+	}{}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	if len(v.Fields) == 0 {
+		return nil, errors.New("'fields' parameter must not be empty")
+	}
+	fields := make([]*generatorField, 0, len(v.Fields))
+	for name, p := range v.Fields {
+		g, err := newFieldGenerator(p)
+		if err != nil {
+			return nil, fmt.Errorf("field '%v' has an invalid generator: %v", name, err)
+		}
+		fields = append(fields, &generatorField{name: name, gen: g})
+	}
+	sort.Sort(generatorFieldsByName(fields))
+
+	if v.Rate < 0 {
+		return nil, fmt.Errorf("'rate' parameter must not be negative: %v", v.Rate)
+	}
+	if v.Count < 0 {
+		return nil, fmt.Errorf("'count' parameter must not be negative: %v", v.Count)
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		var err error
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+
+	return core.NewRewindableSource(&generatorSource{
+		fields:   fields,
+		rate:     v.Rate,
+		count:    v.Count,
+		seed:     v.Seed,
+		tsField:  tsField,
+		ioParams: ioParams,
+		stopCh:   make(chan struct{}),
+	}), nil
+}
+
+type generatorFieldsByName []*generatorField
+
+func (f generatorFieldsByName) Len() int           { return len(f) }
+func (f generatorFieldsByName) Less(i, j int) bool { return f[i].name < f[j].name }
+func (f generatorFieldsByName) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+func init() {
+	MustRegisterGlobalSourceCreator("generator", SourceCreatorFunc(createGeneratorSource))
+}
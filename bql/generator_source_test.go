@@ -0,0 +1,196 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// runGeneratorSource returns tuples emitted by a generator source created
+// with the parameters. The source must have the count parameter.
+func runGeneratorSource(ctx *core.Context, params data.Map, n int) []*core.Tuple {
+	s, err := createGeneratorSource(ctx, &IOParams{}, params)
+	So(err, ShouldBeNil)
+	defer s.Stop(ctx)
+	c := &tupleCollector{}
+	go s.GenerateStream(ctx, c)
+	So(c.ints(n), ShouldHaveLength, n)
+	return c.ts
+}
+
+func TestGeneratorSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given generator source parameters", t, func() {
+		params := data.Map{
+			"count": data.Int(5),
+			"fields": data.Map{
+				"i": data.Map{"type": data.String("sequence"), "start": data.Int(1)},
+			},
+		}
+		fields := params["fields"].(data.Map)
+		values := func(ts []*core.Tuple, field string) []data.Value {
+			res := []data.Value{}
+			for _, t := range ts {
+				res = append(res, t.Data[field])
+			}
+			return res
+		}
+
+		Convey("When generating a sequence", func() {
+			fields["f"] = data.Map{"type": data.String("sequence"), "start": data.Float(0.5), "step": data.Float(0.25)}
+			ts := runGeneratorSource(ctx, params, 5)
+
+			Convey("Then it should emit count tuples", func() {
+				So(values(ts, "i"), ShouldResemble, []data.Value{
+					data.Int(1), data.Int(2), data.Int(3), data.Int(4), data.Int(5)})
+			})
+
+			Convey("Then a float sequence should have floats", func() {
+				So(values(ts, "f"), ShouldResemble, []data.Value{
+					data.Float(0.5), data.Float(0.75), data.Float(1), data.Float(1.25), data.Float(1.5)})
+			})
+		})
+
+		Convey("When generating random values", func() {
+			params["count"] = data.Int(100)
+			fields["u"] = data.Map{"type": data.String("uniform"), "min": data.Int(-1), "max": data.Int(1)}
+			fields["n"] = data.Map{"type": data.String("normal"), "mean": data.Int(10), "stddev": data.Int(0)}
+			fields["d"] = data.Map{"type": data.String("uniform"), "min": data.Int(1), "max": data.Int(3), "integer": data.True}
+			fields["c"] = data.Map{
+				"type":    data.String("choice"),
+				"values":  data.Array{data.String("a"), data.String("b"), data.String("c")},
+				"weights": data.Array{data.Int(1), data.Int(0), data.Int(1)},
+			}
+			fields["w"] = data.Map{"type": data.String("random_walk"), "start": data.Int(5), "step": data.Int(2), "min": data.Int(0), "max": data.Int(6)}
+			ts := runGeneratorSource(ctx, params, 100)
+
+			Convey("Then they should be in the ranges", func() {
+				seen := map[string]bool{}
+				for _, t := range ts {
+					u, _ := data.AsFloat(t.Data["u"])
+					So(u, ShouldBeBetweenOrEqual, -1, 1)
+					So(t.Data["n"], ShouldEqual, data.Float(10))
+					d, err := data.AsInt(t.Data["d"])
+					So(err, ShouldBeNil)
+					So(d, ShouldBeBetweenOrEqual, 1, 3)
+					c, _ := data.AsString(t.Data["c"])
+					seen[c] = true
+					w, _ := data.AsFloat(t.Data["w"])
+					So(w, ShouldBeBetweenOrEqual, 0, 6)
+				}
+				So(seen, ShouldResemble, map[string]bool{"a": true, "c": true})
+				So(ts[0].Data["w"], ShouldEqual, data.Float(5))
+			})
+
+			Convey("Then the same seed should generate the same values", func() {
+				ts2 := runGeneratorSource(ctx, params, 100)
+				for i := range ts {
+					So(ts2[i].Data, ShouldResemble, ts[i].Data)
+				}
+			})
+
+			Convey("Then a different seed should generate different values", func() {
+				params["seed"] = data.Int(1)
+				ts2 := runGeneratorSource(ctx, params, 100)
+				So(values(ts2, "u"), ShouldNotResemble, values(ts, "u"))
+			})
+		})
+
+		Convey("When generating timestamps", func() {
+			start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+			fields["ts"] = data.Map{
+				"type":     data.String("timestamp"),
+				"start":    data.Timestamp(start),
+				"interval": data.Int(60),
+				"jitter":   data.Int(1),
+			}
+			params["timestamp_field"] = data.String("ts")
+			ts := runGeneratorSource(ctx, params, 5)
+
+			Convey("Then they should advance by the interval with jitter", func() {
+				for i, t := range ts {
+					expected := start.Add(time.Duration(i) * time.Minute)
+					So(t.Timestamp, ShouldHappenWithin, time.Second, expected)
+				}
+			})
+		})
+
+		Convey("When generating tuples at a rate", func() {
+			params["rate"] = data.Int(100)
+			params["count"] = data.Int(10)
+			begin := time.Now()
+			runGeneratorSource(ctx, params, 10)
+
+			Convey("Then it should take about count/rate", func() {
+				So(time.Since(begin), ShouldBeGreaterThanOrEqualTo, 90*time.Millisecond)
+			})
+		})
+
+		Convey("When rewinding the source", func() {
+			s, err := createGeneratorSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+			c := &tupleCollector{}
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, c)
+			}()
+			So(c.ints(5), ShouldHaveLength, 5)
+			So(s.(core.RewindableSource).Rewind(ctx), ShouldBeNil)
+
+			Convey("Then it should restart the sequence", func() {
+				So(c.ints(10), ShouldResemble, []int64{1, 2, 3, 4, 5, 1, 2, 3, 4, 5})
+			})
+
+			Convey("Then it should stop", func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+
+		Convey("When creating the source with invalid parameters", func() {
+			cases := []struct {
+				title string
+				spec  data.Map
+			}{
+				{"missing type", data.Map{}},
+				{"unknown type", data.Map{"type": data.String("poisson")}},
+				{"non-number sequence", data.Map{"type": data.String("sequence"), "start": data.String("a")}},
+				{"inverted uniform range", data.Map{"type": data.String("uniform"), "min": data.Int(1), "max": data.Int(0)}},
+				{"uniform range without integers", data.Map{"type": data.String("uniform"), "min": data.Float(0.1), "max": data.Float(0.9), "integer": data.True}},
+				{"negative stddev", data.Map{"type": data.String("normal"), "stddev": data.Int(-1)}},
+				{"missing choice values", data.Map{"type": data.String("choice")}},
+				{"mismatched weights", data.Map{"type": data.String("choice"), "values": data.Array{data.Int(1)}, "weights": data.Array{}}},
+				{"zero weights", data.Map{"type": data.String("choice"), "values": data.Array{data.Int(1)}, "weights": data.Array{data.Int(0)}}},
+				{"negative jitter", data.Map{"type": data.String("timestamp"), "jitter": data.Int(-1)}},
+				{"negative step", data.Map{"type": data.String("random_walk"), "step": data.Int(-1)}},
+			}
+			for _, c := range cases {
+				c := c
+				Convey("Then "+c.title+" should result in an error", func() {
+					fields["x"] = c.spec
+					_, err := createGeneratorSource(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+
+			Convey("Then empty fields should result in an error", func() {
+				params["fields"] = data.Map{}
+				_, err := createGeneratorSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then negative rate should result in an error", func() {
+				params["rate"] = data.Int(-1)
+				_, err := createGeneratorSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,353 @@
+package bql
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// httpSinkStatusError is returned when the server responded with a non-2xx
+// status code.
+type httpSinkStatusError struct {
+	statusCode int
+	body       string
+}
+
+func (e *httpSinkStatusError) Error() string {
+	return fmt.Sprintf("the server returned status %v: %v", e.statusCode, e.body)
+}
+
+// httpSinkMaxBufferedBatches is the number of batches which can be kept in
+// the buffer while the server is failing. The oldest tuples are dropped when
+// the buffer has more tuples.
+const httpSinkMaxBufferedBatches = 10
+
+// httpSink POSTs tuples to a URL. Tuples are sent in batches having up to
+// batchSize tuples. A batch which doesn't get full is sent after
+// flushInterval.
+//
+// A request failed with a 5xx status code or a network error is retried
+// with exponential backoff. When all retries fail, the error is returned as
+// core.TemporaryError and the batch is kept in the buffer so that it's sent
+// again with the next batch. Tuples written while the server is failing are
+// also buffered, and the oldest tuples are dropped once the buffer has more
+// than httpSinkMaxBufferedBatches batches. Other failures are returned as
+// permanent errors and the batch is discarded. Errors are returned from the
+// Write call which sent the batch or from Close, and they're logged when the
+// batch is sent after flushInterval. Once Close is called, failed requests
+// aren't retried any more and the buffer is discarded when sending it fails.
+//
+// Requests are sent without holding the lock of the buffer, so Write doesn't
+// block while another goroutine is sending a batch.
+type httpSink struct {
+	url     string
+	jsonl   bool
+	headers map[string]string
+	client  *http.Client
+
+	batchSize     int
+	flushInterval time.Duration
+
+	maxRetries       int
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+
+	ioParams *IOParams
+
+	m        sync.Mutex
+	buf      []data.Map
+	closed   bool
+	flushing bool
+	flushed  *sync.Cond
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func (s *httpSink) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return errors.New("the sink is already closed")
+	}
+	s.buf = append(s.buf, t.Data)
+	s.dropOverflow(ctx)
+	s.m.Unlock()
+	return s.flush(ctx, false)
+}
+
+// flushPeriodically sends batches which don't get full until they're sent
+// by Write. It's run in a separate goroutine when batchSize is greater than 1.
+func (s *httpSink) flushPeriodically(ctx *core.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.flush(ctx, true); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("url", s.url).Error("Cannot send tuples")
+		}
+	}
+}
+
+// flush sends buffered tuples in batches. Only full batches are sent unless
+// force is true. When another goroutine is sending tuples, flush returns
+// immediately if force is false and waits for it otherwise. Tuples which
+// couldn't be sent due to a temporary error are put back to the head of the
+// buffer. The caller must not hold the lock.
+func (s *httpSink) flush(ctx *core.Context, force bool) error {
+	s.m.Lock()
+	for force && s.flushing {
+		s.flushed.Wait()
+	}
+	if s.flushing || len(s.buf) == 0 || (!force && len(s.buf) < s.batchSize) {
+		s.m.Unlock()
+		return nil
+	}
+	s.flushing = true
+	buf := s.buf
+	s.buf = nil
+	s.m.Unlock()
+
+	var err error
+	for len(buf) > 0 {
+		n := s.batchSize
+		if len(buf) < n {
+			if !force {
+				break
+			}
+			n = len(buf)
+		}
+		err = s.sendWithRetry(s.encode(buf[:n]))
+		if core.IsTemporaryError(err) {
+			break
+		}
+		buf = buf[n:]
+		if err != nil {
+			break
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.buf = append(buf, s.buf...)
+	s.dropOverflow(ctx)
+	s.flushing = false
+	s.flushed.Broadcast()
+	return err
+}
+
+// dropOverflow drops the oldest tuples when the buffer has too many tuples.
+// The caller must hold the lock.
+func (s *httpSink) dropOverflow(ctx *core.Context) {
+	max := s.batchSize * httpSinkMaxBufferedBatches
+	if len(s.buf) <= max {
+		return
+	}
+	n := len(s.buf) - max
+	s.buf = append([]data.Map{}, s.buf[n:]...)
+	ctx.Log().WithField("node_name", s.ioParams.Name).WithField("url", s.url).
+		WithField("num_dropped", n).Warning("Dropped tuples because too many tuples are buffered")
+}
+
+// sendWithRetry sends a request and retries it with exponential backoff.
+// It stops retrying when the sink is closed.
+func (s *httpSink) sendWithRetry(body []byte) error {
+	interval := s.retryInterval
+	for retry := 0; ; retry++ {
+		err := s.send(body)
+		if err == nil {
+			return nil
+		}
+		if !s.shouldRetry(err) {
+			return err
+		}
+		if retry >= s.maxRetries {
+			return core.TemporaryError(fmt.Errorf("gave up sending tuples after %v retries: %v", retry, err))
+		}
+
+		select {
+		case <-s.stopCh:
+			return core.TemporaryError(fmt.Errorf("gave up sending tuples because the sink is closed: %v", err))
+		case <-time.After(interval):
+		}
+		interval *= 2
+		if interval > s.maxRetryInterval {
+			interval = s.maxRetryInterval
+		}
+	}
+}
+
+// encode returns the body of a request. A JSON array is sent when the
+// format is json and the batch size is greater than 1.
+func (s *httpSink) encode(ms []data.Map) []byte {
+	b := bytes.NewBuffer(nil)
+	switch {
+	case s.jsonl:
+		for _, m := range ms {
+			b.WriteString(m.String())
+			b.WriteByte('\n')
+		}
+	case s.batchSize == 1:
+		b.WriteString(ms[0].String())
+	default:
+		b.WriteByte('[')
+		for i, m := range ms {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(m.String())
+		}
+		b.WriteByte(']')
+	}
+	return b.Bytes()
+}
+
+func (s *httpSink) send(body []byte) error {
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if s.jsonl {
+		req.Header.Set("Content-Type", "application/x-ndjson")
+	} else {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		// Read the body so that the connection can be reused.
+		io.Copy(ioutil.Discard, res.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+	return &httpSinkStatusError{
+		statusCode: res.StatusCode,
+		body:       string(msg),
+	}
+}
+
+// shouldRetry returns true when the error is caused by a server error or a
+// network error.
+func (s *httpSink) shouldRetry(err error) bool {
+	if e, ok := err.(*httpSinkStatusError); ok {
+		return e.statusCode >= 500
+	}
+	return true
+}
+
+func (s *httpSink) Close(ctx *core.Context) error {
+	// stopCh is closed first so that a flush waiting for the next retry
+	// gives up.
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.m.Unlock()
+
+	err := s.flush(ctx, true)
+	s.m.Lock()
+	s.buf = nil
+	s.m.Unlock()
+	return err
+}
+
+func createHTTPSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	v := &struct {
+		URL              string `bql:"url,required"`
+		Format           string
+		Headers          map[string]string
+		BatchSize        int
+		FlushInterval    time.Duration
+		Timeout          time.Duration
+		MaxRetries       int
+		RetryInterval    time.Duration
+		MaxRetryInterval time.Duration
+	}{
+		Format:           "json",
+		BatchSize:        1,
+		FlushInterval:    time.Second,
+		Timeout:          10 * time.Second,
+		MaxRetries:       3,
+		RetryInterval:    100 * time.Millisecond,
+		MaxRetryInterval: 10 * time.Second,
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	if u, err := url.Parse(v.URL); err != nil {
+		return nil, fmt.Errorf("'url' parameter has an invalid URL: %v", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("'url' parameter must be an http or https URL: %v", v.URL)
+	}
+	if v.Format != "json" && v.Format != "jsonl" {
+		return nil, fmt.Errorf("'format' parameter must be json or jsonl: %v", v.Format)
+	}
+	if v.BatchSize <= 0 {
+		return nil, fmt.Errorf("'batch_size' parameter must be positive: %v", v.BatchSize)
+	}
+	if v.BatchSize > 1 && v.FlushInterval <= 0 {
+		return nil, fmt.Errorf("'flush_interval' parameter must be positive: %v", v.FlushInterval)
+	}
+	if v.MaxRetries < 0 {
+		return nil, fmt.Errorf("'max_retries' parameter must not be negative: %v", v.MaxRetries)
+	}
+	if v.RetryInterval < 0 || v.MaxRetryInterval < 0 {
+		return nil, errors.New("'retry_interval' and 'max_retry_interval' parameters must not be negative")
+	}
+
+	s := &httpSink{
+		url:              v.URL,
+		jsonl:            v.Format == "jsonl",
+		headers:          v.Headers,
+		client:           &http.Client{Timeout: v.Timeout},
+		batchSize:        v.BatchSize,
+		flushInterval:    v.FlushInterval,
+		maxRetries:       v.MaxRetries,
+		retryInterval:    v.RetryInterval,
+		maxRetryInterval: v.MaxRetryInterval,
+		ioParams:         ioParams,
+		stopCh:           make(chan struct{}),
+	}
+	s.flushed = sync.NewCond(&s.m)
+	if s.batchSize > 1 {
+		s.wg.Add(1)
+		go s.flushPeriodically(ctx)
+	}
+	return s, nil
+}
+
+func init() {
+	MustRegisterGlobalSinkCreator("http", SinkCreatorFunc(createHTTPSink))
+}
@@ -0,0 +1,293 @@
+package bql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+type testHTTPReceiver struct {
+	m        sync.Mutex
+	bodies   []string
+	headers  []http.Header
+	statuses []int
+}
+
+func (r *testHTTPReceiver) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	b, _ := ioutil.ReadAll(req.Body)
+	r.bodies = append(r.bodies, string(b))
+	r.headers = append(r.headers, req.Header)
+
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status = r.statuses[0]
+		r.statuses = r.statuses[1:]
+	}
+	rw.WriteHeader(status)
+}
+
+// requests returns bodies after waiting until the receiver has n requests or
+// one second passes.
+func (r *testHTTPReceiver) requests(n int) []string {
+	deadline := time.Now().Add(time.Second)
+	for {
+		r.m.Lock()
+		l := len(r.bodies)
+		r.m.Unlock()
+		if l >= n || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	return append([]string{}, r.bodies...)
+}
+
+func TestHTTPSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given an HTTP server", t, func() {
+		r := &testHTTPReceiver{}
+		server := httptest.NewServer(r)
+		Reset(server.Close)
+
+		params := data.Map{
+			"url":            data.String(server.URL),
+			"retry_interval": data.Float(0.001),
+		}
+		tuple := func(i int) *core.Tuple {
+			return core.NewTuple(data.Map{"i": data.Int(i)})
+		}
+
+		Convey("When writing a tuple with default parameters", func() {
+			params["headers"] = data.Map{"X-Token": data.String("secret")}
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			So(s.Write(ctx, tuple(1)), ShouldBeNil)
+
+			Convey("Then it should be posted as a JSON object", func() {
+				So(r.requests(1), ShouldResemble, []string{`{"i":1}`})
+				So(r.headers[0].Get("Content-Type"), ShouldEqual, "application/json")
+			})
+
+			Convey("Then custom headers should be sent", func() {
+				So(r.requests(1), ShouldHaveLength, 1)
+				So(r.headers[0].Get("X-Token"), ShouldEqual, "secret")
+			})
+		})
+
+		Convey("When writing tuples in batches", func() {
+			params["batch_size"] = data.Int(2)
+			params["flush_interval"] = data.Float(0.05)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			for i := 1; i <= 3; i++ {
+				So(s.Write(ctx, tuple(i)), ShouldBeNil)
+			}
+
+			Convey("Then a full batch should be posted as a JSON array", func() {
+				So(r.requests(1)[0], ShouldEqual, `[{"i":1},{"i":2}]`)
+			})
+
+			Convey("Then the rest should be posted after the flush interval", func() {
+				So(r.requests(2), ShouldResemble, []string{`[{"i":1},{"i":2}]`, `[{"i":3}]`})
+			})
+		})
+
+		Convey("When writing tuples in JSON Lines", func() {
+			params["format"] = data.String("jsonl")
+			params["batch_size"] = data.Int(10)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			So(s.Write(ctx, tuple(1)), ShouldBeNil)
+			So(s.Write(ctx, tuple(2)), ShouldBeNil)
+
+			Convey("Then buffered tuples should be posted on close", func() {
+				So(s.Close(ctx), ShouldBeNil)
+				So(r.requests(1), ShouldResemble, []string{"{\"i\":1}\n{\"i\":2}\n"})
+				So(r.headers[0].Get("Content-Type"), ShouldEqual, "application/x-ndjson")
+			})
+		})
+
+		Convey("When the server temporarily fails", func() {
+			r.statuses = []int{http.StatusInternalServerError, http.StatusServiceUnavailable}
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			err = s.Write(ctx, tuple(1))
+
+			Convey("Then the request should be retried", func() {
+				So(err, ShouldBeNil)
+				So(r.requests(3), ShouldResemble, []string{`{"i":1}`, `{"i":1}`, `{"i":1}`})
+			})
+		})
+
+		Convey("When the server keeps failing", func() {
+			r.statuses = []int{500, 500, 500, 500, 500}
+			params["max_retries"] = data.Int(2)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			err = s.Write(ctx, tuple(1))
+
+			Convey("Then it should return a temporary error after retries", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsTemporaryError(err), ShouldBeTrue)
+				So(r.requests(3), ShouldHaveLength, 3)
+			})
+		})
+
+		Convey("When the server keeps failing with batches", func() {
+			r.statuses = []int{500, 500}
+			params["max_retries"] = data.Int(1)
+			params["batch_size"] = data.Int(2)
+			params["flush_interval"] = data.Float(10)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			So(s.Write(ctx, tuple(1)), ShouldBeNil)
+			err = s.Write(ctx, tuple(2))
+			So(core.IsTemporaryError(err), ShouldBeTrue)
+
+			Convey("Then the failed batch should be sent with the next batch", func() {
+				So(s.Write(ctx, tuple(3)), ShouldBeNil)
+				So(r.requests(3)[2], ShouldEqual, `[{"i":1},{"i":2}]`)
+				So(s.Write(ctx, tuple(4)), ShouldBeNil)
+				So(r.requests(4)[3], ShouldEqual, `[{"i":3},{"i":4}]`)
+			})
+		})
+
+		Convey("When the server keeps failing for many tuples", func() {
+			r.statuses = make([]int, 15)
+			for i := range r.statuses {
+				r.statuses[i] = 500
+			}
+			params["max_retries"] = data.Int(0)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			for i := 1; i <= 15; i++ {
+				So(core.IsTemporaryError(s.Write(ctx, tuple(i))), ShouldBeTrue)
+			}
+			So(s.Close(ctx), ShouldBeNil)
+
+			Convey("Then only the latest tuples should be kept and sent", func() {
+				reqs := r.requests(25)
+				So(reqs, ShouldHaveLength, 25)
+				for i, b := range reqs[15:] {
+					So(b, ShouldEqual, fmt.Sprintf(`{"i":%v}`, i+6))
+				}
+			})
+		})
+
+		Convey("When closing the sink while it's waiting for a retry", func() {
+			r.statuses = []int{500}
+			params["retry_interval"] = data.Float(10)
+			params["max_retry_interval"] = data.Float(10)
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- s.Write(ctx, tuple(1))
+			}()
+			So(r.requests(1), ShouldHaveLength, 1)
+
+			// Write doesn't block while another Write is waiting for a retry
+			So(s.Write(ctx, tuple(2)), ShouldBeNil)
+
+			start := time.Now()
+			closeErr := s.Close(ctx)
+
+			Convey("Then the retry should be canceled and buffered tuples should be sent", func() {
+				So(time.Since(start), ShouldBeLessThan, time.Second)
+				So(closeErr, ShouldBeNil)
+				So(core.IsTemporaryError(<-errCh), ShouldBeTrue)
+				So(r.requests(3), ShouldResemble, []string{`{"i":1}`, `{"i":1}`, `{"i":2}`})
+			})
+		})
+
+		Convey("When the server rejects the request", func() {
+			r.statuses = []int{http.StatusBadRequest}
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			err = s.Write(ctx, tuple(1))
+
+			Convey("Then it should return a permanent error without retries", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsTemporaryError(err), ShouldBeFalse)
+				So(r.requests(1), ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When writing a tuple after the sink is closed", func() {
+			s, err := createHTTPSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			So(s.Close(ctx), ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(s.Write(ctx, tuple(1)), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating the sink with invalid parameters", func() {
+			cases := []struct {
+				title string
+				key   string
+				value data.Value
+			}{
+				{"non-http url", "url", data.String("ftp://localhost/")},
+				{"unsupported format", "format", data.String("xml")},
+				{"zero batch_size", "batch_size", data.Int(0)},
+				{"negative max_retries", "max_retries", data.Int(-1)},
+				{"negative retry_interval", "retry_interval", data.Float(-1)},
+			}
+			for _, c := range cases {
+				c := c
+				Convey("Then "+c.title+" should result in an error", func() {
+					params[c.key] = c.value
+					_, err := createHTTPSink(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+
+			Convey("Then missing url should result in an error", func() {
+				delete(params, "url")
+				_, err := createHTTPSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then zero flush_interval with batches should result in an error", func() {
+				params["batch_size"] = data.Int(2)
+				params["flush_interval"] = data.Int(0)
+				_, err := createHTTPSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
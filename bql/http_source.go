@@ -0,0 +1,202 @@
+package bql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// TuplePusher is implemented by sources which accept tuples pushed from
+// outside of the topology. The server pushes tuples to such a source when
+// they're posted to the source's endpoint.
+type TuplePusher interface {
+	// Push writes records to the stream of the source as tuples. It returns
+	// an error when the source isn't running.
+	Push(ctx *core.Context, ms []data.Map) error
+}
+
+// MaxPushedRecordsSize is the maximum number of bytes DecodePushedRecords
+// reads.
+const MaxPushedRecordsSize = 16 * 1024 * 1024
+
+// ErrPushedRecordsTooLarge is returned from DecodePushedRecords when the
+// input is larger than MaxPushedRecordsSize.
+var ErrPushedRecordsTooLarge = fmt.Errorf("the body must not be larger than %v bytes", MaxPushedRecordsSize)
+
+// DecodePushedRecords decodes records pushed to a TuplePusher. The input can
+// be a JSON object, a JSON array of objects, or JSON Lines. It returns
+// ErrPushedRecordsTooLarge when the input is larger than
+// MaxPushedRecordsSize.
+func DecodePushedRecords(r io.Reader) ([]data.Map, error) {
+	// One more byte is read to detect that the input is too large.
+	b, err := ioutil.ReadAll(io.LimitReader(r, MaxPushedRecordsSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > MaxPushedRecordsSize {
+		return nil, ErrPushedRecordsTooLarge
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil, errors.New("the body is empty")
+	}
+
+	if b[0] == '[' {
+		var raws []json.RawMessage
+		if err := json.Unmarshal(b, &raws); err != nil {
+			return nil, fmt.Errorf("the body isn't a valid JSON array: %v", err)
+		}
+		ms := make([]data.Map, len(raws))
+		for i, raw := range raws {
+			m, err := decodeJSONObject(raw)
+			if err != nil {
+				return nil, fmt.Errorf("the element at %v is invalid: %v", i, err)
+			}
+			ms[i] = m
+		}
+		return ms, nil
+	}
+
+	// Because json.Decoder reads concatenated values, a single object and
+	// JSON Lines are decoded in the same way.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	ms := []data.Map{}
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return ms, nil
+			}
+			return nil, fmt.Errorf("the record at %v isn't valid JSON: %v", len(ms), err)
+		}
+		m, err := decodeJSONObject(raw)
+		if err != nil {
+			return nil, fmt.Errorf("the record at %v is invalid: %v", len(ms), err)
+		}
+		ms = append(ms, m)
+	}
+}
+
+// decodeJSONObject decodes a JSON object. It returns an error when raw has
+// another type of JSON value.
+func decodeJSONObject(raw []byte) (data.Map, error) {
+	// data.Map accepts null as an empty map.
+	if len(raw) == 0 || raw[0] != '{' {
+		return nil, errors.New("it isn't a JSON object")
+	}
+	m := data.Map{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// httpSource emits tuples pushed through the HTTP API of the server. The
+// server posts records to
+// /api/v1/topologies/:topologyName/sources/:sourceName/tuples.
+type httpSource struct {
+	m        sync.RWMutex
+	w        core.Writer
+	stopped  bool
+	tsField  data.Path
+	ioParams *IOParams
+	stopCh   chan struct{}
+}
+
+var (
+	_ TuplePusher = &httpSource{}
+)
+
+func (s *httpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	s.m.Lock()
+	if s.stopped {
+		s.m.Unlock()
+		return nil
+	}
+	s.w = w
+	s.m.Unlock()
+
+	<-s.stopCh
+
+	// Wait until all running pushes finish.
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.w = nil
+	return nil
+}
+
+func (s *httpSource) Push(ctx *core.Context, ms []data.Map) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.w == nil {
+		if s.stopped {
+			return errors.New("the source has already been stopped")
+		}
+		return errors.New("the source isn't running yet")
+	}
+
+	for _, m := range ms {
+		t := core.NewTuple(m)
+		if s.tsField != nil {
+			if v, err := t.Data.Get(s.tsField); err == nil {
+				if ts, err := data.ToTimestamp(v); err != nil {
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						WithField("timestamp_field", s.tsField).
+						WithField("timestamp_field_value", v).
+						Warning("Cannot convert a value in timestamp_field to a timestamp")
+				} else {
+					t.Timestamp = ts
+				}
+			}
+		}
+		if err := s.w.Write(ctx, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *httpSource) Stop(ctx *core.Context) error {
+	s.m.Lock()
+	if s.stopped {
+		s.m.Unlock()
+		return nil
+	}
+	s.stopped = true
+	s.m.Unlock()
+	close(s.stopCh)
+	return nil
+}
+
+func createHTTPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		TimestampField string
+	}{}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		var err error
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+	return &httpSource{
+		tsField:  tsField,
+		ioParams: ioParams,
+		stopCh:   make(chan struct{}),
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("http", SourceCreatorFunc(createHTTPSource))
+}
@@ -0,0 +1,147 @@
+package bql
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestDecodePushedRecords(t *testing.T) {
+	Convey("Given pushed bodies", t, func() {
+		Convey("When decoding a single object", func() {
+			ms, err := DecodePushedRecords(strings.NewReader(` {"a":1} `))
+
+			Convey("Then it should return the object", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{{"a": data.Int(1)}})
+			})
+		})
+
+		Convey("When decoding an array of objects", func() {
+			ms, err := DecodePushedRecords(strings.NewReader(`[{"a":1}, {"a":2}]`))
+
+			Convey("Then it should return all objects", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+		})
+
+		Convey("When decoding JSON Lines", func() {
+			ms, err := DecodePushedRecords(strings.NewReader("{\"a\":1}\n\n{\"a\":2}\n"))
+
+			Convey("Then it should return all objects", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+		})
+
+		Convey("When decoding a body larger than the limit", func() {
+			b := `{"a":"` + strings.Repeat("x", MaxPushedRecordsSize) + `"}`
+			_, err := DecodePushedRecords(strings.NewReader(b))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldEqual, ErrPushedRecordsTooLarge)
+			})
+		})
+
+		Convey("When decoding invalid bodies", func() {
+			Convey("Then they should result in errors", func() {
+				for _, b := range []string{
+					"",
+					" \n",
+					`{"a":`,
+					"{\"a\":1}\n{",
+					`[{"a":1}, 2]`,
+					`[{"a":1}, null]`,
+					`[{"a":1}] {}`,
+					`1`,
+					`null`,
+				} {
+					_, err := DecodePushedRecords(strings.NewReader(b))
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+}
+
+func TestHTTPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given an http source", t, func() {
+		params := data.Map{}
+		c := &tupleCollector{}
+		create := func() (core.Source, TuplePusher) {
+			s, err := createHTTPSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			p, ok := s.(TuplePusher)
+			So(ok, ShouldBeTrue)
+			return s, p
+		}
+
+		Convey("When pushing records before it starts", func() {
+			_, p := create()
+			err := p.Push(ctx, []data.Map{{"i": data.Int(1)}})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When pushing records to the running source", func() {
+			now := time.Now()
+			params["timestamp_field"] = data.String("ts")
+			s, p := create()
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, c)
+			}()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			var err error
+			for i := 0; i < 100; i++ {
+				if err = p.Push(ctx, []data.Map{
+					{"i": data.Int(1), "ts": data.Timestamp(now)},
+					{"i": data.Int(2)},
+				}); err == nil {
+					break
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit them", func() {
+				So(c.ints(2), ShouldResemble, []int64{1, 2})
+			})
+
+			Convey("Then the timestamp should be taken from the field", func() {
+				So(c.ints(2), ShouldHaveLength, 2)
+				So(c.ts[0].Timestamp, ShouldHappenOnOrBetween, now, now)
+			})
+
+			Convey("Then it should stop", func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+
+				Convey("And pushing records after the stop should fail", func() {
+					So(p.Push(ctx, []data.Map{{"i": data.Int(3)}}), ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("When creating the source with invalid timestamp_field", func() {
+			params["timestamp_field"] = data.String("/this/isnt/a/xpath")
+			_, err := createHTTPSource(ctx, &IOParams{}, params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,237 @@
+package bql
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// influxFormat reads InfluxDB line protocol. A line is decoded into a
+// record having following fields:
+//
+//	measurement: the measurement
+//	tags: a map of tags
+//	fields: a map of fields. Integers, unsigned integers, floats, strings,
+//	        and booleans are converted to ints, ints, floats, strings, and
+//	        bools, respectively.
+//	timestamp: the timestamp. It's omitted when the line doesn't have it.
+//
+// Its parameter is:
+//
+//	precision: the unit of timestamps, which is one of "ns" (default),
+//	           "us", "ms", and "s"
+//
+// Empty lines and lines starting with "#" are skipped. The format doesn't
+// support encoding.
+type influxFormat struct{}
+
+var influxPrecisions = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+}
+
+type influxDecoder struct {
+	lineReader
+	precision time.Duration
+}
+
+func (d *influxDecoder) Decode() (data.Map, error) {
+	for {
+		line, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		s := strings.TrimSpace(string(line))
+		if s == "" || s[0] == '#' {
+			continue
+		}
+		m, err := parseInfluxLine(s, d.precision)
+		if err != nil {
+			return nil, &RecordError{Err: err, Record: s}
+		}
+		return m, nil
+	}
+}
+
+func parseInfluxLine(s string, precision time.Duration) (data.Map, error) {
+	measurement, i := scanInfluxToken(s, 0, ", ")
+	if measurement == "" {
+		return nil, errors.New("the measurement is missing")
+	}
+
+	tags := data.Map{}
+	for i < len(s) && s[i] == ',' {
+		var k, v string
+		k, i = scanInfluxToken(s, i+1, ",= ")
+		if k == "" || i >= len(s) || s[i] != '=' {
+			return nil, errors.New("invalid tag")
+		}
+		v, i = scanInfluxToken(s, i+1, ", ")
+		if v == "" {
+			return nil, fmt.Errorf("the value of tag '%v' is missing", k)
+		}
+		tags[k] = data.String(v)
+	}
+	i = skipInfluxSpaces(s, i)
+
+	fields := data.Map{}
+	for {
+		var k string
+		k, i = scanInfluxToken(s, i, ",= ")
+		if k == "" || i >= len(s) || s[i] != '=' {
+			return nil, errors.New("invalid field")
+		}
+		i++
+
+		var v data.Value
+		if i < len(s) && s[i] == '"' {
+			var str string
+			var err error
+			if str, i, err = scanInfluxString(s, i+1); err != nil {
+				return nil, err
+			}
+			v = data.String(str)
+		} else {
+			var raw string
+			raw, i = scanInfluxToken(s, i, ", ")
+			var err error
+			if v, err = parseInfluxFieldValue(raw); err != nil {
+				return nil, fmt.Errorf("invalid value of field '%v': %v", k, err)
+			}
+		}
+		fields[k] = v
+
+		if i >= len(s) || s[i] != ',' {
+			break
+		}
+		i++
+	}
+
+	m := data.Map{
+		"measurement": data.String(measurement),
+		"tags":        tags,
+		"fields":      fields,
+	}
+	i = skipInfluxSpaces(s, i)
+	if i < len(s) {
+		n, err := strconv.ParseInt(s[i:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %v", s[i:])
+		}
+		m["timestamp"] = data.Timestamp(time.Unix(0, n*int64(precision)))
+	}
+	return m, nil
+}
+
+// scanInfluxToken returns an unescaped token starting from i and the
+// position of the delimiter which ends the token. A backslash escapes a
+// delimiter.
+func scanInfluxToken(s string, i int, delims string) (string, int) {
+	var buf []byte
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) && strings.IndexByte(delims, s[i+1]) >= 0 {
+			i++
+			buf = append(buf, s[i])
+			continue
+		}
+		if strings.IndexByte(delims, c) >= 0 {
+			break
+		}
+		buf = append(buf, c)
+	}
+	return string(buf), i
+}
+
+// scanInfluxString returns an unescaped string value starting from i and
+// the position next to the closing quote.
+func scanInfluxString(s string, i int) (string, int, error) {
+	var buf []byte
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\') {
+			i++
+			buf = append(buf, s[i])
+			continue
+		}
+		if c == '"' {
+			return string(buf), i + 1, nil
+		}
+		buf = append(buf, c)
+	}
+	return "", i, errors.New("a string value isn't closed")
+}
+
+func skipInfluxSpaces(s string, i int) int {
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	return i
+}
+
+func parseInfluxFieldValue(s string) (data.Value, error) {
+	if s == "" {
+		return nil, errors.New("the value is missing")
+	}
+	switch s {
+	case "t", "T", "true", "True", "TRUE":
+		return data.True, nil
+	case "f", "F", "false", "False", "FALSE":
+		return data.False, nil
+	}
+	switch s[len(s)-1] {
+	case 'i':
+		i, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return data.Int(i), nil
+	case 'u':
+		u, err := strconv.ParseUint(s[:len(s)-1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("the value overflows int64: %v", s)
+		}
+		return data.Int(u), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return data.Float(f), nil
+}
+
+func (influxFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	v := &struct {
+		Precision string
+	}{
+		Precision: "ns",
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+	p, ok := influxPrecisions[v.Precision]
+	if !ok {
+		return nil, fmt.Errorf("'precision' parameter must be ns, us, ms, or s: %v", v.Precision)
+	}
+	return &influxDecoder{lineReader{bufio.NewReader(r)}, p}, nil
+}
+
+func (influxFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	return nil, errors.New("the influx format doesn't support encoding")
+}
+
+func init() {
+	MustRegisterGlobalRecordFormat("influx", influxFormat{})
+}
@@ -0,0 +1,95 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestInfluxFormat(t *testing.T) {
+	Convey("Given the influx format", t, func() {
+		f, err := LookupGlobalRecordFormat("influx")
+		So(err, ShouldBeNil)
+
+		Convey("When decoding lines", func() {
+			ms, errs, err := decodeAllRecords(f, "# comment\n"+
+				`weather,location=us\,midwest,sea\ level=low temperature=82,humid=0.5,count=3i,big=4u,ok=t,note="a \"b\" c" 1465839830100400200`+"\n"+
+				"\n"+
+				`cpu\ load value=F`+"\n", nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then each line should be a record", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{
+						"measurement": data.String("weather"),
+						"tags": data.Map{
+							"location":  data.String("us,midwest"),
+							"sea level": data.String("low"),
+						},
+						"fields": data.Map{
+							"temperature": data.Float(82),
+							"humid":       data.Float(0.5),
+							"count":       data.Int(3),
+							"big":         data.Int(4),
+							"ok":          data.True,
+							"note":        data.String(`a "b" c`),
+						},
+						"timestamp": data.Timestamp(time.Unix(0, 1465839830100400200)),
+					},
+					{
+						"measurement": data.String("cpu load"),
+						"tags":        data.Map{},
+						"fields":      data.Map{"value": data.False},
+					},
+				})
+			})
+		})
+
+		Convey("When decoding a line with precision", func() {
+			ms, _, err := decodeAllRecords(f, "m v=1 1465839830\n", data.Map{"precision": data.String("s")})
+			So(err, ShouldBeNil)
+
+			Convey("Then the timestamp should be in the unit", func() {
+				So(ms, ShouldHaveLength, 1)
+				So(ms[0]["timestamp"], ShouldResemble, data.Timestamp(time.Unix(1465839830, 0)))
+			})
+		})
+
+		Convey("When decoding malformed lines", func() {
+			ms, errs, err := decodeAllRecords(f, "m v=1\n"+
+				"m\n"+
+				",t=a v=1\n"+
+				"m,t= v=1\n"+
+				"m v=\n"+
+				"m v=abc\n"+
+				"m v=\"abc\n"+
+				"m v=1 now\n"+
+				"m v=18446744073709551615u\n", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be reported and skipped", func() {
+				So(ms, ShouldHaveLength, 1)
+				So(errs, ShouldHaveLength, 8)
+			})
+		})
+
+		Convey("When creating a decoder with invalid precision", func() {
+			_, err := f.NewDecoder(nil, data.Map{"precision": data.String("m")})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating an encoder", func() {
+			_, err := f.NewEncoder(nil, nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,403 @@
+package bql
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// newMQTTClient creates an MQTT client. It's replaced in tests.
+var newMQTTClient = mqtt.NewClient
+
+// mqttParams has parameters shared by the mqtt source and sink.
+type mqttParams struct {
+	Broker       string `bql:",required"`
+	ClientID     string
+	CleanSession bool
+	Username     string
+	Password     string
+	QoS          int `bql:"qos"`
+
+	// Payload is the format of payloads. "json" means a payload is a JSON
+	// object. "raw" and "blob" mean a payload is stored in PayloadField as
+	// a string and a blob, respectively.
+	Payload      string
+	PayloadField string
+
+	// Timeout is the timeout of connecting to the broker and of each
+	// operation.
+	Timeout time.Duration
+}
+
+func newMQTTParams() *mqttParams {
+	return &mqttParams{
+		CleanSession: true,
+		Payload:      "json",
+		PayloadField: "payload",
+		Timeout:      10 * time.Second,
+	}
+}
+
+func (p *mqttParams) validate() error {
+	if p.QoS != 0 && p.QoS != 1 {
+		return fmt.Errorf("'qos' parameter must be 0 or 1: %v", p.QoS)
+	}
+	switch p.Payload {
+	case "json", "raw", "blob":
+	default:
+		return fmt.Errorf("'payload' parameter must be json, raw, or blob: %v", p.Payload)
+	}
+	if p.Payload != "json" && p.PayloadField == "" {
+		return errors.New("'payload_field' parameter must not be empty")
+	}
+	if !p.CleanSession && p.ClientID == "" {
+		return errors.New("'client_id' parameter is required for a persistent session")
+	}
+	if p.Timeout <= 0 {
+		return fmt.Errorf("'timeout' parameter must be positive: %v", p.Timeout)
+	}
+	return nil
+}
+
+func (p *mqttParams) clientOptions() (*mqtt.ClientOptions, error) {
+	clientID := p.ClientID
+	if clientID == "" {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		clientID = "sensorbee-" + hex.EncodeToString(b)
+	}
+
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(p.Broker)
+	opts.SetClientID(clientID)
+	opts.SetCleanSession(p.CleanSession)
+	opts.SetUsername(p.Username)
+	opts.SetPassword(p.Password)
+	opts.SetConnectTimeout(p.Timeout)
+	opts.SetAutoReconnect(true)
+	return opts, nil
+}
+
+// waitMQTTToken waits until the operation of the token completes.
+func waitMQTTToken(t mqtt.Token, timeout time.Duration) error {
+	if !t.WaitTimeout(timeout) {
+		return errors.New("the MQTT operation timed out")
+	}
+	return t.Error()
+}
+
+// mqttSource subscribes topics and emits received messages as tuples. The
+// topic of a message is stored in topicField, and its levels are stored in
+// the fields specified by topicFields.
+type mqttSource struct {
+	params      *mqttParams
+	topics      []string
+	topicField  string
+	topicFields []string
+	ioParams    *IOParams
+
+	stopCh chan struct{}
+}
+
+func (s *mqttSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	opts, err := s.params.clientOptions()
+	if err != nil {
+		return err
+	}
+	var writeErr error
+	var once sync.Once
+	fatalCh := make(chan struct{})
+	opts.SetDefaultPublishHandler(func(c mqtt.Client, msg mqtt.Message) {
+		t, err := s.toTuple(msg)
+		if err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("topic", msg.Topic()).
+				Warning("Ignoring the message due to a decode error")
+			return
+		}
+		if err := w.Write(ctx, t); err != nil {
+			// The source stops when the writer returns an error such as
+			// core.ErrSourceStopped.
+			once.Do(func() {
+				writeErr = err
+				close(fatalCh)
+			})
+		}
+	})
+	opts.SetConnectionLostHandler(func(c mqtt.Client, err error) {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			Warning("The connection to the MQTT broker was lost")
+	})
+
+	// Topics are subscribed every time the client connects to the broker
+	// because subscriptions are lost on reconnect when the session is clean.
+	// The result of the first subscription is returned from GenerateStream.
+	filters := make(map[string]byte, len(s.topics))
+	for _, t := range s.topics {
+		filters[t] = byte(s.params.QoS)
+	}
+	subscribedCh := make(chan error, 1)
+	opts.SetOnConnectHandler(func(c mqtt.Client) {
+		err := waitMQTTToken(c.SubscribeMultiple(filters, nil), s.params.Timeout)
+		select {
+		case subscribedCh <- err:
+			return
+		default:
+		}
+		if err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				Error("Cannot subscribe topics after reconnecting to the MQTT broker")
+		}
+	})
+	// Subscriptions which weren't acknowledged before a disconnection are
+	// sent again in a persistent session.
+	opts.SetResumeSubs(!s.params.CleanSession)
+
+	client := newMQTTClient(opts)
+	if err := waitMQTTToken(client.Connect(), s.params.Timeout); err != nil {
+		return fmt.Errorf("cannot connect to the MQTT broker: %v", err)
+	}
+	defer client.Disconnect(250)
+
+	select {
+	case <-s.stopCh:
+		return nil
+	case err := <-subscribedCh:
+		if err != nil {
+			return fmt.Errorf("cannot subscribe topics: %v", err)
+		}
+	}
+
+	select {
+	case <-s.stopCh:
+		return nil
+	case <-fatalCh:
+		return writeErr
+	}
+}
+
+func (s *mqttSource) toTuple(msg mqtt.Message) (*core.Tuple, error) {
+	var m data.Map
+	switch s.params.Payload {
+	case "json":
+		var err error
+		if m, err = decodeJSONObject(bytes.TrimSpace(msg.Payload())); err != nil {
+			return nil, err
+		}
+	case "raw":
+		m = data.Map{s.params.PayloadField: data.String(msg.Payload())}
+	case "blob":
+		m = data.Map{s.params.PayloadField: data.Blob(msg.Payload())}
+	}
+
+	if s.topicField != "" {
+		m[s.topicField] = data.String(msg.Topic())
+	}
+	levels := strings.Split(msg.Topic(), "/")
+	for i, f := range s.topicFields {
+		if f == "" || i >= len(levels) {
+			continue
+		}
+		m[f] = data.String(levels[i])
+	}
+	return core.NewTuple(m), nil
+}
+
+func (s *mqttSource) Stop(ctx *core.Context) error {
+	close(s.stopCh)
+	return nil
+}
+
+// topicsParam returns topics given as a string or an array of strings.
+func topicsParam(params data.Map) ([]string, error) {
+	topics, err := stringsParam(params, "topics")
+	if err != nil {
+		return nil, err
+	}
+	if topics == nil {
+		return nil, errors.New("'topics' parameter is required")
+	}
+	if len(topics) == 0 {
+		return nil, errors.New("'topics' parameter must not be empty")
+	}
+	return topics, nil
+}
+
+// stringsParam returns a parameter given as a string or an array of
+// strings. It returns nil when the parameter is missing.
+func stringsParam(params data.Map, name string) ([]string, error) {
+	v, ok := params[name]
+	if !ok {
+		return nil, nil
+	}
+	if s, err := data.AsString(v); err == nil {
+		return []string{s}, nil
+	}
+	a, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("'%v' parameter must be a string or an array of strings", name)
+	}
+	res := make([]string, len(a))
+	for i, e := range a {
+		s, err := data.AsString(e)
+		if err != nil {
+			return nil, fmt.Errorf("'%v' parameter must be a string or an array of strings", name)
+		}
+		res[i] = s
+	}
+	return res, nil
+}
+
+func createMQTTSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		mqttParams
+		TopicField  string
+		TopicFields []string
+	}{
+		mqttParams: *newMQTTParams(),
+		TopicField: "topic",
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+	p := &v.mqttParams
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	topics, err := topicsParam(params)
+	if err != nil {
+		return nil, err
+	}
+	return &mqttSource{
+		params:      p,
+		topics:      topics,
+		topicField:  v.TopicField,
+		topicFields: v.TopicFields,
+		ioParams:    ioParams,
+		stopCh:      make(chan struct{}),
+	}, nil
+}
+
+// mqttSink publishes tuples to a topic. When topicField is given and a tuple
+// has a string in the field, the tuple is published to the topic in the
+// field instead.
+type mqttSink struct {
+	params     *mqttParams
+	topic      string
+	topicField data.Path
+	retained   bool
+	client     mqtt.Client
+}
+
+func (s *mqttSink) Write(ctx *core.Context, t *core.Tuple) error {
+	topic := s.topic
+	if s.topicField != nil {
+		if v, err := t.Data.Get(s.topicField); err == nil {
+			if str, err := data.AsString(v); err == nil {
+				topic = str
+			}
+		}
+	}
+	if topic == "" {
+		return errors.New("the tuple doesn't have a topic")
+	}
+
+	var payload []byte
+	if s.params.Payload == "json" {
+		payload = []byte(t.Data.String())
+	} else {
+		v, ok := t.Data[s.params.PayloadField]
+		if !ok {
+			return fmt.Errorf("the tuple doesn't have the field '%v'", s.params.PayloadField)
+		}
+		var err error
+		switch v.Type() {
+		case data.TypeBlob:
+			payload, _ = data.AsBlob(v)
+		default:
+			var str string
+			str, err = data.ToString(v)
+			payload = []byte(str)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := waitMQTTToken(s.client.Publish(topic, byte(s.params.QoS), s.retained, payload), s.params.Timeout); err != nil {
+		if !s.client.IsConnectionOpen() {
+			// The client is reconnecting to the broker.
+			return core.TemporaryError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *mqttSink) Close(ctx *core.Context) error {
+	s.client.Disconnect(250)
+	return nil
+}
+
+func createMQTTSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	v := &struct {
+		mqttParams
+		Topic      string
+		TopicField string
+		Retained   bool
+	}{
+		mqttParams: *newMQTTParams(),
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+	p := &v.mqttParams
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if v.Topic == "" && v.TopicField == "" {
+		return nil, errors.New("'topic' or 'topic_field' parameter is required")
+	}
+	if strings.ContainsAny(v.Topic, "+#") {
+		return nil, fmt.Errorf("'topic' parameter cannot have wildcards: %v", v.Topic)
+	}
+	var topicField data.Path
+	if v.TopicField != "" {
+		var err error
+		if topicField, err = data.CompilePath(v.TopicField); err != nil {
+			return nil, fmt.Errorf("'topic_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+
+	opts, err := p.clientOptions()
+	if err != nil {
+		return nil, err
+	}
+	client := newMQTTClient(opts)
+	if err := waitMQTTToken(client.Connect(), p.Timeout); err != nil {
+		return nil, fmt.Errorf("cannot connect to the MQTT broker: %v", err)
+	}
+	return &mqttSink{
+		params:     p,
+		topic:      v.Topic,
+		topicField: topicField,
+		retained:   v.Retained,
+		client:     client,
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("mqtt", SourceCreatorFunc(createMQTTSource))
+	MustRegisterGlobalSinkCreator("mqtt", SinkCreatorFunc(createMQTTSink))
+}
@@ -0,0 +1,428 @@
+package bql
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// testMQTTToken is a token which has already completed.
+type testMQTTToken struct {
+	mqtt.Token
+	err error
+}
+
+func (t *testMQTTToken) Wait() bool                       { return true }
+func (t *testMQTTToken) WaitTimeout(d time.Duration) bool { return true }
+func (t *testMQTTToken) Error() error                     { return t.err }
+
+type testMQTTMessage struct {
+	mqtt.Message
+	topic   string
+	payload []byte
+}
+
+func (m *testMQTTMessage) Topic() string   { return m.topic }
+func (m *testMQTTMessage) Payload() []byte { return m.payload }
+
+type testMQTTPublish struct {
+	topic    string
+	qos      byte
+	retained bool
+	payload  string
+}
+
+// testMQTTClient is a fake MQTT client which doesn't connect to any broker.
+// Messages are delivered to the default publish handler by deliver. Like a
+// broker, it forgets subscriptions of a clean session when reconnecting.
+type testMQTTClient struct {
+	mqtt.Client
+	opts *mqtt.ClientOptions
+
+	m          sync.Mutex
+	subscribed map[string]byte
+	published  []testMQTTPublish
+	connErr    error
+	pubErr     error
+	subErr     error
+	open       bool
+}
+
+func (c *testMQTTClient) IsConnectionOpen() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.open
+}
+
+func (c *testMQTTClient) Connect() mqtt.Token {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.open = c.connErr == nil
+	if c.open && c.opts.OnConnect != nil {
+		go c.opts.OnConnect(c)
+	}
+	return &testMQTTToken{err: c.connErr}
+}
+
+// reconnect simulates a connection lost and an automatic reconnection.
+func (c *testMQTTClient) reconnect() {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.opts.CleanSession {
+		c.subscribed = nil
+	}
+	if c.opts.OnConnect != nil {
+		go c.opts.OnConnect(c)
+	}
+}
+
+func (c *testMQTTClient) Disconnect(quiesce uint) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.open = false
+}
+
+func (c *testMQTTClient) Publish(topic string, qos byte, retained bool, payload interface{}) mqtt.Token {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.pubErr != nil {
+		return &testMQTTToken{err: c.pubErr}
+	}
+	c.published = append(c.published, testMQTTPublish{topic, qos, retained, string(payload.([]byte))})
+	return &testMQTTToken{}
+}
+
+func (c *testMQTTClient) SubscribeMultiple(filters map[string]byte, callback mqtt.MessageHandler) mqtt.Token {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.subErr != nil {
+		return &testMQTTToken{err: c.subErr}
+	}
+	c.subscribed = filters
+	return &testMQTTToken{}
+}
+
+// waitSubscribed waits until topics are subscribed or one second passes.
+func (c *testMQTTClient) waitSubscribed() map[string]byte {
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.m.Lock()
+		s := c.subscribed
+		c.m.Unlock()
+		if s != nil || time.Now().After(deadline) {
+			return s
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// deliver delivers a message unless the client has no subscription.
+func (c *testMQTTClient) deliver(topic string, payload string) {
+	c.m.Lock()
+	s := c.subscribed
+	c.m.Unlock()
+	if len(s) == 0 {
+		return
+	}
+	c.opts.DefaultPublishHandler(c, &testMQTTMessage{topic: topic, payload: []byte(payload)})
+}
+
+// setUpTestMQTTClient replaces newMQTTClient with a function returning the
+// given fake client.
+func setUpTestMQTTClient(c *testMQTTClient) {
+	orig := newMQTTClient
+	newMQTTClient = func(opts *mqtt.ClientOptions) mqtt.Client {
+		c.opts = opts
+		return c
+	}
+	Reset(func() {
+		newMQTTClient = orig
+	})
+}
+
+func TestMQTTSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given an mqtt source with a fake client", t, func() {
+		client := &testMQTTClient{}
+		setUpTestMQTTClient(client)
+		params := data.Map{
+			"broker": data.String("tcp://localhost:1883"),
+			"topics": data.Array{data.String("sensors/+/temp"), data.String("alerts")},
+		}
+		c := &tupleCollector{}
+		start := func() core.Source {
+			s, err := createMQTTSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, c)
+			}()
+			Reset(func() {
+				s.Stop(ctx)
+				<-ch
+			})
+			So(client.waitSubscribed(), ShouldNotBeNil)
+			return s
+		}
+
+		Convey("When it starts", func() {
+			params["qos"] = data.Int(1)
+			start()
+
+			Convey("Then it should subscribe all topics", func() {
+				So(client.waitSubscribed(), ShouldResemble, map[string]byte{
+					"sensors/+/temp": 1,
+					"alerts":         1,
+				})
+			})
+
+			Convey("Then it should generate a client ID", func() {
+				So(client.opts.ClientID, ShouldStartWith, "sensorbee-")
+			})
+		})
+
+		Convey("When receiving JSON payloads", func() {
+			params["topic_fields"] = data.Array{data.String(""), data.String("sensor")}
+			start()
+			client.deliver("sensors/s1/temp", `{"i":1}`)
+			client.deliver("sensors/s2/temp", `not json`)
+			client.deliver("sensors/s3/temp", `[{"i":3}]`)
+			client.deliver("alerts", ` {"i":2} `)
+
+			Convey("Then valid objects should be emitted with the topic", func() {
+				So(c.ints(2), ShouldResemble, []int64{1, 2})
+				So(c.ts[0].Data, ShouldResemble, data.Map{
+					"i":      data.Int(1),
+					"topic":  data.String("sensors/s1/temp"),
+					"sensor": data.String("s1"),
+				})
+				So(c.ts[1].Data, ShouldResemble, data.Map{
+					"i":     data.Int(2),
+					"topic": data.String("alerts"),
+				})
+			})
+		})
+
+		Convey("When receiving raw payloads", func() {
+			params["payload"] = data.String("raw")
+			params["payload_field"] = data.String("msg")
+			params["topic_field"] = data.String("")
+			start()
+			client.deliver("alerts", "hello")
+
+			Convey("Then the payload should be stored as a string", func() {
+				So(c.ts, ShouldHaveLength, 1)
+				So(c.ts[0].Data, ShouldResemble, data.Map{"msg": data.String("hello")})
+			})
+		})
+
+		Convey("When receiving blob payloads", func() {
+			params["payload"] = data.String("blob")
+			start()
+			client.deliver("alerts", "\x00\x01")
+
+			Convey("Then the payload should be stored as a blob", func() {
+				So(c.ts, ShouldHaveLength, 1)
+				So(c.ts[0].Data["payload"], ShouldResemble, data.Blob([]byte{0, 1}))
+			})
+		})
+
+		Convey("When the connection is lost and restored", func() {
+			start()
+			client.deliver("alerts", `{"i":1}`)
+			client.reconnect()
+			So(client.waitSubscribed(), ShouldNotBeNil)
+			client.deliver("alerts", `{"i":2}`)
+
+			Convey("Then topics should be subscribed again and messages should arrive", func() {
+				So(c.ints(2), ShouldResemble, []int64{1, 2})
+			})
+		})
+
+		Convey("When it uses a persistent session", func() {
+			params["clean_session"] = data.False
+			params["client_id"] = data.String("src")
+			start()
+
+			Convey("Then it should resume subscriptions", func() {
+				So(client.opts.ResumeSubs, ShouldBeTrue)
+			})
+		})
+
+		Convey("When it cannot subscribe topics", func() {
+			client.subErr = errors.New("not authorized")
+			s, err := createMQTTSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+
+			Convey("Then GenerateStream should fail", func() {
+				So(s.GenerateStream(ctx, c), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When it cannot connect to the broker", func() {
+			client.connErr = errors.New("connection refused")
+			s, err := createMQTTSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+
+			Convey("Then GenerateStream should fail", func() {
+				So(s.GenerateStream(ctx, c), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating the source with invalid parameters", func() {
+			cases := []struct {
+				title string
+				key   string
+				value data.Value
+			}{
+				{"unsupported qos", "qos", data.Int(2)},
+				{"unsupported payload", "payload", data.String("xml")},
+				{"persistent session without client_id", "clean_session", data.False},
+				{"empty topics", "topics", data.Array{}},
+				{"non-string topics", "topics", data.Array{data.Int(1)}},
+				{"non-positive timeout", "timeout", data.Int(0)},
+			}
+			for _, c := range cases {
+				c := c
+				Convey("Then "+c.title+" should result in an error", func() {
+					params[c.key] = c.value
+					_, err := createMQTTSource(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+
+			Convey("Then missing broker should result in an error", func() {
+				delete(params, "broker")
+				_, err := createMQTTSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then missing topics should result in an error", func() {
+				delete(params, "topics")
+				_, err := createMQTTSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestMQTTSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a fake MQTT client", t, func() {
+		client := &testMQTTClient{}
+		setUpTestMQTTClient(client)
+		params := data.Map{
+			"broker": data.String("tcp://localhost:1883"),
+			"topic":  data.String("out"),
+		}
+		create := func() core.Sink {
+			s, err := createMQTTSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			return s
+		}
+
+		Convey("When writing a tuple with default parameters", func() {
+			params["retained"] = data.True
+			s := create()
+			So(s.Write(ctx, core.NewTuple(data.Map{"i": data.Int(1)})), ShouldBeNil)
+
+			Convey("Then it should be published as JSON", func() {
+				So(client.published, ShouldResemble, []testMQTTPublish{{"out", 0, true, `{"i":1}`}})
+			})
+		})
+
+		Convey("When writing tuples with topic_field", func() {
+			params["topic_field"] = data.String("dst.topic")
+			s := create()
+			So(s.Write(ctx, core.NewTuple(data.Map{"dst": data.Map{"topic": data.String("a/b")}})), ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{"i": data.Int(1)})), ShouldBeNil)
+
+			Convey("Then they should be published to the topic in the field or the default topic", func() {
+				So(client.published, ShouldHaveLength, 2)
+				So(client.published[0].topic, ShouldEqual, "a/b")
+				So(client.published[1].topic, ShouldEqual, "out")
+			})
+		})
+
+		Convey("When writing a tuple without any topic", func() {
+			delete(params, "topic")
+			params["topic_field"] = data.String("topic")
+			s := create()
+
+			Convey("Then it should fail", func() {
+				So(s.Write(ctx, core.NewTuple(data.Map{"i": data.Int(1)})), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing tuples with raw payloads", func() {
+			params["payload"] = data.String("raw")
+			s := create()
+			So(s.Write(ctx, core.NewTuple(data.Map{"payload": data.String("hello")})), ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{"payload": data.Blob([]byte{0, 1})})), ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{"payload": data.Int(3)})), ShouldBeNil)
+
+			Convey("Then the payload field should be published", func() {
+				So(client.published, ShouldHaveLength, 3)
+				So(client.published[0].payload, ShouldEqual, "hello")
+				So(client.published[1].payload, ShouldEqual, "\x00\x01")
+				So(client.published[2].payload, ShouldEqual, "3")
+			})
+
+			Convey("Then a tuple without the payload field should fail", func() {
+				So(s.Write(ctx, core.NewTuple(data.Map{"i": data.Int(1)})), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When publishing fails while the connection is lost", func() {
+			s := create()
+			client.Disconnect(0)
+			client.pubErr = errors.New("not connected")
+			err := s.Write(ctx, core.NewTuple(data.Map{"i": data.Int(1)}))
+
+			Convey("Then it should return a temporary error", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsTemporaryError(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When it cannot connect to the broker", func() {
+			client.connErr = errors.New("connection refused")
+			_, err := createMQTTSink(ctx, &IOParams{}, params)
+
+			Convey("Then creating the sink should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating the sink with invalid parameters", func() {
+			Convey("Then a wildcard in topic should result in an error", func() {
+				params["topic"] = data.String("out/#")
+				_, err := createMQTTSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then missing topic and topic_field should result in an error", func() {
+				delete(params, "topic")
+				_, err := createMQTTSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid topic_field should result in an error", func() {
+				params["topic_field"] = data.String("/this/isnt/a/xpath")
+				_, err := createMQTTSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
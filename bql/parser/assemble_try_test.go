@@ -0,0 +1,87 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleTryCast(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When there are two correct items in the given range", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(3, 4, RowValue{"", "b"})
+			ps.PushComponent(4, 5, Int)
+			ps.AssembleTryCast(3, 5)
+
+			Convey("Then AssembleTryCast wraps a type cast", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 3)
+				So(top.end, ShouldEqual, 5)
+				So(top.comp, ShouldResemble, TryAST{TypeCastAST{RowValue{"", "b"}, Int}, nil})
+			})
+		})
+
+		Convey("When there is one item in the given range", func() {
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			f := func() {
+				ps.AssembleTryCast(2, 3)
+			}
+
+			Convey("Then AssembleTryCast panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+}
+
+func TestAssembleTry(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When there is one item in the given range", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			ps.AssembleTry(2, 3)
+
+			Convey("Then AssembleTry wraps it without a fallback", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 2)
+				So(top.end, ShouldEqual, 3)
+				So(top.comp, ShouldResemble, TryAST{RowValue{"", "a"}, nil})
+			})
+		})
+
+		Convey("When there are two items in the given range", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			ps.PushComponent(4, 5, NumericLiteral{0})
+			ps.AssembleTry(2, 5)
+
+			Convey("Then AssembleTry wraps them with a fallback", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 2)
+				So(top.end, ShouldEqual, 5)
+				So(top.comp, ShouldResemble, TryAST{RowValue{"", "a"}, NumericLiteral{0}})
+			})
+		})
+
+		Convey("When there are no items in the given range", func() {
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			f := func() {
+				ps.AssembleTry(4, 5)
+			}
+
+			Convey("Then AssembleTry panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+}
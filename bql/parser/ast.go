@@ -703,10 +703,51 @@ func (u TypeCastAST) String() string {
 	return "CAST(" + u.Expr.String() + " AS " + u.Target.String() + ")"
 }
 
+// TryAST is an expression that evaluates to the Fallback expression,
+// or NULL if Fallback is nil, when the evaluation of Expr fails.
+// TRY_CAST(x AS t) is represented as TryAST{TypeCastAST{x, t}, nil}.
+type TryAST struct {
+	Expr     Expression
+	Fallback Expression
+}
+
+func (t TryAST) ReferencedRelations() map[string]bool {
+	rels := t.Expr.ReferencedRelations()
+	if t.Fallback != nil {
+		for rel := range t.Fallback.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (t TryAST) RenameReferencedRelation(from, to string) Expression {
+	if t.Fallback != nil {
+		return TryAST{t.Expr.RenameReferencedRelation(from, to),
+			t.Fallback.RenameReferencedRelation(from, to)}
+	}
+	return TryAST{t.Expr.RenameReferencedRelation(from, to), nil}
+}
+
+func (t TryAST) Foldable() bool {
+	return t.Expr.Foldable() && (t.Fallback == nil || t.Fallback.Foldable())
+}
+
+func (t TryAST) String() string {
+	if t.Fallback != nil {
+		return "TRY(" + t.Expr.String() + ", " + t.Fallback.String() + ")"
+	}
+	if c, ok := t.Expr.(TypeCastAST); ok {
+		return "TRY_CAST(" + c.Expr.String() + " AS " + c.Target.String() + ")"
+	}
+	return "TRY(" + t.Expr.String() + ")"
+}
+
 type FuncAppAST struct {
 	Function FuncName
 	ExpressionsAST
 	Ordering []SortedExpressionAST
+	Filter   Expression
 }
 
 func (f FuncAppAST) ReferencedRelations() map[string]bool {
@@ -721,6 +762,11 @@ func (f FuncAppAST) ReferencedRelations() map[string]bool {
 			rels[rel] = true
 		}
 	}
+	if f.Filter != nil {
+		for rel := range f.Filter.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
 	return rels
 }
 
@@ -733,7 +779,11 @@ func (f FuncAppAST) RenameReferencedRelation(from, to string) Expression {
 	for i, expr := range f.Ordering {
 		newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
 	}
-	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs}
+	var newFilter Expression
+	if f.Filter != nil {
+		newFilter = f.Filter.RenameReferencedRelation(from, to)
+	}
+	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs, newFilter}
 }
 
 func (f FuncAppAST) Foldable() bool {
@@ -747,6 +797,10 @@ func (f FuncAppAST) Foldable() bool {
 	if len(f.Ordering) > 0 {
 		return false
 	}
+	// the same holds for a FILTER clause
+	if f.Filter != nil {
+		return false
+	}
 	for _, expr := range f.Expressions {
 		if !expr.Foldable() {
 			foldable = false
@@ -765,7 +819,11 @@ func (f FuncAppAST) String() string {
 		}
 		s += " ORDER BY " + strings.Join(orderStrings, ", ")
 	}
-	return s + ")"
+	s += ")"
+	if f.Filter != nil {
+		s += " FILTER (WHERE " + f.Filter.String() + ")"
+	}
+	return s
 }
 
 type FuncAppSelectorAST struct {
@@ -99,6 +99,8 @@ const (
 	rulecastExpr
 	rulebaseExpr
 	ruleFuncTypeCast
+	ruleFuncTryCast
+	ruleTry
 	ruleFuncApp
 	ruleFuncAppSelector
 	ruleFuncElemAccessor
@@ -106,6 +108,7 @@ const (
 	ruleFuncAppWithoutOrderBy
 	ruleFuncParams
 	ruleParamsOrder
+	ruleFuncFilterOpt
 	ruleSortedExpression
 	ruleOrderDirectionOpt
 	ruleArrayExpr
@@ -339,6 +342,10 @@ const (
 	ruleAction133
 	ruleAction134
 	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
 )
 
 var rul3s = [...]string{
@@ -428,6 +435,8 @@ var rul3s = [...]string{
 	"castExpr",
 	"baseExpr",
 	"FuncTypeCast",
+	"FuncTryCast",
+	"Try",
 	"FuncApp",
 	"FuncAppSelector",
 	"FuncElemAccessor",
@@ -435,6 +444,7 @@ var rul3s = [...]string{
 	"FuncAppWithoutOrderBy",
 	"FuncParams",
 	"ParamsOrder",
+	"FuncFilterOpt",
 	"SortedExpression",
 	"OrderDirectionOpt",
 	"ArrayExpr",
@@ -668,6 +678,10 @@ var rul3s = [...]string{
 	"Action133",
 	"Action134",
 	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
 }
 
 type token32 struct {
@@ -784,7 +798,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [326]func() bool
+	rules  [333]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -1147,301 +1161,319 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction65:
 
-			p.AssembleFuncAppSelector()
+			p.AssembleTryCast(begin, end)
 
 		case ruleAction66:
 
+			p.AssembleTry(begin, end)
+
+		case ruleAction67:
+
+			p.AssembleFuncAppFilter()
+
+		case ruleAction68:
+
+			p.AssembleFuncAppSelector()
+
+		case ruleAction69:
+
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewRaw(substr))
 
-		case ruleAction67:
+		case ruleAction70:
 
 			p.AssembleFuncApp()
 
-		case ruleAction68:
+		case ruleAction71:
 
 			p.AssembleExpressions(begin, end)
 			p.AssembleFuncApp()
 
-		case ruleAction69:
+		case ruleAction72:
 
 			p.AssembleExpressions(begin, end)
 
-		case ruleAction70:
+		case ruleAction73:
 
 			p.AssembleExpressions(begin, end)
 
-		case ruleAction71:
+		case ruleAction74:
+
+			// This is *always* executed, even if there is no
+			// FILTER clause present in the function call.
+			p.AssembleFilter(begin, end)
+
+		case ruleAction75:
 
 			p.AssembleSortedExpression()
 
-		case ruleAction72:
+		case ruleAction76:
 
 			p.EnsureKeywordPresent(begin, end)
 
-		case ruleAction73:
+		case ruleAction77:
 
 			p.AssembleExpressions(begin, end)
 			p.AssembleArray()
 
-		case ruleAction74:
+		case ruleAction78:
 
 			p.AssembleMap(begin, end)
 
-		case ruleAction75:
+		case ruleAction79:
 
 			p.AssembleKeyValuePair()
 
-		case ruleAction76:
+		case ruleAction80:
 
 			p.AssembleConditionCase(begin, end)
 
-		case ruleAction77:
+		case ruleAction81:
 
 			p.AssembleExpressionCase(begin, end)
 
-		case ruleAction78:
+		case ruleAction82:
 
 			p.AssembleWhenThenPair()
 
-		case ruleAction79:
+		case ruleAction83:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewStream(substr))
 
-		case ruleAction80:
+		case ruleAction84:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
-		case ruleAction81:
+		case ruleAction85:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewRowValue(substr))
 
-		case ruleAction82:
+		case ruleAction86:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
-		case ruleAction83:
+		case ruleAction87:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
-		case ruleAction84:
+		case ruleAction88:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
-		case ruleAction85:
+		case ruleAction89:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, FuncName(substr))
 
-		case ruleAction86:
+		case ruleAction90:
 
 			p.PushComponent(begin, end, NewNullLiteral())
 
-		case ruleAction87:
+		case ruleAction91:
 
 			p.PushComponent(begin, end, NewMissing())
 
-		case ruleAction88:
+		case ruleAction92:
 
 			p.PushComponent(begin, end, NewBoolLiteral(true))
 
-		case ruleAction89:
+		case ruleAction93:
 
 			p.PushComponent(begin, end, NewBoolLiteral(false))
 
-		case ruleAction90:
+		case ruleAction94:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewWildcard(substr))
 
-		case ruleAction91:
+		case ruleAction95:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, NewStringLiteral(substr))
 
-		case ruleAction92:
+		case ruleAction96:
 
 			p.PushComponent(begin, end, Istream)
 
-		case ruleAction93:
+		case ruleAction97:
 
 			p.PushComponent(begin, end, Dstream)
 
-		case ruleAction94:
+		case ruleAction98:
 
 			p.PushComponent(begin, end, Rstream)
 
-		case ruleAction95:
+		case ruleAction99:
 
 			p.PushComponent(begin, end, Tuples)
 
-		case ruleAction96:
+		case ruleAction100:
 
 			p.PushComponent(begin, end, Seconds)
 
-		case ruleAction97:
+		case ruleAction101:
 
 			p.PushComponent(begin, end, Milliseconds)
 
-		case ruleAction98:
+		case ruleAction102:
 
 			p.PushComponent(begin, end, Wait)
 
-		case ruleAction99:
+		case ruleAction103:
 
 			p.PushComponent(begin, end, DropOldest)
 
-		case ruleAction100:
+		case ruleAction104:
 
 			p.PushComponent(begin, end, DropNewest)
 
-		case ruleAction101:
+		case ruleAction105:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, StreamIdentifier(substr))
 
-		case ruleAction102:
+		case ruleAction106:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, SourceSinkType(substr))
 
-		case ruleAction103:
+		case ruleAction107:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, SourceSinkParamKey(substr))
 
-		case ruleAction104:
+		case ruleAction108:
 
 			p.PushComponent(begin, end, Yes)
 
-		case ruleAction105:
+		case ruleAction109:
 
 			p.PushComponent(begin, end, No)
 
-		case ruleAction106:
+		case ruleAction110:
 
 			p.PushComponent(begin, end, Yes)
 
-		case ruleAction107:
+		case ruleAction111:
 
 			p.PushComponent(begin, end, No)
 
-		case ruleAction108:
+		case ruleAction112:
 
 			p.PushComponent(begin, end, Bool)
 
-		case ruleAction109:
+		case ruleAction113:
 
 			p.PushComponent(begin, end, Int)
 
-		case ruleAction110:
+		case ruleAction114:
 
 			p.PushComponent(begin, end, Float)
 
-		case ruleAction111:
+		case ruleAction115:
 
 			p.PushComponent(begin, end, String)
 
-		case ruleAction112:
+		case ruleAction116:
 
 			p.PushComponent(begin, end, Blob)
 
-		case ruleAction113:
+		case ruleAction117:
 
 			p.PushComponent(begin, end, Timestamp)
 
-		case ruleAction114:
+		case ruleAction118:
 
 			p.PushComponent(begin, end, Array)
 
-		case ruleAction115:
+		case ruleAction119:
 
 			p.PushComponent(begin, end, Map)
 
-		case ruleAction116:
+		case ruleAction120:
 
 			p.PushComponent(begin, end, Or)
 
-		case ruleAction117:
+		case ruleAction121:
 
 			p.PushComponent(begin, end, And)
 
-		case ruleAction118:
+		case ruleAction122:
 
 			p.PushComponent(begin, end, Not)
 
-		case ruleAction119:
+		case ruleAction123:
 
 			p.PushComponent(begin, end, Equal)
 
-		case ruleAction120:
+		case ruleAction124:
 
 			p.PushComponent(begin, end, Less)
 
-		case ruleAction121:
+		case ruleAction125:
 
 			p.PushComponent(begin, end, LessOrEqual)
 
-		case ruleAction122:
+		case ruleAction126:
 
 			p.PushComponent(begin, end, Greater)
 
-		case ruleAction123:
+		case ruleAction127:
 
 			p.PushComponent(begin, end, GreaterOrEqual)
 
-		case ruleAction124:
+		case ruleAction128:
 
 			p.PushComponent(begin, end, NotEqual)
 
-		case ruleAction125:
+		case ruleAction129:
 
 			p.PushComponent(begin, end, Concat)
 
-		case ruleAction126:
+		case ruleAction130:
 
 			p.PushComponent(begin, end, Is)
 
-		case ruleAction127:
+		case ruleAction131:
 
 			p.PushComponent(begin, end, IsNot)
 
-		case ruleAction128:
+		case ruleAction132:
 
 			p.PushComponent(begin, end, Plus)
 
-		case ruleAction129:
+		case ruleAction133:
 
 			p.PushComponent(begin, end, Minus)
 
-		case ruleAction130:
+		case ruleAction134:
 
 			p.PushComponent(begin, end, Multiply)
 
-		case ruleAction131:
+		case ruleAction135:
 
 			p.PushComponent(begin, end, Divide)
 
-		case ruleAction132:
+		case ruleAction136:
 
 			p.PushComponent(begin, end, Modulo)
 
-		case ruleAction133:
+		case ruleAction137:
 
 			p.PushComponent(begin, end, UnaryMinus)
 
-		case ruleAction134:
+		case ruleAction138:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
 
-		case ruleAction135:
+		case ruleAction139:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
@@ -10283,7 +10315,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position1108, tokenIndex1108
 			return false
 		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
+		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncTryCast / Try / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
 		func() bool {
 			position1113, tokenIndex1113 := position, tokenIndex
 			{
@@ -10346,29 +10378,41 @@ func (p *bqlPegBackend) Init() {
 					goto l1115
 				l1122:
 					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncAppSelector]() {
+					if !_rules[ruleFuncTryCast]() {
 						goto l1123
 					}
 					goto l1115
 				l1123:
 					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncApp]() {
+					if !_rules[ruleTry]() {
 						goto l1124
 					}
 					goto l1115
 				l1124:
 					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowValue]() {
+					if !_rules[ruleFuncAppSelector]() {
 						goto l1125
 					}
 					goto l1115
 				l1125:
 					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleArrayExpr]() {
+					if !_rules[ruleFuncApp]() {
 						goto l1126
 					}
 					goto l1115
 				l1126:
+					position, tokenIndex = position1115, tokenIndex1115
+					if !_rules[ruleRowValue]() {
+						goto l1127
+					}
+					goto l1115
+				l1127:
+					position, tokenIndex = position1115, tokenIndex1115
+					if !_rules[ruleArrayExpr]() {
+						goto l1128
+					}
+					goto l1115
+				l1128:
 					position, tokenIndex = position1115, tokenIndex1115
 					if !_rules[ruleLiteral]() {
 						goto l1113
@@ -10384,6518 +10428,7032 @@ func (p *bqlPegBackend) Init() {
 		},
 		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
 		func() bool {
-			position1127, tokenIndex1127 := position, tokenIndex
+			position1129, tokenIndex1129 := position, tokenIndex
 			{
-				position1128 := position
+				position1130 := position
 				{
-					position1129 := position
-					{
-						position1130, tokenIndex1130 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1131
-						}
-						position++
-						goto l1130
-					l1131:
-						position, tokenIndex = position1130, tokenIndex1130
-						if buffer[position] != rune('C') {
-							goto l1127
-						}
-						position++
-					}
-				l1130:
+					position1131 := position
 					{
 						position1132, tokenIndex1132 := position, tokenIndex
-						if buffer[position] != rune('a') {
+						if buffer[position] != rune('c') {
 							goto l1133
 						}
 						position++
 						goto l1132
 					l1133:
 						position, tokenIndex = position1132, tokenIndex1132
-						if buffer[position] != rune('A') {
-							goto l1127
+						if buffer[position] != rune('C') {
+							goto l1129
 						}
 						position++
 					}
 				l1132:
 					{
 						position1134, tokenIndex1134 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						if buffer[position] != rune('a') {
 							goto l1135
 						}
 						position++
 						goto l1134
 					l1135:
 						position, tokenIndex = position1134, tokenIndex1134
-						if buffer[position] != rune('S') {
-							goto l1127
+						if buffer[position] != rune('A') {
+							goto l1129
 						}
 						position++
 					}
 				l1134:
 					{
 						position1136, tokenIndex1136 := position, tokenIndex
-						if buffer[position] != rune('t') {
+						if buffer[position] != rune('s') {
 							goto l1137
 						}
 						position++
 						goto l1136
 					l1137:
 						position, tokenIndex = position1136, tokenIndex1136
-						if buffer[position] != rune('T') {
-							goto l1127
+						if buffer[position] != rune('S') {
+							goto l1129
 						}
 						position++
 					}
 				l1136:
+					{
+						position1138, tokenIndex1138 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1139
+						}
+						position++
+						goto l1138
+					l1139:
+						position, tokenIndex = position1138, tokenIndex1138
+						if buffer[position] != rune('T') {
+							goto l1129
+						}
+						position++
+					}
+				l1138:
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1129
 					}
 					if buffer[position] != rune('(') {
-						goto l1127
+						goto l1129
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1129
 					}
 					if !_rules[ruleExpression]() {
-						goto l1127
+						goto l1129
 					}
 					if !_rules[rulesp]() {
-						goto l1127
+						goto l1129
 					}
 					{
-						position1138, tokenIndex1138 := position, tokenIndex
+						position1140, tokenIndex1140 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1139
+							goto l1141
 						}
 						position++
-						goto l1138
-					l1139:
-						position, tokenIndex = position1138, tokenIndex1138
+						goto l1140
+					l1141:
+						position, tokenIndex = position1140, tokenIndex1140
 						if buffer[position] != rune('A') {
-							goto l1127
+							goto l1129
 						}
 						position++
 					}
-				l1138:
+				l1140:
 					{
-						position1140, tokenIndex1140 := position, tokenIndex
+						position1142, tokenIndex1142 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1141
+							goto l1143
 						}
 						position++
-						goto l1140
-					l1141:
-						position, tokenIndex = position1140, tokenIndex1140
+						goto l1142
+					l1143:
+						position, tokenIndex = position1142, tokenIndex1142
 						if buffer[position] != rune('S') {
-							goto l1127
+							goto l1129
 						}
 						position++
 					}
-				l1140:
+				l1142:
 					if !_rules[rulesp]() {
-						goto l1127
+						goto l1129
 					}
 					if !_rules[ruleType]() {
-						goto l1127
+						goto l1129
 					}
 					if !_rules[rulespOpt]() {
-						goto l1127
+						goto l1129
 					}
 					if buffer[position] != rune(')') {
-						goto l1127
+						goto l1129
 					}
 					position++
-					add(rulePegText, position1129)
+					add(rulePegText, position1131)
 				}
 				if !_rules[ruleAction64]() {
-					goto l1127
+					goto l1129
 				}
-				add(ruleFuncTypeCast, position1128)
+				add(ruleFuncTypeCast, position1130)
 			}
 			return true
-		l1127:
-			position, tokenIndex = position1127, tokenIndex1127
+		l1129:
+			position, tokenIndex = position1129, tokenIndex1129
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 85 FuncTryCast <- <(<(('t' / 'T') ('r' / 'R') ('y' / 'Y') '_' ('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action65)> */
 		func() bool {
-			position1142, tokenIndex1142 := position, tokenIndex
+			position1144, tokenIndex1144 := position, tokenIndex
 			{
-				position1143 := position
+				position1145 := position
 				{
-					position1144, tokenIndex1144 := position, tokenIndex
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
+					position1146 := position
+					{
+						position1147, tokenIndex1147 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1148
+						}
+						position++
+						goto l1147
+					l1148:
+						position, tokenIndex = position1147, tokenIndex1147
+						if buffer[position] != rune('T') {
+							goto l1144
+						}
+						position++
 					}
-					goto l1144
-				l1145:
-					position, tokenIndex = position1144, tokenIndex1144
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
+				l1147:
+					{
+						position1149, tokenIndex1149 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1150
+						}
+						position++
+						goto l1149
+					l1150:
+						position, tokenIndex = position1149, tokenIndex1149
+						if buffer[position] != rune('R') {
+							goto l1144
+						}
+						position++
 					}
-				}
-			l1144:
-				add(ruleFuncApp, position1143)
-			}
-			return true
-		l1142:
-			position, tokenIndex = position1142, tokenIndex1142
-			return false
-		},
-		/* 86 FuncAppSelector <- <(FuncApp FuncElemAccessor Action65)> */
-		func() bool {
-			position1146, tokenIndex1146 := position, tokenIndex
-			{
-				position1147 := position
-				if !_rules[ruleFuncApp]() {
-					goto l1146
-				}
-				if !_rules[ruleFuncElemAccessor]() {
-					goto l1146
-				}
+				l1149:
+					{
+						position1151, tokenIndex1151 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1152
+						}
+						position++
+						goto l1151
+					l1152:
+						position, tokenIndex = position1151, tokenIndex1151
+						if buffer[position] != rune('Y') {
+							goto l1144
+						}
+						position++
+					}
+				l1151:
+					if buffer[position] != rune('_') {
+						goto l1144
+					}
+					position++
+					{
+						position1153, tokenIndex1153 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1154
+						}
+						position++
+						goto l1153
+					l1154:
+						position, tokenIndex = position1153, tokenIndex1153
+						if buffer[position] != rune('C') {
+							goto l1144
+						}
+						position++
+					}
+				l1153:
+					{
+						position1155, tokenIndex1155 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1156
+						}
+						position++
+						goto l1155
+					l1156:
+						position, tokenIndex = position1155, tokenIndex1155
+						if buffer[position] != rune('A') {
+							goto l1144
+						}
+						position++
+					}
+				l1155:
+					{
+						position1157, tokenIndex1157 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1158
+						}
+						position++
+						goto l1157
+					l1158:
+						position, tokenIndex = position1157, tokenIndex1157
+						if buffer[position] != rune('S') {
+							goto l1144
+						}
+						position++
+					}
+				l1157:
+					{
+						position1159, tokenIndex1159 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1160
+						}
+						position++
+						goto l1159
+					l1160:
+						position, tokenIndex = position1159, tokenIndex1159
+						if buffer[position] != rune('T') {
+							goto l1144
+						}
+						position++
+					}
+				l1159:
+					if !_rules[rulespOpt]() {
+						goto l1144
+					}
+					if buffer[position] != rune('(') {
+						goto l1144
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1144
+					}
+					if !_rules[ruleExpression]() {
+						goto l1144
+					}
+					if !_rules[rulesp]() {
+						goto l1144
+					}
+					{
+						position1161, tokenIndex1161 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1162
+						}
+						position++
+						goto l1161
+					l1162:
+						position, tokenIndex = position1161, tokenIndex1161
+						if buffer[position] != rune('A') {
+							goto l1144
+						}
+						position++
+					}
+				l1161:
+					{
+						position1163, tokenIndex1163 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1164
+						}
+						position++
+						goto l1163
+					l1164:
+						position, tokenIndex = position1163, tokenIndex1163
+						if buffer[position] != rune('S') {
+							goto l1144
+						}
+						position++
+					}
+				l1163:
+					if !_rules[rulesp]() {
+						goto l1144
+					}
+					if !_rules[ruleType]() {
+						goto l1144
+					}
+					if !_rules[rulespOpt]() {
+						goto l1144
+					}
+					if buffer[position] != rune(')') {
+						goto l1144
+					}
+					position++
+					add(rulePegText, position1146)
+				}
 				if !_rules[ruleAction65]() {
-					goto l1146
+					goto l1144
 				}
-				add(ruleFuncAppSelector, position1147)
+				add(ruleFuncTryCast, position1145)
 			}
 			return true
-		l1146:
-			position, tokenIndex = position1146, tokenIndex1146
+		l1144:
+			position, tokenIndex = position1144, tokenIndex1144
 			return false
 		},
-		/* 87 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action66)> */
+		/* 86 Try <- <(<(('t' / 'T') ('r' / 'R') ('y' / 'Y') spOpt '(' spOpt Expression (spOpt ',' spOpt Expression)? spOpt ')')> Action66)> */
 		func() bool {
-			position1148, tokenIndex1148 := position, tokenIndex
+			position1165, tokenIndex1165 := position, tokenIndex
 			{
-				position1149 := position
+				position1166 := position
 				{
-					position1150 := position
+					position1167 := position
+					{
+						position1168, tokenIndex1168 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1169
+						}
+						position++
+						goto l1168
+					l1169:
+						position, tokenIndex = position1168, tokenIndex1168
+						if buffer[position] != rune('T') {
+							goto l1165
+						}
+						position++
+					}
+				l1168:
+					{
+						position1170, tokenIndex1170 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1171
+						}
+						position++
+						goto l1170
+					l1171:
+						position, tokenIndex = position1170, tokenIndex1170
+						if buffer[position] != rune('R') {
+							goto l1165
+						}
+						position++
+					}
+				l1170:
+					{
+						position1172, tokenIndex1172 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1173
+						}
+						position++
+						goto l1172
+					l1173:
+						position, tokenIndex = position1172, tokenIndex1172
+						if buffer[position] != rune('Y') {
+							goto l1165
+						}
+						position++
+					}
+				l1172:
+					if !_rules[rulespOpt]() {
+						goto l1165
+					}
+					if buffer[position] != rune('(') {
+						goto l1165
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1165
+					}
+					if !_rules[ruleExpression]() {
+						goto l1165
+					}
+					{
+						position1174, tokenIndex1174 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1174
+						}
+						if buffer[position] != rune(',') {
+							goto l1174
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1174
+						}
+						if !_rules[ruleExpression]() {
+							goto l1174
+						}
+						goto l1175
+					l1174:
+						position, tokenIndex = position1174, tokenIndex1174
+					}
+				l1175:
+					if !_rules[rulespOpt]() {
+						goto l1165
+					}
+					if buffer[position] != rune(')') {
+						goto l1165
+					}
+					position++
+					add(rulePegText, position1167)
+				}
+				if !_rules[ruleAction66]() {
+					goto l1165
+				}
+				add(ruleTry, position1166)
+			}
+			return true
+		l1165:
+			position, tokenIndex = position1165, tokenIndex1165
+			return false
+		},
+		/* 87 FuncApp <- <((FuncAppWithOrderBy / FuncAppWithoutOrderBy) FuncFilterOpt Action67)> */
+		func() bool {
+			position1176, tokenIndex1176 := position, tokenIndex
+			{
+				position1177 := position
+				{
+					position1178, tokenIndex1178 := position, tokenIndex
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1179
+					}
+					goto l1178
+				l1179:
+					position, tokenIndex = position1178, tokenIndex1178
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1176
+					}
+				}
+			l1178:
+				if !_rules[ruleFuncFilterOpt]() {
+					goto l1176
+				}
+				if !_rules[ruleAction67]() {
+					goto l1176
+				}
+				add(ruleFuncApp, position1177)
+			}
+			return true
+		l1176:
+			position, tokenIndex = position1176, tokenIndex1176
+			return false
+		},
+		/* 88 FuncAppSelector <- <(FuncApp FuncElemAccessor Action68)> */
+		func() bool {
+			position1180, tokenIndex1180 := position, tokenIndex
+			{
+				position1181 := position
+				if !_rules[ruleFuncApp]() {
+					goto l1180
+				}
+				if !_rules[ruleFuncElemAccessor]() {
+					goto l1180
+				}
+				if !_rules[ruleAction68]() {
+					goto l1180
+				}
+				add(ruleFuncAppSelector, position1181)
+			}
+			return true
+		l1180:
+			position, tokenIndex = position1180, tokenIndex1180
+			return false
+		},
+		/* 89 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action69)> */
+		func() bool {
+			position1182, tokenIndex1182 := position, tokenIndex
+			{
+				position1183 := position
+				{
+					position1184 := position
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1148
+						goto l1182
 					}
-				l1151:
+				l1185:
 					{
-						position1152, tokenIndex1152 := position, tokenIndex
+						position1186, tokenIndex1186 := position, tokenIndex
 						if !_rules[rulejsonGetPathNonHead]() {
-							goto l1152
+							goto l1186
 						}
-						goto l1151
-					l1152:
-						position, tokenIndex = position1152, tokenIndex1152
+						goto l1185
+					l1186:
+						position, tokenIndex = position1186, tokenIndex1186
 					}
-					add(rulePegText, position1150)
+					add(rulePegText, position1184)
 				}
-				if !_rules[ruleAction66]() {
-					goto l1148
+				if !_rules[ruleAction69]() {
+					goto l1182
 				}
-				add(ruleFuncElemAccessor, position1149)
+				add(ruleFuncElemAccessor, position1183)
 			}
 			return true
-		l1148:
-			position, tokenIndex = position1148, tokenIndex1148
+		l1182:
+			position, tokenIndex = position1182, tokenIndex1182
 			return false
 		},
-		/* 88 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action67)> */
+		/* 90 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action70)> */
 		func() bool {
-			position1153, tokenIndex1153 := position, tokenIndex
+			position1187, tokenIndex1187 := position, tokenIndex
 			{
-				position1154 := position
+				position1188 := position
 				if !_rules[ruleFunction]() {
-					goto l1153
+					goto l1187
 				}
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1187
 				}
 				if buffer[position] != rune('(') {
-					goto l1153
+					goto l1187
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1187
 				}
 				if !_rules[ruleFuncParams]() {
-					goto l1153
+					goto l1187
 				}
 				if !_rules[rulesp]() {
-					goto l1153
+					goto l1187
 				}
 				if !_rules[ruleParamsOrder]() {
-					goto l1153
+					goto l1187
 				}
 				if !_rules[rulespOpt]() {
-					goto l1153
+					goto l1187
 				}
 				if buffer[position] != rune(')') {
-					goto l1153
+					goto l1187
 				}
 				position++
-				if !_rules[ruleAction67]() {
-					goto l1153
+				if !_rules[ruleAction70]() {
+					goto l1187
 				}
-				add(ruleFuncAppWithOrderBy, position1154)
+				add(ruleFuncAppWithOrderBy, position1188)
 			}
 			return true
-		l1153:
-			position, tokenIndex = position1153, tokenIndex1153
+		l1187:
+			position, tokenIndex = position1187, tokenIndex1187
 			return false
 		},
-		/* 89 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action68)> */
+		/* 91 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action71)> */
 		func() bool {
-			position1155, tokenIndex1155 := position, tokenIndex
+			position1189, tokenIndex1189 := position, tokenIndex
 			{
-				position1156 := position
+				position1190 := position
 				if !_rules[ruleFunction]() {
-					goto l1155
+					goto l1189
 				}
 				if !_rules[rulespOpt]() {
-					goto l1155
+					goto l1189
 				}
 				if buffer[position] != rune('(') {
-					goto l1155
+					goto l1189
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1155
+					goto l1189
 				}
 				if !_rules[ruleFuncParams]() {
-					goto l1155
+					goto l1189
 				}
 				{
-					position1157 := position
+					position1191 := position
 					if !_rules[rulespOpt]() {
-						goto l1155
+						goto l1189
 					}
-					add(rulePegText, position1157)
+					add(rulePegText, position1191)
 				}
 				if buffer[position] != rune(')') {
-					goto l1155
+					goto l1189
 				}
 				position++
-				if !_rules[ruleAction68]() {
-					goto l1155
+				if !_rules[ruleAction71]() {
+					goto l1189
 				}
-				add(ruleFuncAppWithoutOrderBy, position1156)
+				add(ruleFuncAppWithoutOrderBy, position1190)
 			}
 			return true
-		l1155:
-			position, tokenIndex = position1155, tokenIndex1155
+		l1189:
+			position, tokenIndex = position1189, tokenIndex1189
 			return false
 		},
-		/* 90 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action69)> */
+		/* 92 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action72)> */
 		func() bool {
-			position1158, tokenIndex1158 := position, tokenIndex
+			position1192, tokenIndex1192 := position, tokenIndex
 			{
-				position1159 := position
+				position1193 := position
 				{
-					position1160 := position
+					position1194 := position
 					{
-						position1161, tokenIndex1161 := position, tokenIndex
+						position1195, tokenIndex1195 := position, tokenIndex
 						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1161
+							goto l1195
 						}
-					l1163:
+					l1197:
 						{
-							position1164, tokenIndex1164 := position, tokenIndex
+							position1198, tokenIndex1198 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1164
+								goto l1198
 							}
 							if buffer[position] != rune(',') {
-								goto l1164
+								goto l1198
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1164
+								goto l1198
 							}
 							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1164
+								goto l1198
 							}
-							goto l1163
-						l1164:
-							position, tokenIndex = position1164, tokenIndex1164
+							goto l1197
+						l1198:
+							position, tokenIndex = position1198, tokenIndex1198
 						}
-						goto l1162
-					l1161:
-						position, tokenIndex = position1161, tokenIndex1161
+						goto l1196
+					l1195:
+						position, tokenIndex = position1195, tokenIndex1195
 					}
-				l1162:
-					add(rulePegText, position1160)
+				l1196:
+					add(rulePegText, position1194)
 				}
-				if !_rules[ruleAction69]() {
-					goto l1158
+				if !_rules[ruleAction72]() {
+					goto l1192
 				}
-				add(ruleFuncParams, position1159)
+				add(ruleFuncParams, position1193)
 			}
 			return true
-		l1158:
-			position, tokenIndex = position1158, tokenIndex1158
+		l1192:
+			position, tokenIndex = position1192, tokenIndex1192
 			return false
 		},
-		/* 91 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action70)> */
+		/* 93 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action73)> */
 		func() bool {
-			position1165, tokenIndex1165 := position, tokenIndex
+			position1199, tokenIndex1199 := position, tokenIndex
 			{
-				position1166 := position
+				position1200 := position
 				{
-					position1167 := position
+					position1201 := position
 					{
-						position1168, tokenIndex1168 := position, tokenIndex
+						position1202, tokenIndex1202 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1169
+							goto l1203
 						}
 						position++
-						goto l1168
-					l1169:
-						position, tokenIndex = position1168, tokenIndex1168
+						goto l1202
+					l1203:
+						position, tokenIndex = position1202, tokenIndex1202
 						if buffer[position] != rune('O') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1168:
+				l1202:
 					{
-						position1170, tokenIndex1170 := position, tokenIndex
+						position1204, tokenIndex1204 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1171
+							goto l1205
 						}
 						position++
-						goto l1170
-					l1171:
-						position, tokenIndex = position1170, tokenIndex1170
+						goto l1204
+					l1205:
+						position, tokenIndex = position1204, tokenIndex1204
 						if buffer[position] != rune('R') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1170:
+				l1204:
 					{
-						position1172, tokenIndex1172 := position, tokenIndex
+						position1206, tokenIndex1206 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1173
+							goto l1207
 						}
 						position++
-						goto l1172
-					l1173:
-						position, tokenIndex = position1172, tokenIndex1172
+						goto l1206
+					l1207:
+						position, tokenIndex = position1206, tokenIndex1206
 						if buffer[position] != rune('D') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1172:
+				l1206:
 					{
-						position1174, tokenIndex1174 := position, tokenIndex
+						position1208, tokenIndex1208 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1175
+							goto l1209
 						}
 						position++
-						goto l1174
-					l1175:
-						position, tokenIndex = position1174, tokenIndex1174
+						goto l1208
+					l1209:
+						position, tokenIndex = position1208, tokenIndex1208
 						if buffer[position] != rune('E') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1174:
+				l1208:
 					{
-						position1176, tokenIndex1176 := position, tokenIndex
+						position1210, tokenIndex1210 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1177
+							goto l1211
 						}
 						position++
-						goto l1176
-					l1177:
-						position, tokenIndex = position1176, tokenIndex1176
+						goto l1210
+					l1211:
+						position, tokenIndex = position1210, tokenIndex1210
 						if buffer[position] != rune('R') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1176:
+				l1210:
 					if !_rules[rulesp]() {
-						goto l1165
+						goto l1199
 					}
 					{
-						position1178, tokenIndex1178 := position, tokenIndex
+						position1212, tokenIndex1212 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1179
+							goto l1213
 						}
 						position++
-						goto l1178
-					l1179:
-						position, tokenIndex = position1178, tokenIndex1178
+						goto l1212
+					l1213:
+						position, tokenIndex = position1212, tokenIndex1212
 						if buffer[position] != rune('B') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1178:
+				l1212:
 					{
-						position1180, tokenIndex1180 := position, tokenIndex
+						position1214, tokenIndex1214 := position, tokenIndex
 						if buffer[position] != rune('y') {
-							goto l1181
+							goto l1215
 						}
 						position++
-						goto l1180
-					l1181:
-						position, tokenIndex = position1180, tokenIndex1180
+						goto l1214
+					l1215:
+						position, tokenIndex = position1214, tokenIndex1214
 						if buffer[position] != rune('Y') {
-							goto l1165
+							goto l1199
 						}
 						position++
 					}
-				l1180:
+				l1214:
 					if !_rules[rulesp]() {
-						goto l1165
+						goto l1199
 					}
 					if !_rules[ruleSortedExpression]() {
-						goto l1165
+						goto l1199
 					}
-				l1182:
+				l1216:
 					{
-						position1183, tokenIndex1183 := position, tokenIndex
+						position1217, tokenIndex1217 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l1183
+							goto l1217
 						}
 						if buffer[position] != rune(',') {
-							goto l1183
+							goto l1217
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l1183
+							goto l1217
 						}
 						if !_rules[ruleSortedExpression]() {
-							goto l1183
+							goto l1217
 						}
-						goto l1182
-					l1183:
-						position, tokenIndex = position1183, tokenIndex1183
+						goto l1216
+					l1217:
+						position, tokenIndex = position1217, tokenIndex1217
 					}
-					add(rulePegText, position1167)
+					add(rulePegText, position1201)
 				}
-				if !_rules[ruleAction70]() {
-					goto l1165
+				if !_rules[ruleAction73]() {
+					goto l1199
 				}
-				add(ruleParamsOrder, position1166)
+				add(ruleParamsOrder, position1200)
 			}
 			return true
-		l1165:
-			position, tokenIndex = position1165, tokenIndex1165
+		l1199:
+			position, tokenIndex = position1199, tokenIndex1199
 			return false
 		},
-		/* 92 SortedExpression <- <(Expression OrderDirectionOpt Action71)> */
+		/* 94 FuncFilterOpt <- <(<(sp (('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression spOpt ')')?> Action74)> */
 		func() bool {
-			position1184, tokenIndex1184 := position, tokenIndex
+			position1218, tokenIndex1218 := position, tokenIndex
 			{
-				position1185 := position
+				position1219 := position
+				{
+					position1220 := position
+					{
+						position1221, tokenIndex1221 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1221
+						}
+						{
+							position1223, tokenIndex1223 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1224
+							}
+							position++
+							goto l1223
+						l1224:
+							position, tokenIndex = position1223, tokenIndex1223
+							if buffer[position] != rune('F') {
+								goto l1221
+							}
+							position++
+						}
+					l1223:
+						{
+							position1225, tokenIndex1225 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1226
+							}
+							position++
+							goto l1225
+						l1226:
+							position, tokenIndex = position1225, tokenIndex1225
+							if buffer[position] != rune('I') {
+								goto l1221
+							}
+							position++
+						}
+					l1225:
+						{
+							position1227, tokenIndex1227 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1228
+							}
+							position++
+							goto l1227
+						l1228:
+							position, tokenIndex = position1227, tokenIndex1227
+							if buffer[position] != rune('L') {
+								goto l1221
+							}
+							position++
+						}
+					l1227:
+						{
+							position1229, tokenIndex1229 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1230
+							}
+							position++
+							goto l1229
+						l1230:
+							position, tokenIndex = position1229, tokenIndex1229
+							if buffer[position] != rune('T') {
+								goto l1221
+							}
+							position++
+						}
+					l1229:
+						{
+							position1231, tokenIndex1231 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1232
+							}
+							position++
+							goto l1231
+						l1232:
+							position, tokenIndex = position1231, tokenIndex1231
+							if buffer[position] != rune('E') {
+								goto l1221
+							}
+							position++
+						}
+					l1231:
+						{
+							position1233, tokenIndex1233 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1234
+							}
+							position++
+							goto l1233
+						l1234:
+							position, tokenIndex = position1233, tokenIndex1233
+							if buffer[position] != rune('R') {
+								goto l1221
+							}
+							position++
+						}
+					l1233:
+						if !_rules[rulespOpt]() {
+							goto l1221
+						}
+						if buffer[position] != rune('(') {
+							goto l1221
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1221
+						}
+						{
+							position1235, tokenIndex1235 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1236
+							}
+							position++
+							goto l1235
+						l1236:
+							position, tokenIndex = position1235, tokenIndex1235
+							if buffer[position] != rune('W') {
+								goto l1221
+							}
+							position++
+						}
+					l1235:
+						{
+							position1237, tokenIndex1237 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1238
+							}
+							position++
+							goto l1237
+						l1238:
+							position, tokenIndex = position1237, tokenIndex1237
+							if buffer[position] != rune('H') {
+								goto l1221
+							}
+							position++
+						}
+					l1237:
+						{
+							position1239, tokenIndex1239 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1240
+							}
+							position++
+							goto l1239
+						l1240:
+							position, tokenIndex = position1239, tokenIndex1239
+							if buffer[position] != rune('E') {
+								goto l1221
+							}
+							position++
+						}
+					l1239:
+						{
+							position1241, tokenIndex1241 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1242
+							}
+							position++
+							goto l1241
+						l1242:
+							position, tokenIndex = position1241, tokenIndex1241
+							if buffer[position] != rune('R') {
+								goto l1221
+							}
+							position++
+						}
+					l1241:
+						{
+							position1243, tokenIndex1243 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1244
+							}
+							position++
+							goto l1243
+						l1244:
+							position, tokenIndex = position1243, tokenIndex1243
+							if buffer[position] != rune('E') {
+								goto l1221
+							}
+							position++
+						}
+					l1243:
+						if !_rules[rulesp]() {
+							goto l1221
+						}
+						if !_rules[ruleExpression]() {
+							goto l1221
+						}
+						if !_rules[rulespOpt]() {
+							goto l1221
+						}
+						if buffer[position] != rune(')') {
+							goto l1221
+						}
+						position++
+						goto l1222
+					l1221:
+						position, tokenIndex = position1221, tokenIndex1221
+					}
+				l1222:
+					add(rulePegText, position1220)
+				}
+				if !_rules[ruleAction74]() {
+					goto l1218
+				}
+				add(ruleFuncFilterOpt, position1219)
+			}
+			return true
+		l1218:
+			position, tokenIndex = position1218, tokenIndex1218
+			return false
+		},
+		/* 95 SortedExpression <- <(Expression OrderDirectionOpt Action75)> */
+		func() bool {
+			position1245, tokenIndex1245 := position, tokenIndex
+			{
+				position1246 := position
 				if !_rules[ruleExpression]() {
-					goto l1184
+					goto l1245
 				}
 				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1184
+					goto l1245
 				}
-				if !_rules[ruleAction71]() {
-					goto l1184
+				if !_rules[ruleAction75]() {
+					goto l1245
 				}
-				add(ruleSortedExpression, position1185)
+				add(ruleSortedExpression, position1246)
 			}
 			return true
-		l1184:
-			position, tokenIndex = position1184, tokenIndex1184
+		l1245:
+			position, tokenIndex = position1245, tokenIndex1245
 			return false
 		},
-		/* 93 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action72)> */
+		/* 96 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action76)> */
 		func() bool {
-			position1186, tokenIndex1186 := position, tokenIndex
+			position1247, tokenIndex1247 := position, tokenIndex
 			{
-				position1187 := position
+				position1248 := position
 				{
-					position1188 := position
+					position1249 := position
 					{
-						position1189, tokenIndex1189 := position, tokenIndex
+						position1250, tokenIndex1250 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1189
+							goto l1250
 						}
 						{
-							position1191, tokenIndex1191 := position, tokenIndex
+							position1252, tokenIndex1252 := position, tokenIndex
 							if !_rules[ruleAscending]() {
-								goto l1192
+								goto l1253
 							}
-							goto l1191
-						l1192:
-							position, tokenIndex = position1191, tokenIndex1191
+							goto l1252
+						l1253:
+							position, tokenIndex = position1252, tokenIndex1252
 							if !_rules[ruleDescending]() {
-								goto l1189
+								goto l1250
 							}
 						}
-					l1191:
-						goto l1190
-					l1189:
-						position, tokenIndex = position1189, tokenIndex1189
+					l1252:
+						goto l1251
+					l1250:
+						position, tokenIndex = position1250, tokenIndex1250
 					}
-				l1190:
-					add(rulePegText, position1188)
+				l1251:
+					add(rulePegText, position1249)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1186
+				if !_rules[ruleAction76]() {
+					goto l1247
 				}
-				add(ruleOrderDirectionOpt, position1187)
+				add(ruleOrderDirectionOpt, position1248)
 			}
 			return true
-		l1186:
-			position, tokenIndex = position1186, tokenIndex1186
+		l1247:
+			position, tokenIndex = position1247, tokenIndex1247
 			return false
 		},
-		/* 94 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action73)> */
+		/* 97 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action77)> */
 		func() bool {
-			position1193, tokenIndex1193 := position, tokenIndex
+			position1254, tokenIndex1254 := position, tokenIndex
 			{
-				position1194 := position
+				position1255 := position
 				{
-					position1195 := position
+					position1256 := position
 					if buffer[position] != rune('[') {
-						goto l1193
+						goto l1254
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1254
 					}
 					{
-						position1196, tokenIndex1196 := position, tokenIndex
+						position1257, tokenIndex1257 := position, tokenIndex
 						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1196
+							goto l1257
 						}
-					l1198:
+					l1259:
 						{
-							position1199, tokenIndex1199 := position, tokenIndex
+							position1260, tokenIndex1260 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1199
+								goto l1260
 							}
 							if buffer[position] != rune(',') {
-								goto l1199
+								goto l1260
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1199
+								goto l1260
 							}
 							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1199
+								goto l1260
 							}
-							goto l1198
-						l1199:
-							position, tokenIndex = position1199, tokenIndex1199
+							goto l1259
+						l1260:
+							position, tokenIndex = position1260, tokenIndex1260
 						}
-						goto l1197
-					l1196:
-						position, tokenIndex = position1196, tokenIndex1196
+						goto l1258
+					l1257:
+						position, tokenIndex = position1257, tokenIndex1257
 					}
-				l1197:
+				l1258:
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1254
 					}
 					{
-						position1200, tokenIndex1200 := position, tokenIndex
+						position1261, tokenIndex1261 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l1200
+							goto l1261
 						}
 						position++
-						goto l1201
-					l1200:
-						position, tokenIndex = position1200, tokenIndex1200
+						goto l1262
+					l1261:
+						position, tokenIndex = position1261, tokenIndex1261
 					}
-				l1201:
+				l1262:
 					if !_rules[rulespOpt]() {
-						goto l1193
+						goto l1254
 					}
 					if buffer[position] != rune(']') {
-						goto l1193
+						goto l1254
 					}
 					position++
-					add(rulePegText, position1195)
+					add(rulePegText, position1256)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1193
+				if !_rules[ruleAction77]() {
+					goto l1254
 				}
-				add(ruleArrayExpr, position1194)
+				add(ruleArrayExpr, position1255)
 			}
 			return true
-		l1193:
-			position, tokenIndex = position1193, tokenIndex1193
+		l1254:
+			position, tokenIndex = position1254, tokenIndex1254
 			return false
 		},
-		/* 95 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action74)> */
+		/* 98 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action78)> */
 		func() bool {
-			position1202, tokenIndex1202 := position, tokenIndex
+			position1263, tokenIndex1263 := position, tokenIndex
 			{
-				position1203 := position
+				position1264 := position
 				{
-					position1204 := position
+					position1265 := position
 					if buffer[position] != rune('{') {
-						goto l1202
+						goto l1263
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1263
 					}
 					{
-						position1205, tokenIndex1205 := position, tokenIndex
+						position1266, tokenIndex1266 := position, tokenIndex
 						if !_rules[ruleKeyValuePair]() {
-							goto l1205
+							goto l1266
 						}
-					l1207:
+					l1268:
 						{
-							position1208, tokenIndex1208 := position, tokenIndex
+							position1269, tokenIndex1269 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1208
+								goto l1269
 							}
 							if buffer[position] != rune(',') {
-								goto l1208
+								goto l1269
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1208
+								goto l1269
 							}
 							if !_rules[ruleKeyValuePair]() {
-								goto l1208
+								goto l1269
 							}
-							goto l1207
-						l1208:
-							position, tokenIndex = position1208, tokenIndex1208
+							goto l1268
+						l1269:
+							position, tokenIndex = position1269, tokenIndex1269
 						}
-						goto l1206
-					l1205:
-						position, tokenIndex = position1205, tokenIndex1205
+						goto l1267
+					l1266:
+						position, tokenIndex = position1266, tokenIndex1266
 					}
-				l1206:
+				l1267:
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1263
 					}
 					if buffer[position] != rune('}') {
-						goto l1202
+						goto l1263
 					}
 					position++
-					add(rulePegText, position1204)
+					add(rulePegText, position1265)
 				}
-				if !_rules[ruleAction74]() {
-					goto l1202
+				if !_rules[ruleAction78]() {
+					goto l1263
 				}
-				add(ruleMapExpr, position1203)
+				add(ruleMapExpr, position1264)
 			}
 			return true
-		l1202:
-			position, tokenIndex = position1202, tokenIndex1202
+		l1263:
+			position, tokenIndex = position1263, tokenIndex1263
 			return false
 		},
-		/* 96 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action75)> */
+		/* 99 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action79)> */
 		func() bool {
-			position1209, tokenIndex1209 := position, tokenIndex
+			position1270, tokenIndex1270 := position, tokenIndex
 			{
-				position1210 := position
+				position1271 := position
 				{
-					position1211 := position
+					position1272 := position
 					if !_rules[ruleStringLiteral]() {
-						goto l1209
+						goto l1270
 					}
 					if !_rules[rulespOpt]() {
-						goto l1209
+						goto l1270
 					}
 					if buffer[position] != rune(':') {
-						goto l1209
+						goto l1270
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1209
+						goto l1270
 					}
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1209
+						goto l1270
 					}
-					add(rulePegText, position1211)
+					add(rulePegText, position1272)
 				}
-				if !_rules[ruleAction75]() {
-					goto l1209
+				if !_rules[ruleAction79]() {
+					goto l1270
 				}
-				add(ruleKeyValuePair, position1210)
+				add(ruleKeyValuePair, position1271)
 			}
 			return true
-		l1209:
-			position, tokenIndex = position1209, tokenIndex1209
+		l1270:
+			position, tokenIndex = position1270, tokenIndex1270
 			return false
 		},
-		/* 97 Case <- <(ConditionCase / ExpressionCase)> */
+		/* 100 Case <- <(ConditionCase / ExpressionCase)> */
 		func() bool {
-			position1212, tokenIndex1212 := position, tokenIndex
+			position1273, tokenIndex1273 := position, tokenIndex
 			{
-				position1213 := position
+				position1274 := position
 				{
-					position1214, tokenIndex1214 := position, tokenIndex
+					position1275, tokenIndex1275 := position, tokenIndex
 					if !_rules[ruleConditionCase]() {
-						goto l1215
+						goto l1276
 					}
-					goto l1214
-				l1215:
-					position, tokenIndex = position1214, tokenIndex1214
+					goto l1275
+				l1276:
+					position, tokenIndex = position1275, tokenIndex1275
 					if !_rules[ruleExpressionCase]() {
-						goto l1212
+						goto l1273
 					}
 				}
-			l1214:
-				add(ruleCase, position1213)
+			l1275:
+				add(ruleCase, position1274)
 			}
 			return true
-		l1212:
-			position, tokenIndex = position1212, tokenIndex1212
+		l1273:
+			position, tokenIndex = position1273, tokenIndex1273
 			return false
 		},
-		/* 98 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action76)> */
+		/* 101 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action80)> */
 		func() bool {
-			position1216, tokenIndex1216 := position, tokenIndex
+			position1277, tokenIndex1277 := position, tokenIndex
 			{
-				position1217 := position
+				position1278 := position
 				{
-					position1218, tokenIndex1218 := position, tokenIndex
+					position1279, tokenIndex1279 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l1219
+						goto l1280
 					}
 					position++
-					goto l1218
-				l1219:
-					position, tokenIndex = position1218, tokenIndex1218
+					goto l1279
+				l1280:
+					position, tokenIndex = position1279, tokenIndex1279
 					if buffer[position] != rune('C') {
-						goto l1216
+						goto l1277
 					}
 					position++
 				}
-			l1218:
+			l1279:
 				{
-					position1220, tokenIndex1220 := position, tokenIndex
+					position1281, tokenIndex1281 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l1221
+						goto l1282
 					}
 					position++
-					goto l1220
-				l1221:
-					position, tokenIndex = position1220, tokenIndex1220
+					goto l1281
+				l1282:
+					position, tokenIndex = position1281, tokenIndex1281
 					if buffer[position] != rune('A') {
-						goto l1216
+						goto l1277
 					}
 					position++
 				}
-			l1220:
+			l1281:
 				{
-					position1222, tokenIndex1222 := position, tokenIndex
+					position1283, tokenIndex1283 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1223
+						goto l1284
 					}
 					position++
-					goto l1222
-				l1223:
-					position, tokenIndex = position1222, tokenIndex1222
+					goto l1283
+				l1284:
+					position, tokenIndex = position1283, tokenIndex1283
 					if buffer[position] != rune('S') {
-						goto l1216
+						goto l1277
 					}
 					position++
 				}
-			l1222:
+			l1283:
 				{
-					position1224, tokenIndex1224 := position, tokenIndex
+					position1285, tokenIndex1285 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1225
+						goto l1286
 					}
 					position++
-					goto l1224
-				l1225:
-					position, tokenIndex = position1224, tokenIndex1224
+					goto l1285
+				l1286:
+					position, tokenIndex = position1285, tokenIndex1285
 					if buffer[position] != rune('E') {
-						goto l1216
+						goto l1277
 					}
 					position++
 				}
-			l1224:
+			l1285:
 				{
-					position1226 := position
+					position1287 := position
 					if !_rules[rulesp]() {
-						goto l1216
+						goto l1277
 					}
 					if !_rules[ruleWhenThenPair]() {
-						goto l1216
+						goto l1277
 					}
-				l1227:
+				l1288:
 					{
-						position1228, tokenIndex1228 := position, tokenIndex
+						position1289, tokenIndex1289 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1228
+							goto l1289
 						}
 						if !_rules[ruleWhenThenPair]() {
-							goto l1228
+							goto l1289
 						}
-						goto l1227
-					l1228:
-						position, tokenIndex = position1228, tokenIndex1228
+						goto l1288
+					l1289:
+						position, tokenIndex = position1289, tokenIndex1289
 					}
 					{
-						position1229, tokenIndex1229 := position, tokenIndex
+						position1290, tokenIndex1290 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1229
+							goto l1290
 						}
 						{
-							position1231, tokenIndex1231 := position, tokenIndex
+							position1292, tokenIndex1292 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1232
+								goto l1293
 							}
 							position++
-							goto l1231
-						l1232:
-							position, tokenIndex = position1231, tokenIndex1231
+							goto l1292
+						l1293:
+							position, tokenIndex = position1292, tokenIndex1292
 							if buffer[position] != rune('E') {
-								goto l1229
+								goto l1290
 							}
 							position++
 						}
-					l1231:
+					l1292:
 						{
-							position1233, tokenIndex1233 := position, tokenIndex
+							position1294, tokenIndex1294 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l1234
+								goto l1295
 							}
 							position++
-							goto l1233
-						l1234:
-							position, tokenIndex = position1233, tokenIndex1233
+							goto l1294
+						l1295:
+							position, tokenIndex = position1294, tokenIndex1294
 							if buffer[position] != rune('L') {
-								goto l1229
+								goto l1290
 							}
 							position++
 						}
-					l1233:
+					l1294:
 						{
-							position1235, tokenIndex1235 := position, tokenIndex
+							position1296, tokenIndex1296 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l1236
+								goto l1297
 							}
 							position++
-							goto l1235
-						l1236:
-							position, tokenIndex = position1235, tokenIndex1235
+							goto l1296
+						l1297:
+							position, tokenIndex = position1296, tokenIndex1296
 							if buffer[position] != rune('S') {
-								goto l1229
+								goto l1290
 							}
 							position++
 						}
-					l1235:
+					l1296:
 						{
-							position1237, tokenIndex1237 := position, tokenIndex
+							position1298, tokenIndex1298 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1238
+								goto l1299
 							}
 							position++
-							goto l1237
-						l1238:
-							position, tokenIndex = position1237, tokenIndex1237
+							goto l1298
+						l1299:
+							position, tokenIndex = position1298, tokenIndex1298
 							if buffer[position] != rune('E') {
-								goto l1229
+								goto l1290
 							}
 							position++
 						}
-					l1237:
+					l1298:
 						if !_rules[rulesp]() {
-							goto l1229
+							goto l1290
 						}
 						if !_rules[ruleExpression]() {
-							goto l1229
+							goto l1290
 						}
-						goto l1230
-					l1229:
-						position, tokenIndex = position1229, tokenIndex1229
+						goto l1291
+					l1290:
+						position, tokenIndex = position1290, tokenIndex1290
 					}
-				l1230:
+				l1291:
 					if !_rules[rulesp]() {
-						goto l1216
+						goto l1277
 					}
 					{
-						position1239, tokenIndex1239 := position, tokenIndex
+						position1300, tokenIndex1300 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1240
+							goto l1301
 						}
 						position++
-						goto l1239
-					l1240:
-						position, tokenIndex = position1239, tokenIndex1239
+						goto l1300
+					l1301:
+						position, tokenIndex = position1300, tokenIndex1300
 						if buffer[position] != rune('E') {
-							goto l1216
+							goto l1277
 						}
 						position++
 					}
-				l1239:
+				l1300:
 					{
-						position1241, tokenIndex1241 := position, tokenIndex
+						position1302, tokenIndex1302 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1242
+							goto l1303
 						}
 						position++
-						goto l1241
-					l1242:
-						position, tokenIndex = position1241, tokenIndex1241
+						goto l1302
+					l1303:
+						position, tokenIndex = position1302, tokenIndex1302
 						if buffer[position] != rune('N') {
-							goto l1216
+							goto l1277
 						}
 						position++
 					}
-				l1241:
+				l1302:
 					{
-						position1243, tokenIndex1243 := position, tokenIndex
+						position1304, tokenIndex1304 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1244
+							goto l1305
 						}
 						position++
-						goto l1243
-					l1244:
-						position, tokenIndex = position1243, tokenIndex1243
+						goto l1304
+					l1305:
+						position, tokenIndex = position1304, tokenIndex1304
 						if buffer[position] != rune('D') {
-							goto l1216
+							goto l1277
 						}
 						position++
 					}
-				l1243:
-					add(rulePegText, position1226)
+				l1304:
+					add(rulePegText, position1287)
 				}
-				if !_rules[ruleAction76]() {
-					goto l1216
+				if !_rules[ruleAction80]() {
+					goto l1277
 				}
-				add(ruleConditionCase, position1217)
+				add(ruleConditionCase, position1278)
 			}
 			return true
-		l1216:
-			position, tokenIndex = position1216, tokenIndex1216
+		l1277:
+			position, tokenIndex = position1277, tokenIndex1277
 			return false
 		},
-		/* 99 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action77)> */
+		/* 102 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action81)> */
 		func() bool {
-			position1245, tokenIndex1245 := position, tokenIndex
+			position1306, tokenIndex1306 := position, tokenIndex
 			{
-				position1246 := position
+				position1307 := position
 				{
-					position1247, tokenIndex1247 := position, tokenIndex
+					position1308, tokenIndex1308 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l1248
+						goto l1309
 					}
 					position++
-					goto l1247
-				l1248:
-					position, tokenIndex = position1247, tokenIndex1247
+					goto l1308
+				l1309:
+					position, tokenIndex = position1308, tokenIndex1308
 					if buffer[position] != rune('C') {
-						goto l1245
+						goto l1306
 					}
 					position++
 				}
-			l1247:
+			l1308:
 				{
-					position1249, tokenIndex1249 := position, tokenIndex
+					position1310, tokenIndex1310 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l1250
+						goto l1311
 					}
 					position++
-					goto l1249
-				l1250:
-					position, tokenIndex = position1249, tokenIndex1249
+					goto l1310
+				l1311:
+					position, tokenIndex = position1310, tokenIndex1310
 					if buffer[position] != rune('A') {
-						goto l1245
+						goto l1306
 					}
 					position++
 				}
-			l1249:
+			l1310:
 				{
-					position1251, tokenIndex1251 := position, tokenIndex
+					position1312, tokenIndex1312 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1252
+						goto l1313
 					}
 					position++
-					goto l1251
-				l1252:
-					position, tokenIndex = position1251, tokenIndex1251
+					goto l1312
+				l1313:
+					position, tokenIndex = position1312, tokenIndex1312
 					if buffer[position] != rune('S') {
-						goto l1245
+						goto l1306
 					}
 					position++
 				}
-			l1251:
+			l1312:
 				{
-					position1253, tokenIndex1253 := position, tokenIndex
+					position1314, tokenIndex1314 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1254
+						goto l1315
 					}
 					position++
-					goto l1253
-				l1254:
-					position, tokenIndex = position1253, tokenIndex1253
+					goto l1314
+				l1315:
+					position, tokenIndex = position1314, tokenIndex1314
 					if buffer[position] != rune('E') {
-						goto l1245
+						goto l1306
 					}
 					position++
 				}
-			l1253:
+			l1314:
 				if !_rules[rulesp]() {
-					goto l1245
+					goto l1306
 				}
 				if !_rules[ruleExpression]() {
-					goto l1245
+					goto l1306
 				}
 				{
-					position1255 := position
+					position1316 := position
 					if !_rules[rulesp]() {
-						goto l1245
+						goto l1306
 					}
 					if !_rules[ruleWhenThenPair]() {
-						goto l1245
+						goto l1306
 					}
-				l1256:
+				l1317:
 					{
-						position1257, tokenIndex1257 := position, tokenIndex
+						position1318, tokenIndex1318 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1257
+							goto l1318
 						}
 						if !_rules[ruleWhenThenPair]() {
-							goto l1257
+							goto l1318
 						}
-						goto l1256
-					l1257:
-						position, tokenIndex = position1257, tokenIndex1257
+						goto l1317
+					l1318:
+						position, tokenIndex = position1318, tokenIndex1318
 					}
 					{
-						position1258, tokenIndex1258 := position, tokenIndex
+						position1319, tokenIndex1319 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1258
+							goto l1319
 						}
 						{
-							position1260, tokenIndex1260 := position, tokenIndex
+							position1321, tokenIndex1321 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1261
+								goto l1322
 							}
 							position++
-							goto l1260
-						l1261:
-							position, tokenIndex = position1260, tokenIndex1260
+							goto l1321
+						l1322:
+							position, tokenIndex = position1321, tokenIndex1321
 							if buffer[position] != rune('E') {
-								goto l1258
+								goto l1319
 							}
 							position++
 						}
-					l1260:
+					l1321:
 						{
-							position1262, tokenIndex1262 := position, tokenIndex
+							position1323, tokenIndex1323 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l1263
+								goto l1324
 							}
 							position++
-							goto l1262
-						l1263:
-							position, tokenIndex = position1262, tokenIndex1262
+							goto l1323
+						l1324:
+							position, tokenIndex = position1323, tokenIndex1323
 							if buffer[position] != rune('L') {
-								goto l1258
+								goto l1319
 							}
 							position++
 						}
-					l1262:
+					l1323:
 						{
-							position1264, tokenIndex1264 := position, tokenIndex
+							position1325, tokenIndex1325 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l1265
+								goto l1326
 							}
 							position++
-							goto l1264
-						l1265:
-							position, tokenIndex = position1264, tokenIndex1264
+							goto l1325
+						l1326:
+							position, tokenIndex = position1325, tokenIndex1325
 							if buffer[position] != rune('S') {
-								goto l1258
+								goto l1319
 							}
 							position++
 						}
-					l1264:
+					l1325:
 						{
-							position1266, tokenIndex1266 := position, tokenIndex
+							position1327, tokenIndex1327 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l1267
+								goto l1328
 							}
 							position++
-							goto l1266
-						l1267:
-							position, tokenIndex = position1266, tokenIndex1266
+							goto l1327
+						l1328:
+							position, tokenIndex = position1327, tokenIndex1327
 							if buffer[position] != rune('E') {
-								goto l1258
+								goto l1319
 							}
 							position++
 						}
-					l1266:
+					l1327:
 						if !_rules[rulesp]() {
-							goto l1258
+							goto l1319
 						}
 						if !_rules[ruleExpression]() {
-							goto l1258
+							goto l1319
 						}
-						goto l1259
-					l1258:
-						position, tokenIndex = position1258, tokenIndex1258
+						goto l1320
+					l1319:
+						position, tokenIndex = position1319, tokenIndex1319
 					}
-				l1259:
+				l1320:
 					if !_rules[rulesp]() {
-						goto l1245
+						goto l1306
 					}
 					{
-						position1268, tokenIndex1268 := position, tokenIndex
+						position1329, tokenIndex1329 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1269
+							goto l1330
 						}
 						position++
-						goto l1268
-					l1269:
-						position, tokenIndex = position1268, tokenIndex1268
+						goto l1329
+					l1330:
+						position, tokenIndex = position1329, tokenIndex1329
 						if buffer[position] != rune('E') {
-							goto l1245
+							goto l1306
 						}
 						position++
 					}
-				l1268:
+				l1329:
 					{
-						position1270, tokenIndex1270 := position, tokenIndex
+						position1331, tokenIndex1331 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1271
+							goto l1332
 						}
 						position++
-						goto l1270
-					l1271:
-						position, tokenIndex = position1270, tokenIndex1270
+						goto l1331
+					l1332:
+						position, tokenIndex = position1331, tokenIndex1331
 						if buffer[position] != rune('N') {
-							goto l1245
+							goto l1306
 						}
 						position++
 					}
-				l1270:
+				l1331:
 					{
-						position1272, tokenIndex1272 := position, tokenIndex
+						position1333, tokenIndex1333 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1273
+							goto l1334
 						}
 						position++
-						goto l1272
-					l1273:
-						position, tokenIndex = position1272, tokenIndex1272
+						goto l1333
+					l1334:
+						position, tokenIndex = position1333, tokenIndex1333
 						if buffer[position] != rune('D') {
-							goto l1245
+							goto l1306
 						}
 						position++
 					}
-				l1272:
-					add(rulePegText, position1255)
+				l1333:
+					add(rulePegText, position1316)
 				}
-				if !_rules[ruleAction77]() {
-					goto l1245
+				if !_rules[ruleAction81]() {
+					goto l1306
 				}
-				add(ruleExpressionCase, position1246)
+				add(ruleExpressionCase, position1307)
 			}
 			return true
-		l1245:
-			position, tokenIndex = position1245, tokenIndex1245
+		l1306:
+			position, tokenIndex = position1306, tokenIndex1306
 			return false
 		},
-		/* 100 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action78)> */
+		/* 103 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action82)> */
 		func() bool {
-			position1274, tokenIndex1274 := position, tokenIndex
+			position1335, tokenIndex1335 := position, tokenIndex
 			{
-				position1275 := position
+				position1336 := position
 				{
-					position1276, tokenIndex1276 := position, tokenIndex
+					position1337, tokenIndex1337 := position, tokenIndex
 					if buffer[position] != rune('w') {
-						goto l1277
+						goto l1338
 					}
 					position++
-					goto l1276
-				l1277:
-					position, tokenIndex = position1276, tokenIndex1276
+					goto l1337
+				l1338:
+					position, tokenIndex = position1337, tokenIndex1337
 					if buffer[position] != rune('W') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1276:
+			l1337:
 				{
-					position1278, tokenIndex1278 := position, tokenIndex
+					position1339, tokenIndex1339 := position, tokenIndex
 					if buffer[position] != rune('h') {
-						goto l1279
+						goto l1340
 					}
 					position++
-					goto l1278
-				l1279:
-					position, tokenIndex = position1278, tokenIndex1278
+					goto l1339
+				l1340:
+					position, tokenIndex = position1339, tokenIndex1339
 					if buffer[position] != rune('H') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1278:
+			l1339:
 				{
-					position1280, tokenIndex1280 := position, tokenIndex
+					position1341, tokenIndex1341 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1281
+						goto l1342
 					}
 					position++
-					goto l1280
-				l1281:
-					position, tokenIndex = position1280, tokenIndex1280
+					goto l1341
+				l1342:
+					position, tokenIndex = position1341, tokenIndex1341
 					if buffer[position] != rune('E') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1280:
+			l1341:
 				{
-					position1282, tokenIndex1282 := position, tokenIndex
+					position1343, tokenIndex1343 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l1283
+						goto l1344
 					}
 					position++
-					goto l1282
-				l1283:
-					position, tokenIndex = position1282, tokenIndex1282
+					goto l1343
+				l1344:
+					position, tokenIndex = position1343, tokenIndex1343
 					if buffer[position] != rune('N') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1282:
+			l1343:
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1335
 				}
 				if !_rules[ruleExpression]() {
-					goto l1274
+					goto l1335
 				}
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1335
 				}
 				{
-					position1284, tokenIndex1284 := position, tokenIndex
+					position1345, tokenIndex1345 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l1285
+						goto l1346
 					}
 					position++
-					goto l1284
-				l1285:
-					position, tokenIndex = position1284, tokenIndex1284
+					goto l1345
+				l1346:
+					position, tokenIndex = position1345, tokenIndex1345
 					if buffer[position] != rune('T') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1284:
+			l1345:
 				{
-					position1286, tokenIndex1286 := position, tokenIndex
+					position1347, tokenIndex1347 := position, tokenIndex
 					if buffer[position] != rune('h') {
-						goto l1287
+						goto l1348
 					}
 					position++
-					goto l1286
-				l1287:
-					position, tokenIndex = position1286, tokenIndex1286
+					goto l1347
+				l1348:
+					position, tokenIndex = position1347, tokenIndex1347
 					if buffer[position] != rune('H') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1286:
+			l1347:
 				{
-					position1288, tokenIndex1288 := position, tokenIndex
+					position1349, tokenIndex1349 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1289
+						goto l1350
 					}
 					position++
-					goto l1288
-				l1289:
-					position, tokenIndex = position1288, tokenIndex1288
+					goto l1349
+				l1350:
+					position, tokenIndex = position1349, tokenIndex1349
 					if buffer[position] != rune('E') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1288:
+			l1349:
 				{
-					position1290, tokenIndex1290 := position, tokenIndex
+					position1351, tokenIndex1351 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l1291
+						goto l1352
 					}
 					position++
-					goto l1290
-				l1291:
-					position, tokenIndex = position1290, tokenIndex1290
+					goto l1351
+				l1352:
+					position, tokenIndex = position1351, tokenIndex1351
 					if buffer[position] != rune('N') {
-						goto l1274
+						goto l1335
 					}
 					position++
 				}
-			l1290:
+			l1351:
 				if !_rules[rulesp]() {
-					goto l1274
+					goto l1335
 				}
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1274
+					goto l1335
 				}
-				if !_rules[ruleAction78]() {
-					goto l1274
+				if !_rules[ruleAction82]() {
+					goto l1335
 				}
-				add(ruleWhenThenPair, position1275)
+				add(ruleWhenThenPair, position1336)
 			}
 			return true
-		l1274:
-			position, tokenIndex = position1274, tokenIndex1274
+		l1335:
+			position, tokenIndex = position1335, tokenIndex1335
 			return false
 		},
-		/* 101 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 104 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
 		func() bool {
-			position1292, tokenIndex1292 := position, tokenIndex
+			position1353, tokenIndex1353 := position, tokenIndex
 			{
-				position1293 := position
+				position1354 := position
 				{
-					position1294, tokenIndex1294 := position, tokenIndex
+					position1355, tokenIndex1355 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l1295
+						goto l1356
 					}
-					goto l1294
-				l1295:
-					position, tokenIndex = position1294, tokenIndex1294
+					goto l1355
+				l1356:
+					position, tokenIndex = position1355, tokenIndex1355
 					if !_rules[ruleNumericLiteral]() {
-						goto l1296
+						goto l1357
 					}
-					goto l1294
-				l1296:
-					position, tokenIndex = position1294, tokenIndex1294
+					goto l1355
+				l1357:
+					position, tokenIndex = position1355, tokenIndex1355
 					if !_rules[ruleStringLiteral]() {
-						goto l1292
+						goto l1353
 					}
 				}
-			l1294:
-				add(ruleLiteral, position1293)
+			l1355:
+				add(ruleLiteral, position1354)
 			}
 			return true
-		l1292:
-			position, tokenIndex = position1292, tokenIndex1292
+		l1353:
+			position, tokenIndex = position1353, tokenIndex1353
 			return false
 		},
-		/* 102 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 105 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
 		func() bool {
-			position1297, tokenIndex1297 := position, tokenIndex
+			position1358, tokenIndex1358 := position, tokenIndex
 			{
-				position1298 := position
+				position1359 := position
 				{
-					position1299, tokenIndex1299 := position, tokenIndex
+					position1360, tokenIndex1360 := position, tokenIndex
 					if !_rules[ruleEqual]() {
-						goto l1300
+						goto l1361
 					}
-					goto l1299
-				l1300:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1361:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleNotEqual]() {
-						goto l1301
+						goto l1362
 					}
-					goto l1299
-				l1301:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1362:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleLessOrEqual]() {
-						goto l1302
+						goto l1363
 					}
-					goto l1299
-				l1302:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1363:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleLess]() {
-						goto l1303
+						goto l1364
 					}
-					goto l1299
-				l1303:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1364:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleGreaterOrEqual]() {
-						goto l1304
+						goto l1365
 					}
-					goto l1299
-				l1304:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1365:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleGreater]() {
-						goto l1305
+						goto l1366
 					}
-					goto l1299
-				l1305:
-					position, tokenIndex = position1299, tokenIndex1299
+					goto l1360
+				l1366:
+					position, tokenIndex = position1360, tokenIndex1360
 					if !_rules[ruleNotEqual]() {
-						goto l1297
+						goto l1358
 					}
 				}
-			l1299:
-				add(ruleComparisonOp, position1298)
+			l1360:
+				add(ruleComparisonOp, position1359)
 			}
 			return true
-		l1297:
-			position, tokenIndex = position1297, tokenIndex1297
+		l1358:
+			position, tokenIndex = position1358, tokenIndex1358
 			return false
 		},
-		/* 103 OtherOp <- <Concat> */
+		/* 106 OtherOp <- <Concat> */
 		func() bool {
-			position1306, tokenIndex1306 := position, tokenIndex
+			position1367, tokenIndex1367 := position, tokenIndex
 			{
-				position1307 := position
+				position1368 := position
 				if !_rules[ruleConcat]() {
-					goto l1306
+					goto l1367
 				}
-				add(ruleOtherOp, position1307)
+				add(ruleOtherOp, position1368)
 			}
 			return true
-		l1306:
-			position, tokenIndex = position1306, tokenIndex1306
+		l1367:
+			position, tokenIndex = position1367, tokenIndex1367
 			return false
 		},
-		/* 104 IsOp <- <(IsNot / Is)> */
+		/* 107 IsOp <- <(IsNot / Is)> */
 		func() bool {
-			position1308, tokenIndex1308 := position, tokenIndex
+			position1369, tokenIndex1369 := position, tokenIndex
 			{
-				position1309 := position
+				position1370 := position
 				{
-					position1310, tokenIndex1310 := position, tokenIndex
+					position1371, tokenIndex1371 := position, tokenIndex
 					if !_rules[ruleIsNot]() {
-						goto l1311
+						goto l1372
 					}
-					goto l1310
-				l1311:
-					position, tokenIndex = position1310, tokenIndex1310
+					goto l1371
+				l1372:
+					position, tokenIndex = position1371, tokenIndex1371
 					if !_rules[ruleIs]() {
-						goto l1308
+						goto l1369
 					}
 				}
-			l1310:
-				add(ruleIsOp, position1309)
+			l1371:
+				add(ruleIsOp, position1370)
 			}
 			return true
-		l1308:
-			position, tokenIndex = position1308, tokenIndex1308
+		l1369:
+			position, tokenIndex = position1369, tokenIndex1369
 			return false
 		},
-		/* 105 PlusMinusOp <- <(Plus / Minus)> */
+		/* 108 PlusMinusOp <- <(Plus / Minus)> */
 		func() bool {
-			position1312, tokenIndex1312 := position, tokenIndex
+			position1373, tokenIndex1373 := position, tokenIndex
 			{
-				position1313 := position
+				position1374 := position
 				{
-					position1314, tokenIndex1314 := position, tokenIndex
+					position1375, tokenIndex1375 := position, tokenIndex
 					if !_rules[rulePlus]() {
-						goto l1315
+						goto l1376
 					}
-					goto l1314
-				l1315:
-					position, tokenIndex = position1314, tokenIndex1314
+					goto l1375
+				l1376:
+					position, tokenIndex = position1375, tokenIndex1375
 					if !_rules[ruleMinus]() {
-						goto l1312
+						goto l1373
 					}
 				}
-			l1314:
-				add(rulePlusMinusOp, position1313)
+			l1375:
+				add(rulePlusMinusOp, position1374)
 			}
 			return true
-		l1312:
-			position, tokenIndex = position1312, tokenIndex1312
+		l1373:
+			position, tokenIndex = position1373, tokenIndex1373
 			return false
 		},
-		/* 106 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		/* 109 MultDivOp <- <(Multiply / Divide / Modulo)> */
 		func() bool {
-			position1316, tokenIndex1316 := position, tokenIndex
+			position1377, tokenIndex1377 := position, tokenIndex
 			{
-				position1317 := position
+				position1378 := position
 				{
-					position1318, tokenIndex1318 := position, tokenIndex
+					position1379, tokenIndex1379 := position, tokenIndex
 					if !_rules[ruleMultiply]() {
-						goto l1319
+						goto l1380
 					}
-					goto l1318
-				l1319:
-					position, tokenIndex = position1318, tokenIndex1318
+					goto l1379
+				l1380:
+					position, tokenIndex = position1379, tokenIndex1379
 					if !_rules[ruleDivide]() {
-						goto l1320
+						goto l1381
 					}
-					goto l1318
-				l1320:
-					position, tokenIndex = position1318, tokenIndex1318
+					goto l1379
+				l1381:
+					position, tokenIndex = position1379, tokenIndex1379
 					if !_rules[ruleModulo]() {
-						goto l1316
+						goto l1377
 					}
 				}
-			l1318:
-				add(ruleMultDivOp, position1317)
+			l1379:
+				add(ruleMultDivOp, position1378)
 			}
 			return true
-		l1316:
-			position, tokenIndex = position1316, tokenIndex1316
+		l1377:
+			position, tokenIndex = position1377, tokenIndex1377
 			return false
 		},
-		/* 107 Stream <- <(<ident> Action79)> */
+		/* 110 Stream <- <(<ident> Action83)> */
 		func() bool {
-			position1321, tokenIndex1321 := position, tokenIndex
+			position1382, tokenIndex1382 := position, tokenIndex
 			{
-				position1322 := position
+				position1383 := position
 				{
-					position1323 := position
+					position1384 := position
 					if !_rules[ruleident]() {
-						goto l1321
+						goto l1382
 					}
-					add(rulePegText, position1323)
+					add(rulePegText, position1384)
 				}
-				if !_rules[ruleAction79]() {
-					goto l1321
+				if !_rules[ruleAction83]() {
+					goto l1382
 				}
-				add(ruleStream, position1322)
+				add(ruleStream, position1383)
 			}
 			return true
-		l1321:
-			position, tokenIndex = position1321, tokenIndex1321
+		l1382:
+			position, tokenIndex = position1382, tokenIndex1382
 			return false
 		},
-		/* 108 RowMeta <- <RowTimestamp> */
+		/* 111 RowMeta <- <RowTimestamp> */
 		func() bool {
-			position1324, tokenIndex1324 := position, tokenIndex
+			position1385, tokenIndex1385 := position, tokenIndex
 			{
-				position1325 := position
+				position1386 := position
 				if !_rules[ruleRowTimestamp]() {
-					goto l1324
+					goto l1385
 				}
-				add(ruleRowMeta, position1325)
+				add(ruleRowMeta, position1386)
 			}
 			return true
-		l1324:
-			position, tokenIndex = position1324, tokenIndex1324
+		l1385:
+			position, tokenIndex = position1385, tokenIndex1385
 			return false
 		},
-		/* 109 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action80)> */
+		/* 112 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action84)> */
 		func() bool {
-			position1326, tokenIndex1326 := position, tokenIndex
+			position1387, tokenIndex1387 := position, tokenIndex
 			{
-				position1327 := position
+				position1388 := position
 				{
-					position1328 := position
+					position1389 := position
 					{
-						position1329, tokenIndex1329 := position, tokenIndex
+						position1390, tokenIndex1390 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1329
+							goto l1390
 						}
 						if buffer[position] != rune(':') {
-							goto l1329
+							goto l1390
 						}
 						position++
-						goto l1330
-					l1329:
-						position, tokenIndex = position1329, tokenIndex1329
+						goto l1391
+					l1390:
+						position, tokenIndex = position1390, tokenIndex1390
 					}
-				l1330:
+				l1391:
 					if buffer[position] != rune('t') {
-						goto l1326
+						goto l1387
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l1326
+						goto l1387
 					}
 					position++
 					if buffer[position] != rune('(') {
-						goto l1326
+						goto l1387
 					}
 					position++
 					if buffer[position] != rune(')') {
-						goto l1326
+						goto l1387
 					}
 					position++
-					add(rulePegText, position1328)
+					add(rulePegText, position1389)
 				}
-				if !_rules[ruleAction80]() {
-					goto l1326
+				if !_rules[ruleAction84]() {
+					goto l1387
 				}
-				add(ruleRowTimestamp, position1327)
+				add(ruleRowTimestamp, position1388)
 			}
 			return true
-		l1326:
-			position, tokenIndex = position1326, tokenIndex1326
+		l1387:
+			position, tokenIndex = position1387, tokenIndex1387
 			return false
 		},
-		/* 110 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action81)> */
+		/* 113 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action85)> */
 		func() bool {
-			position1331, tokenIndex1331 := position, tokenIndex
+			position1392, tokenIndex1392 := position, tokenIndex
 			{
-				position1332 := position
+				position1393 := position
 				{
-					position1333 := position
+					position1394 := position
 					{
-						position1334, tokenIndex1334 := position, tokenIndex
+						position1395, tokenIndex1395 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1334
+							goto l1395
 						}
 						if buffer[position] != rune(':') {
-							goto l1334
+							goto l1395
 						}
 						position++
 						{
-							position1336, tokenIndex1336 := position, tokenIndex
+							position1397, tokenIndex1397 := position, tokenIndex
 							if buffer[position] != rune(':') {
-								goto l1336
+								goto l1397
 							}
 							position++
-							goto l1334
-						l1336:
-							position, tokenIndex = position1336, tokenIndex1336
+							goto l1395
+						l1397:
+							position, tokenIndex = position1397, tokenIndex1397
 						}
-						goto l1335
-					l1334:
-						position, tokenIndex = position1334, tokenIndex1334
+						goto l1396
+					l1395:
+						position, tokenIndex = position1395, tokenIndex1395
 					}
-				l1335:
+				l1396:
 					if !_rules[rulejsonGetPath]() {
-						goto l1331
+						goto l1392
 					}
-					add(rulePegText, position1333)
+					add(rulePegText, position1394)
 				}
-				if !_rules[ruleAction81]() {
-					goto l1331
+				if !_rules[ruleAction85]() {
+					goto l1392
 				}
-				add(ruleRowValue, position1332)
+				add(ruleRowValue, position1393)
 			}
 			return true
-		l1331:
-			position, tokenIndex = position1331, tokenIndex1331
+		l1392:
+			position, tokenIndex = position1392, tokenIndex1392
 			return false
 		},
-		/* 111 NumericLiteral <- <(<('-'? [0-9]+)> Action82)> */
+		/* 114 NumericLiteral <- <(<('-'? [0-9]+)> Action86)> */
 		func() bool {
-			position1337, tokenIndex1337 := position, tokenIndex
+			position1398, tokenIndex1398 := position, tokenIndex
 			{
-				position1338 := position
+				position1399 := position
 				{
-					position1339 := position
+					position1400 := position
 					{
-						position1340, tokenIndex1340 := position, tokenIndex
+						position1401, tokenIndex1401 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1340
+							goto l1401
 						}
 						position++
-						goto l1341
-					l1340:
-						position, tokenIndex = position1340, tokenIndex1340
+						goto l1402
+					l1401:
+						position, tokenIndex = position1401, tokenIndex1401
 					}
-				l1341:
+				l1402:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
+						goto l1398
 					}
 					position++
-				l1342:
+				l1403:
 					{
-						position1343, tokenIndex1343 := position, tokenIndex
+						position1404, tokenIndex1404 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1343
+							goto l1404
 						}
 						position++
-						goto l1342
-					l1343:
-						position, tokenIndex = position1343, tokenIndex1343
+						goto l1403
+					l1404:
+						position, tokenIndex = position1404, tokenIndex1404
 					}
-					add(rulePegText, position1339)
+					add(rulePegText, position1400)
 				}
-				if !_rules[ruleAction82]() {
-					goto l1337
+				if !_rules[ruleAction86]() {
+					goto l1398
 				}
-				add(ruleNumericLiteral, position1338)
+				add(ruleNumericLiteral, position1399)
 			}
 			return true
-		l1337:
-			position, tokenIndex = position1337, tokenIndex1337
+		l1398:
+			position, tokenIndex = position1398, tokenIndex1398
 			return false
 		},
-		/* 112 NonNegativeNumericLiteral <- <(<[0-9]+> Action83)> */
+		/* 115 NonNegativeNumericLiteral <- <(<[0-9]+> Action87)> */
 		func() bool {
-			position1344, tokenIndex1344 := position, tokenIndex
+			position1405, tokenIndex1405 := position, tokenIndex
 			{
-				position1345 := position
+				position1406 := position
 				{
-					position1346 := position
+					position1407 := position
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1344
+						goto l1405
 					}
 					position++
-				l1347:
+				l1408:
 					{
-						position1348, tokenIndex1348 := position, tokenIndex
+						position1409, tokenIndex1409 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+							goto l1409
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex = position1348, tokenIndex1348
+						goto l1408
+					l1409:
+						position, tokenIndex = position1409, tokenIndex1409
 					}
-					add(rulePegText, position1346)
+					add(rulePegText, position1407)
 				}
-				if !_rules[ruleAction83]() {
-					goto l1344
+				if !_rules[ruleAction87]() {
+					goto l1405
 				}
-				add(ruleNonNegativeNumericLiteral, position1345)
+				add(ruleNonNegativeNumericLiteral, position1406)
 			}
 			return true
-		l1344:
-			position, tokenIndex = position1344, tokenIndex1344
+		l1405:
+			position, tokenIndex = position1405, tokenIndex1405
 			return false
 		},
-		/* 113 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action84)> */
+		/* 116 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action88)> */
 		func() bool {
-			position1349, tokenIndex1349 := position, tokenIndex
+			position1410, tokenIndex1410 := position, tokenIndex
 			{
-				position1350 := position
+				position1411 := position
 				{
-					position1351 := position
+					position1412 := position
 					{
-						position1352, tokenIndex1352 := position, tokenIndex
+						position1413, tokenIndex1413 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1352
+							goto l1413
 						}
 						position++
-						goto l1353
-					l1352:
-						position, tokenIndex = position1352, tokenIndex1352
+						goto l1414
+					l1413:
+						position, tokenIndex = position1413, tokenIndex1413
 					}
-				l1353:
+				l1414:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+						goto l1410
 					}
 					position++
-				l1354:
+				l1415:
 					{
-						position1355, tokenIndex1355 := position, tokenIndex
+						position1416, tokenIndex1416 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1355
+							goto l1416
 						}
 						position++
-						goto l1354
-					l1355:
-						position, tokenIndex = position1355, tokenIndex1355
+						goto l1415
+					l1416:
+						position, tokenIndex = position1416, tokenIndex1416
 					}
 					if buffer[position] != rune('.') {
-						goto l1349
+						goto l1410
 					}
 					position++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+						goto l1410
 					}
 					position++
-				l1356:
+				l1417:
 					{
-						position1357, tokenIndex1357 := position, tokenIndex
+						position1418, tokenIndex1418 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1357
+							goto l1418
 						}
 						position++
-						goto l1356
-					l1357:
-						position, tokenIndex = position1357, tokenIndex1357
+						goto l1417
+					l1418:
+						position, tokenIndex = position1418, tokenIndex1418
 					}
-					add(rulePegText, position1351)
+					add(rulePegText, position1412)
 				}
-				if !_rules[ruleAction84]() {
-					goto l1349
+				if !_rules[ruleAction88]() {
+					goto l1410
 				}
-				add(ruleFloatLiteral, position1350)
+				add(ruleFloatLiteral, position1411)
 			}
 			return true
-		l1349:
-			position, tokenIndex = position1349, tokenIndex1349
+		l1410:
+			position, tokenIndex = position1410, tokenIndex1410
 			return false
 		},
-		/* 114 Function <- <(<ident> Action85)> */
+		/* 117 Function <- <(<ident> Action89)> */
 		func() bool {
-			position1358, tokenIndex1358 := position, tokenIndex
+			position1419, tokenIndex1419 := position, tokenIndex
 			{
-				position1359 := position
+				position1420 := position
 				{
-					position1360 := position
+					position1421 := position
 					if !_rules[ruleident]() {
-						goto l1358
+						goto l1419
 					}
-					add(rulePegText, position1360)
+					add(rulePegText, position1421)
 				}
-				if !_rules[ruleAction85]() {
-					goto l1358
+				if !_rules[ruleAction89]() {
+					goto l1419
 				}
-				add(ruleFunction, position1359)
+				add(ruleFunction, position1420)
 			}
 			return true
-		l1358:
-			position, tokenIndex = position1358, tokenIndex1358
+		l1419:
+			position, tokenIndex = position1419, tokenIndex1419
 			return false
 		},
-		/* 115 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action86)> */
+		/* 118 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action90)> */
 		func() bool {
-			position1361, tokenIndex1361 := position, tokenIndex
+			position1422, tokenIndex1422 := position, tokenIndex
 			{
-				position1362 := position
+				position1423 := position
 				{
-					position1363 := position
+					position1424 := position
 					{
-						position1364, tokenIndex1364 := position, tokenIndex
+						position1425, tokenIndex1425 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1365
+							goto l1426
 						}
 						position++
-						goto l1364
-					l1365:
-						position, tokenIndex = position1364, tokenIndex1364
+						goto l1425
+					l1426:
+						position, tokenIndex = position1425, tokenIndex1425
 						if buffer[position] != rune('N') {
-							goto l1361
+							goto l1422
 						}
 						position++
 					}
-				l1364:
+				l1425:
 					{
-						position1366, tokenIndex1366 := position, tokenIndex
+						position1427, tokenIndex1427 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1367
+							goto l1428
 						}
 						position++
-						goto l1366
-					l1367:
-						position, tokenIndex = position1366, tokenIndex1366
+						goto l1427
+					l1428:
+						position, tokenIndex = position1427, tokenIndex1427
 						if buffer[position] != rune('U') {
-							goto l1361
+							goto l1422
 						}
 						position++
 					}
-				l1366:
+				l1427:
 					{
-						position1368, tokenIndex1368 := position, tokenIndex
+						position1429, tokenIndex1429 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1369
+							goto l1430
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex = position1368, tokenIndex1368
+						goto l1429
+					l1430:
+						position, tokenIndex = position1429, tokenIndex1429
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l1422
 						}
 						position++
 					}
-				l1368:
+				l1429:
 					{
-						position1370, tokenIndex1370 := position, tokenIndex
+						position1431, tokenIndex1431 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1371
+							goto l1432
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex = position1370, tokenIndex1370
+						goto l1431
+					l1432:
+						position, tokenIndex = position1431, tokenIndex1431
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l1422
 						}
 						position++
 					}
-				l1370:
-					add(rulePegText, position1363)
+				l1431:
+					add(rulePegText, position1424)
 				}
-				if !_rules[ruleAction86]() {
-					goto l1361
+				if !_rules[ruleAction90]() {
+					goto l1422
 				}
-				add(ruleNullLiteral, position1362)
+				add(ruleNullLiteral, position1423)
 			}
 			return true
-		l1361:
-			position, tokenIndex = position1361, tokenIndex1361
+		l1422:
+			position, tokenIndex = position1422, tokenIndex1422
 			return false
 		},
-		/* 116 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action87)> */
+		/* 119 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action91)> */
 		func() bool {
-			position1372, tokenIndex1372 := position, tokenIndex
+			position1433, tokenIndex1433 := position, tokenIndex
 			{
-				position1373 := position
+				position1434 := position
 				{
-					position1374 := position
+					position1435 := position
 					{
-						position1375, tokenIndex1375 := position, tokenIndex
+						position1436, tokenIndex1436 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1376
+							goto l1437
 						}
 						position++
-						goto l1375
-					l1376:
-						position, tokenIndex = position1375, tokenIndex1375
+						goto l1436
+					l1437:
+						position, tokenIndex = position1436, tokenIndex1436
 						if buffer[position] != rune('M') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1375:
+				l1436:
 					{
-						position1377, tokenIndex1377 := position, tokenIndex
+						position1438, tokenIndex1438 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1378
+							goto l1439
 						}
 						position++
-						goto l1377
-					l1378:
-						position, tokenIndex = position1377, tokenIndex1377
+						goto l1438
+					l1439:
+						position, tokenIndex = position1438, tokenIndex1438
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1377:
+				l1438:
 					{
-						position1379, tokenIndex1379 := position, tokenIndex
+						position1440, tokenIndex1440 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1380
+							goto l1441
 						}
 						position++
-						goto l1379
-					l1380:
-						position, tokenIndex = position1379, tokenIndex1379
+						goto l1440
+					l1441:
+						position, tokenIndex = position1440, tokenIndex1440
 						if buffer[position] != rune('S') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1379:
+				l1440:
 					{
-						position1381, tokenIndex1381 := position, tokenIndex
+						position1442, tokenIndex1442 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1382
+							goto l1443
 						}
 						position++
-						goto l1381
-					l1382:
-						position, tokenIndex = position1381, tokenIndex1381
+						goto l1442
+					l1443:
+						position, tokenIndex = position1442, tokenIndex1442
 						if buffer[position] != rune('S') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1381:
+				l1442:
 					{
-						position1383, tokenIndex1383 := position, tokenIndex
+						position1444, tokenIndex1444 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1384
+							goto l1445
 						}
 						position++
-						goto l1383
-					l1384:
-						position, tokenIndex = position1383, tokenIndex1383
+						goto l1444
+					l1445:
+						position, tokenIndex = position1444, tokenIndex1444
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1383:
+				l1444:
 					{
-						position1385, tokenIndex1385 := position, tokenIndex
+						position1446, tokenIndex1446 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1386
+							goto l1447
 						}
 						position++
-						goto l1385
-					l1386:
-						position, tokenIndex = position1385, tokenIndex1385
+						goto l1446
+					l1447:
+						position, tokenIndex = position1446, tokenIndex1446
 						if buffer[position] != rune('N') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1385:
+				l1446:
 					{
-						position1387, tokenIndex1387 := position, tokenIndex
+						position1448, tokenIndex1448 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l1388
+							goto l1449
 						}
 						position++
-						goto l1387
-					l1388:
-						position, tokenIndex = position1387, tokenIndex1387
+						goto l1448
+					l1449:
+						position, tokenIndex = position1448, tokenIndex1448
 						if buffer[position] != rune('G') {
-							goto l1372
+							goto l1433
 						}
 						position++
 					}
-				l1387:
-					add(rulePegText, position1374)
+				l1448:
+					add(rulePegText, position1435)
 				}
-				if !_rules[ruleAction87]() {
-					goto l1372
+				if !_rules[ruleAction91]() {
+					goto l1433
 				}
-				add(ruleMissing, position1373)
+				add(ruleMissing, position1434)
 			}
 			return true
-		l1372:
-			position, tokenIndex = position1372, tokenIndex1372
+		l1433:
+			position, tokenIndex = position1433, tokenIndex1433
 			return false
 		},
-		/* 117 BooleanLiteral <- <(TRUE / FALSE)> */
+		/* 120 BooleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1389, tokenIndex1389 := position, tokenIndex
+			position1450, tokenIndex1450 := position, tokenIndex
 			{
-				position1390 := position
+				position1451 := position
 				{
-					position1391, tokenIndex1391 := position, tokenIndex
+					position1452, tokenIndex1452 := position, tokenIndex
 					if !_rules[ruleTRUE]() {
-						goto l1392
+						goto l1453
 					}
-					goto l1391
-				l1392:
-					position, tokenIndex = position1391, tokenIndex1391
+					goto l1452
+				l1453:
+					position, tokenIndex = position1452, tokenIndex1452
 					if !_rules[ruleFALSE]() {
-						goto l1389
+						goto l1450
 					}
 				}
-			l1391:
-				add(ruleBooleanLiteral, position1390)
+			l1452:
+				add(ruleBooleanLiteral, position1451)
 			}
 			return true
-		l1389:
-			position, tokenIndex = position1389, tokenIndex1389
+		l1450:
+			position, tokenIndex = position1450, tokenIndex1450
 			return false
 		},
-		/* 118 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action88)> */
+		/* 121 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action92)> */
 		func() bool {
-			position1393, tokenIndex1393 := position, tokenIndex
+			position1454, tokenIndex1454 := position, tokenIndex
 			{
-				position1394 := position
+				position1455 := position
 				{
-					position1395 := position
+					position1456 := position
 					{
-						position1396, tokenIndex1396 := position, tokenIndex
+						position1457, tokenIndex1457 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1397
+							goto l1458
 						}
 						position++
-						goto l1396
-					l1397:
-						position, tokenIndex = position1396, tokenIndex1396
+						goto l1457
+					l1458:
+						position, tokenIndex = position1457, tokenIndex1457
 						if buffer[position] != rune('T') {
-							goto l1393
+							goto l1454
 						}
 						position++
 					}
-				l1396:
+				l1457:
 					{
-						position1398, tokenIndex1398 := position, tokenIndex
+						position1459, tokenIndex1459 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1399
+							goto l1460
 						}
 						position++
-						goto l1398
-					l1399:
-						position, tokenIndex = position1398, tokenIndex1398
+						goto l1459
+					l1460:
+						position, tokenIndex = position1459, tokenIndex1459
 						if buffer[position] != rune('R') {
-							goto l1393
+							goto l1454
 						}
 						position++
 					}
-				l1398:
+				l1459:
 					{
-						position1400, tokenIndex1400 := position, tokenIndex
+						position1461, tokenIndex1461 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1401
+							goto l1462
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex = position1400, tokenIndex1400
+						goto l1461
+					l1462:
+						position, tokenIndex = position1461, tokenIndex1461
 						if buffer[position] != rune('U') {
-							goto l1393
+							goto l1454
 						}
 						position++
 					}
-				l1400:
+				l1461:
 					{
-						position1402, tokenIndex1402 := position, tokenIndex
+						position1463, tokenIndex1463 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1403
+							goto l1464
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex = position1402, tokenIndex1402
+						goto l1463
+					l1464:
+						position, tokenIndex = position1463, tokenIndex1463
 						if buffer[position] != rune('E') {
-							goto l1393
+							goto l1454
 						}
 						position++
 					}
-				l1402:
-					add(rulePegText, position1395)
+				l1463:
+					add(rulePegText, position1456)
 				}
-				if !_rules[ruleAction88]() {
-					goto l1393
+				if !_rules[ruleAction92]() {
+					goto l1454
 				}
-				add(ruleTRUE, position1394)
+				add(ruleTRUE, position1455)
 			}
 			return true
-		l1393:
-			position, tokenIndex = position1393, tokenIndex1393
+		l1454:
+			position, tokenIndex = position1454, tokenIndex1454
 			return false
 		},
-		/* 119 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action89)> */
+		/* 122 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action93)> */
 		func() bool {
-			position1404, tokenIndex1404 := position, tokenIndex
+			position1465, tokenIndex1465 := position, tokenIndex
 			{
-				position1405 := position
+				position1466 := position
 				{
-					position1406 := position
+					position1467 := position
 					{
-						position1407, tokenIndex1407 := position, tokenIndex
+						position1468, tokenIndex1468 := position, tokenIndex
 						if buffer[position] != rune('f') {
-							goto l1408
+							goto l1469
 						}
 						position++
-						goto l1407
-					l1408:
-						position, tokenIndex = position1407, tokenIndex1407
+						goto l1468
+					l1469:
+						position, tokenIndex = position1468, tokenIndex1468
 						if buffer[position] != rune('F') {
-							goto l1404
+							goto l1465
 						}
 						position++
 					}
-				l1407:
+				l1468:
 					{
-						position1409, tokenIndex1409 := position, tokenIndex
+						position1470, tokenIndex1470 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1410
+							goto l1471
 						}
 						position++
-						goto l1409
-					l1410:
-						position, tokenIndex = position1409, tokenIndex1409
+						goto l1470
+					l1471:
+						position, tokenIndex = position1470, tokenIndex1470
 						if buffer[position] != rune('A') {
-							goto l1404
+							goto l1465
 						}
 						position++
 					}
-				l1409:
+				l1470:
 					{
-						position1411, tokenIndex1411 := position, tokenIndex
+						position1472, tokenIndex1472 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1412
+							goto l1473
 						}
 						position++
-						goto l1411
-					l1412:
-						position, tokenIndex = position1411, tokenIndex1411
+						goto l1472
+					l1473:
+						position, tokenIndex = position1472, tokenIndex1472
 						if buffer[position] != rune('L') {
-							goto l1404
+							goto l1465
 						}
 						position++
 					}
-				l1411:
+				l1472:
 					{
-						position1413, tokenIndex1413 := position, tokenIndex
+						position1474, tokenIndex1474 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1414
+							goto l1475
 						}
 						position++
-						goto l1413
-					l1414:
-						position, tokenIndex = position1413, tokenIndex1413
+						goto l1474
+					l1475:
+						position, tokenIndex = position1474, tokenIndex1474
 						if buffer[position] != rune('S') {
-							goto l1404
+							goto l1465
 						}
 						position++
 					}
-				l1413:
+				l1474:
 					{
-						position1415, tokenIndex1415 := position, tokenIndex
+						position1476, tokenIndex1476 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1416
+							goto l1477
 						}
 						position++
-						goto l1415
-					l1416:
-						position, tokenIndex = position1415, tokenIndex1415
+						goto l1476
+					l1477:
+						position, tokenIndex = position1476, tokenIndex1476
 						if buffer[position] != rune('E') {
-							goto l1404
+							goto l1465
 						}
 						position++
 					}
-				l1415:
-					add(rulePegText, position1406)
+				l1476:
+					add(rulePegText, position1467)
 				}
-				if !_rules[ruleAction89]() {
-					goto l1404
+				if !_rules[ruleAction93]() {
+					goto l1465
 				}
-				add(ruleFALSE, position1405)
+				add(ruleFALSE, position1466)
 			}
 			return true
-		l1404:
-			position, tokenIndex = position1404, tokenIndex1404
+		l1465:
+			position, tokenIndex = position1465, tokenIndex1465
 			return false
 		},
-		/* 120 Wildcard <- <(<((ident ':' !':')? '*')> Action90)> */
+		/* 123 Wildcard <- <(<((ident ':' !':')? '*')> Action94)> */
 		func() bool {
-			position1417, tokenIndex1417 := position, tokenIndex
+			position1478, tokenIndex1478 := position, tokenIndex
 			{
-				position1418 := position
+				position1479 := position
 				{
-					position1419 := position
+					position1480 := position
 					{
-						position1420, tokenIndex1420 := position, tokenIndex
+						position1481, tokenIndex1481 := position, tokenIndex
 						if !_rules[ruleident]() {
-							goto l1420
+							goto l1481
 						}
 						if buffer[position] != rune(':') {
-							goto l1420
+							goto l1481
 						}
 						position++
 						{
-							position1422, tokenIndex1422 := position, tokenIndex
+							position1483, tokenIndex1483 := position, tokenIndex
 							if buffer[position] != rune(':') {
-								goto l1422
+								goto l1483
 							}
 							position++
-							goto l1420
-						l1422:
-							position, tokenIndex = position1422, tokenIndex1422
+							goto l1481
+						l1483:
+							position, tokenIndex = position1483, tokenIndex1483
 						}
-						goto l1421
-					l1420:
-						position, tokenIndex = position1420, tokenIndex1420
+						goto l1482
+					l1481:
+						position, tokenIndex = position1481, tokenIndex1481
 					}
-				l1421:
+				l1482:
 					if buffer[position] != rune('*') {
-						goto l1417
+						goto l1478
 					}
 					position++
-					add(rulePegText, position1419)
+					add(rulePegText, position1480)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1417
+				if !_rules[ruleAction94]() {
+					goto l1478
 				}
-				add(ruleWildcard, position1418)
+				add(ruleWildcard, position1479)
 			}
 			return true
-		l1417:
-			position, tokenIndex = position1417, tokenIndex1417
+		l1478:
+			position, tokenIndex = position1478, tokenIndex1478
 			return false
 		},
-		/* 121 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action91)> */
+		/* 124 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action95)> */
 		func() bool {
-			position1423, tokenIndex1423 := position, tokenIndex
+			position1484, tokenIndex1484 := position, tokenIndex
 			{
-				position1424 := position
+				position1485 := position
 				{
-					position1425 := position
+					position1486 := position
 					if buffer[position] != rune('"') {
-						goto l1423
+						goto l1484
 					}
 					position++
-				l1426:
+				l1487:
 					{
-						position1427, tokenIndex1427 := position, tokenIndex
+						position1488, tokenIndex1488 := position, tokenIndex
 						{
-							position1428, tokenIndex1428 := position, tokenIndex
+							position1489, tokenIndex1489 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l1429
+								goto l1490
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1429
+								goto l1490
 							}
 							position++
-							goto l1428
-						l1429:
-							position, tokenIndex = position1428, tokenIndex1428
+							goto l1489
+						l1490:
+							position, tokenIndex = position1489, tokenIndex1489
 							{
-								position1430, tokenIndex1430 := position, tokenIndex
+								position1491, tokenIndex1491 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l1430
+									goto l1491
 								}
 								position++
-								goto l1427
-							l1430:
-								position, tokenIndex = position1430, tokenIndex1430
+								goto l1488
+							l1491:
+								position, tokenIndex = position1491, tokenIndex1491
 							}
 							if !matchDot() {
-								goto l1427
+								goto l1488
 							}
 						}
-					l1428:
-						goto l1426
-					l1427:
-						position, tokenIndex = position1427, tokenIndex1427
+					l1489:
+						goto l1487
+					l1488:
+						position, tokenIndex = position1488, tokenIndex1488
 					}
 					if buffer[position] != rune('"') {
-						goto l1423
+						goto l1484
 					}
 					position++
-					add(rulePegText, position1425)
+					add(rulePegText, position1486)
 				}
-				if !_rules[ruleAction91]() {
-					goto l1423
+				if !_rules[ruleAction95]() {
+					goto l1484
 				}
-				add(ruleStringLiteral, position1424)
+				add(ruleStringLiteral, position1485)
 			}
 			return true
-		l1423:
-			position, tokenIndex = position1423, tokenIndex1423
+		l1484:
+			position, tokenIndex = position1484, tokenIndex1484
 			return false
 		},
-		/* 122 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
+		/* 125 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action96)> */
 		func() bool {
-			position1431, tokenIndex1431 := position, tokenIndex
+			position1492, tokenIndex1492 := position, tokenIndex
 			{
-				position1432 := position
+				position1493 := position
 				{
-					position1433 := position
+					position1494 := position
 					{
-						position1434, tokenIndex1434 := position, tokenIndex
+						position1495, tokenIndex1495 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1435
+							goto l1496
 						}
 						position++
-						goto l1434
-					l1435:
-						position, tokenIndex = position1434, tokenIndex1434
+						goto l1495
+					l1496:
+						position, tokenIndex = position1495, tokenIndex1495
 						if buffer[position] != rune('I') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1434:
+				l1495:
 					{
-						position1436, tokenIndex1436 := position, tokenIndex
+						position1497, tokenIndex1497 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1437
+							goto l1498
 						}
 						position++
-						goto l1436
-					l1437:
-						position, tokenIndex = position1436, tokenIndex1436
+						goto l1497
+					l1498:
+						position, tokenIndex = position1497, tokenIndex1497
 						if buffer[position] != rune('S') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1436:
+				l1497:
 					{
-						position1438, tokenIndex1438 := position, tokenIndex
+						position1499, tokenIndex1499 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1439
+							goto l1500
 						}
 						position++
-						goto l1438
-					l1439:
-						position, tokenIndex = position1438, tokenIndex1438
+						goto l1499
+					l1500:
+						position, tokenIndex = position1499, tokenIndex1499
 						if buffer[position] != rune('T') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1438:
+				l1499:
 					{
-						position1440, tokenIndex1440 := position, tokenIndex
+						position1501, tokenIndex1501 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1441
+							goto l1502
 						}
 						position++
-						goto l1440
-					l1441:
-						position, tokenIndex = position1440, tokenIndex1440
+						goto l1501
+					l1502:
+						position, tokenIndex = position1501, tokenIndex1501
 						if buffer[position] != rune('R') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1440:
+				l1501:
 					{
-						position1442, tokenIndex1442 := position, tokenIndex
+						position1503, tokenIndex1503 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1443
+							goto l1504
 						}
 						position++
-						goto l1442
-					l1443:
-						position, tokenIndex = position1442, tokenIndex1442
+						goto l1503
+					l1504:
+						position, tokenIndex = position1503, tokenIndex1503
 						if buffer[position] != rune('E') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1442:
+				l1503:
 					{
-						position1444, tokenIndex1444 := position, tokenIndex
+						position1505, tokenIndex1505 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1445
+							goto l1506
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex = position1444, tokenIndex1444
+						goto l1505
+					l1506:
+						position, tokenIndex = position1505, tokenIndex1505
 						if buffer[position] != rune('A') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1444:
+				l1505:
 					{
-						position1446, tokenIndex1446 := position, tokenIndex
+						position1507, tokenIndex1507 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1447
+							goto l1508
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex = position1446, tokenIndex1446
+						goto l1507
+					l1508:
+						position, tokenIndex = position1507, tokenIndex1507
 						if buffer[position] != rune('M') {
-							goto l1431
+							goto l1492
 						}
 						position++
 					}
-				l1446:
-					add(rulePegText, position1433)
+				l1507:
+					add(rulePegText, position1494)
 				}
-				if !_rules[ruleAction92]() {
-					goto l1431
+				if !_rules[ruleAction96]() {
+					goto l1492
 				}
-				add(ruleISTREAM, position1432)
+				add(ruleISTREAM, position1493)
 			}
 			return true
-		l1431:
-			position, tokenIndex = position1431, tokenIndex1431
+		l1492:
+			position, tokenIndex = position1492, tokenIndex1492
 			return false
 		},
-		/* 123 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action93)> */
+		/* 126 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action97)> */
 		func() bool {
-			position1448, tokenIndex1448 := position, tokenIndex
+			position1509, tokenIndex1509 := position, tokenIndex
 			{
-				position1449 := position
+				position1510 := position
 				{
-					position1450 := position
+					position1511 := position
 					{
-						position1451, tokenIndex1451 := position, tokenIndex
+						position1512, tokenIndex1512 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1452
+							goto l1513
 						}
 						position++
-						goto l1451
-					l1452:
-						position, tokenIndex = position1451, tokenIndex1451
+						goto l1512
+					l1513:
+						position, tokenIndex = position1512, tokenIndex1512
 						if buffer[position] != rune('D') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1451:
+				l1512:
 					{
-						position1453, tokenIndex1453 := position, tokenIndex
+						position1514, tokenIndex1514 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1454
+							goto l1515
 						}
 						position++
-						goto l1453
-					l1454:
-						position, tokenIndex = position1453, tokenIndex1453
+						goto l1514
+					l1515:
+						position, tokenIndex = position1514, tokenIndex1514
 						if buffer[position] != rune('S') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1453:
+				l1514:
 					{
-						position1455, tokenIndex1455 := position, tokenIndex
+						position1516, tokenIndex1516 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1456
+							goto l1517
 						}
 						position++
-						goto l1455
-					l1456:
-						position, tokenIndex = position1455, tokenIndex1455
+						goto l1516
+					l1517:
+						position, tokenIndex = position1516, tokenIndex1516
 						if buffer[position] != rune('T') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1455:
+				l1516:
 					{
-						position1457, tokenIndex1457 := position, tokenIndex
+						position1518, tokenIndex1518 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1458
+							goto l1519
 						}
 						position++
-						goto l1457
-					l1458:
-						position, tokenIndex = position1457, tokenIndex1457
+						goto l1518
+					l1519:
+						position, tokenIndex = position1518, tokenIndex1518
 						if buffer[position] != rune('R') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1457:
+				l1518:
 					{
-						position1459, tokenIndex1459 := position, tokenIndex
+						position1520, tokenIndex1520 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1460
+							goto l1521
 						}
 						position++
-						goto l1459
-					l1460:
-						position, tokenIndex = position1459, tokenIndex1459
+						goto l1520
+					l1521:
+						position, tokenIndex = position1520, tokenIndex1520
 						if buffer[position] != rune('E') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1459:
+				l1520:
 					{
-						position1461, tokenIndex1461 := position, tokenIndex
+						position1522, tokenIndex1522 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1462
+							goto l1523
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex = position1461, tokenIndex1461
+						goto l1522
+					l1523:
+						position, tokenIndex = position1522, tokenIndex1522
 						if buffer[position] != rune('A') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1461:
+				l1522:
 					{
-						position1463, tokenIndex1463 := position, tokenIndex
+						position1524, tokenIndex1524 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1464
+							goto l1525
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex = position1463, tokenIndex1463
+						goto l1524
+					l1525:
+						position, tokenIndex = position1524, tokenIndex1524
 						if buffer[position] != rune('M') {
-							goto l1448
+							goto l1509
 						}
 						position++
 					}
-				l1463:
-					add(rulePegText, position1450)
+				l1524:
+					add(rulePegText, position1511)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1448
+				if !_rules[ruleAction97]() {
+					goto l1509
 				}
-				add(ruleDSTREAM, position1449)
+				add(ruleDSTREAM, position1510)
 			}
 			return true
-		l1448:
-			position, tokenIndex = position1448, tokenIndex1448
+		l1509:
+			position, tokenIndex = position1509, tokenIndex1509
 			return false
 		},
-		/* 124 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action94)> */
+		/* 127 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action98)> */
 		func() bool {
-			position1465, tokenIndex1465 := position, tokenIndex
+			position1526, tokenIndex1526 := position, tokenIndex
 			{
-				position1466 := position
+				position1527 := position
 				{
-					position1467 := position
+					position1528 := position
 					{
-						position1468, tokenIndex1468 := position, tokenIndex
+						position1529, tokenIndex1529 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1469
+							goto l1530
 						}
 						position++
-						goto l1468
-					l1469:
-						position, tokenIndex = position1468, tokenIndex1468
+						goto l1529
+					l1530:
+						position, tokenIndex = position1529, tokenIndex1529
 						if buffer[position] != rune('R') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1468:
+				l1529:
 					{
-						position1470, tokenIndex1470 := position, tokenIndex
+						position1531, tokenIndex1531 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1471
+							goto l1532
 						}
 						position++
-						goto l1470
-					l1471:
-						position, tokenIndex = position1470, tokenIndex1470
+						goto l1531
+					l1532:
+						position, tokenIndex = position1531, tokenIndex1531
 						if buffer[position] != rune('S') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1470:
+				l1531:
 					{
-						position1472, tokenIndex1472 := position, tokenIndex
+						position1533, tokenIndex1533 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1473
+							goto l1534
 						}
 						position++
-						goto l1472
-					l1473:
-						position, tokenIndex = position1472, tokenIndex1472
+						goto l1533
+					l1534:
+						position, tokenIndex = position1533, tokenIndex1533
 						if buffer[position] != rune('T') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1472:
+				l1533:
 					{
-						position1474, tokenIndex1474 := position, tokenIndex
+						position1535, tokenIndex1535 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1475
+							goto l1536
 						}
 						position++
-						goto l1474
-					l1475:
-						position, tokenIndex = position1474, tokenIndex1474
+						goto l1535
+					l1536:
+						position, tokenIndex = position1535, tokenIndex1535
 						if buffer[position] != rune('R') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1474:
+				l1535:
 					{
-						position1476, tokenIndex1476 := position, tokenIndex
+						position1537, tokenIndex1537 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1477
+							goto l1538
 						}
 						position++
-						goto l1476
-					l1477:
-						position, tokenIndex = position1476, tokenIndex1476
+						goto l1537
+					l1538:
+						position, tokenIndex = position1537, tokenIndex1537
 						if buffer[position] != rune('E') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1476:
+				l1537:
 					{
-						position1478, tokenIndex1478 := position, tokenIndex
+						position1539, tokenIndex1539 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1479
+							goto l1540
 						}
 						position++
-						goto l1478
-					l1479:
-						position, tokenIndex = position1478, tokenIndex1478
+						goto l1539
+					l1540:
+						position, tokenIndex = position1539, tokenIndex1539
 						if buffer[position] != rune('A') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1478:
+				l1539:
 					{
-						position1480, tokenIndex1480 := position, tokenIndex
+						position1541, tokenIndex1541 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1481
+							goto l1542
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex = position1480, tokenIndex1480
+						goto l1541
+					l1542:
+						position, tokenIndex = position1541, tokenIndex1541
 						if buffer[position] != rune('M') {
-							goto l1465
+							goto l1526
 						}
 						position++
 					}
-				l1480:
-					add(rulePegText, position1467)
+				l1541:
+					add(rulePegText, position1528)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1465
+				if !_rules[ruleAction98]() {
+					goto l1526
 				}
-				add(ruleRSTREAM, position1466)
+				add(ruleRSTREAM, position1527)
 			}
 			return true
-		l1465:
-			position, tokenIndex = position1465, tokenIndex1465
+		l1526:
+			position, tokenIndex = position1526, tokenIndex1526
 			return false
 		},
-		/* 125 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action95)> */
+		/* 128 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action99)> */
 		func() bool {
-			position1482, tokenIndex1482 := position, tokenIndex
+			position1543, tokenIndex1543 := position, tokenIndex
 			{
-				position1483 := position
+				position1544 := position
 				{
-					position1484 := position
+					position1545 := position
 					{
-						position1485, tokenIndex1485 := position, tokenIndex
+						position1546, tokenIndex1546 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1486
+							goto l1547
 						}
 						position++
-						goto l1485
-					l1486:
-						position, tokenIndex = position1485, tokenIndex1485
+						goto l1546
+					l1547:
+						position, tokenIndex = position1546, tokenIndex1546
 						if buffer[position] != rune('T') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1485:
+				l1546:
 					{
-						position1487, tokenIndex1487 := position, tokenIndex
+						position1548, tokenIndex1548 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1488
+							goto l1549
 						}
 						position++
-						goto l1487
-					l1488:
-						position, tokenIndex = position1487, tokenIndex1487
+						goto l1548
+					l1549:
+						position, tokenIndex = position1548, tokenIndex1548
 						if buffer[position] != rune('U') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1487:
+				l1548:
 					{
-						position1489, tokenIndex1489 := position, tokenIndex
+						position1550, tokenIndex1550 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1490
+							goto l1551
 						}
 						position++
-						goto l1489
-					l1490:
-						position, tokenIndex = position1489, tokenIndex1489
+						goto l1550
+					l1551:
+						position, tokenIndex = position1550, tokenIndex1550
 						if buffer[position] != rune('P') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1489:
+				l1550:
 					{
-						position1491, tokenIndex1491 := position, tokenIndex
+						position1552, tokenIndex1552 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1492
+							goto l1553
 						}
 						position++
-						goto l1491
-					l1492:
-						position, tokenIndex = position1491, tokenIndex1491
+						goto l1552
+					l1553:
+						position, tokenIndex = position1552, tokenIndex1552
 						if buffer[position] != rune('L') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1491:
+				l1552:
 					{
-						position1493, tokenIndex1493 := position, tokenIndex
+						position1554, tokenIndex1554 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1494
+							goto l1555
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex = position1493, tokenIndex1493
+						goto l1554
+					l1555:
+						position, tokenIndex = position1554, tokenIndex1554
 						if buffer[position] != rune('E') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1493:
+				l1554:
 					{
-						position1495, tokenIndex1495 := position, tokenIndex
+						position1556, tokenIndex1556 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1496
+							goto l1557
 						}
 						position++
-						goto l1495
-					l1496:
-						position, tokenIndex = position1495, tokenIndex1495
+						goto l1556
+					l1557:
+						position, tokenIndex = position1556, tokenIndex1556
 						if buffer[position] != rune('S') {
-							goto l1482
+							goto l1543
 						}
 						position++
 					}
-				l1495:
-					add(rulePegText, position1484)
+				l1556:
+					add(rulePegText, position1545)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1482
+				if !_rules[ruleAction99]() {
+					goto l1543
 				}
-				add(ruleTUPLES, position1483)
+				add(ruleTUPLES, position1544)
 			}
 			return true
-		l1482:
-			position, tokenIndex = position1482, tokenIndex1482
+		l1543:
+			position, tokenIndex = position1543, tokenIndex1543
 			return false
 		},
-		/* 126 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action96)> */
+		/* 129 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action100)> */
 		func() bool {
-			position1497, tokenIndex1497 := position, tokenIndex
+			position1558, tokenIndex1558 := position, tokenIndex
 			{
-				position1498 := position
+				position1559 := position
 				{
-					position1499 := position
+					position1560 := position
 					{
-						position1500, tokenIndex1500 := position, tokenIndex
+						position1561, tokenIndex1561 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1501
+							goto l1562
 						}
 						position++
-						goto l1500
-					l1501:
-						position, tokenIndex = position1500, tokenIndex1500
+						goto l1561
+					l1562:
+						position, tokenIndex = position1561, tokenIndex1561
 						if buffer[position] != rune('S') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1500:
+				l1561:
 					{
-						position1502, tokenIndex1502 := position, tokenIndex
+						position1563, tokenIndex1563 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1503
+							goto l1564
 						}
 						position++
-						goto l1502
-					l1503:
-						position, tokenIndex = position1502, tokenIndex1502
+						goto l1563
+					l1564:
+						position, tokenIndex = position1563, tokenIndex1563
 						if buffer[position] != rune('E') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1502:
+				l1563:
 					{
-						position1504, tokenIndex1504 := position, tokenIndex
+						position1565, tokenIndex1565 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1505
+							goto l1566
 						}
 						position++
-						goto l1504
-					l1505:
-						position, tokenIndex = position1504, tokenIndex1504
+						goto l1565
+					l1566:
+						position, tokenIndex = position1565, tokenIndex1565
 						if buffer[position] != rune('C') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1504:
+				l1565:
 					{
-						position1506, tokenIndex1506 := position, tokenIndex
+						position1567, tokenIndex1567 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1507
+							goto l1568
 						}
 						position++
-						goto l1506
-					l1507:
-						position, tokenIndex = position1506, tokenIndex1506
+						goto l1567
+					l1568:
+						position, tokenIndex = position1567, tokenIndex1567
 						if buffer[position] != rune('O') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1506:
+				l1567:
 					{
-						position1508, tokenIndex1508 := position, tokenIndex
+						position1569, tokenIndex1569 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1509
+							goto l1570
 						}
 						position++
-						goto l1508
-					l1509:
-						position, tokenIndex = position1508, tokenIndex1508
+						goto l1569
+					l1570:
+						position, tokenIndex = position1569, tokenIndex1569
 						if buffer[position] != rune('N') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1508:
+				l1569:
 					{
-						position1510, tokenIndex1510 := position, tokenIndex
+						position1571, tokenIndex1571 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1511
+							goto l1572
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex = position1510, tokenIndex1510
+						goto l1571
+					l1572:
+						position, tokenIndex = position1571, tokenIndex1571
 						if buffer[position] != rune('D') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1510:
+				l1571:
 					{
-						position1512, tokenIndex1512 := position, tokenIndex
+						position1573, tokenIndex1573 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1513
+							goto l1574
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex = position1512, tokenIndex1512
+						goto l1573
+					l1574:
+						position, tokenIndex = position1573, tokenIndex1573
 						if buffer[position] != rune('S') {
-							goto l1497
+							goto l1558
 						}
 						position++
 					}
-				l1512:
-					add(rulePegText, position1499)
+				l1573:
+					add(rulePegText, position1560)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1497
+				if !_rules[ruleAction100]() {
+					goto l1558
 				}
-				add(ruleSECONDS, position1498)
+				add(ruleSECONDS, position1559)
 			}
 			return true
-		l1497:
-			position, tokenIndex = position1497, tokenIndex1497
+		l1558:
+			position, tokenIndex = position1558, tokenIndex1558
 			return false
 		},
-		/* 127 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action97)> */
+		/* 130 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action101)> */
 		func() bool {
-			position1514, tokenIndex1514 := position, tokenIndex
+			position1575, tokenIndex1575 := position, tokenIndex
 			{
-				position1515 := position
+				position1576 := position
 				{
-					position1516 := position
+					position1577 := position
 					{
-						position1517, tokenIndex1517 := position, tokenIndex
+						position1578, tokenIndex1578 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1518
+							goto l1579
 						}
 						position++
-						goto l1517
-					l1518:
-						position, tokenIndex = position1517, tokenIndex1517
+						goto l1578
+					l1579:
+						position, tokenIndex = position1578, tokenIndex1578
 						if buffer[position] != rune('M') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1517:
+				l1578:
 					{
-						position1519, tokenIndex1519 := position, tokenIndex
+						position1580, tokenIndex1580 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1520
+							goto l1581
 						}
 						position++
-						goto l1519
-					l1520:
-						position, tokenIndex = position1519, tokenIndex1519
+						goto l1580
+					l1581:
+						position, tokenIndex = position1580, tokenIndex1580
 						if buffer[position] != rune('I') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1519:
+				l1580:
 					{
-						position1521, tokenIndex1521 := position, tokenIndex
+						position1582, tokenIndex1582 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1522
+							goto l1583
 						}
 						position++
-						goto l1521
-					l1522:
-						position, tokenIndex = position1521, tokenIndex1521
+						goto l1582
+					l1583:
+						position, tokenIndex = position1582, tokenIndex1582
 						if buffer[position] != rune('L') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1521:
+				l1582:
 					{
-						position1523, tokenIndex1523 := position, tokenIndex
+						position1584, tokenIndex1584 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1524
+							goto l1585
 						}
 						position++
-						goto l1523
-					l1524:
-						position, tokenIndex = position1523, tokenIndex1523
+						goto l1584
+					l1585:
+						position, tokenIndex = position1584, tokenIndex1584
 						if buffer[position] != rune('L') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1523:
+				l1584:
 					{
-						position1525, tokenIndex1525 := position, tokenIndex
+						position1586, tokenIndex1586 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1526
+							goto l1587
 						}
 						position++
-						goto l1525
-					l1526:
-						position, tokenIndex = position1525, tokenIndex1525
+						goto l1586
+					l1587:
+						position, tokenIndex = position1586, tokenIndex1586
 						if buffer[position] != rune('I') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1525:
+				l1586:
 					{
-						position1527, tokenIndex1527 := position, tokenIndex
+						position1588, tokenIndex1588 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1528
+							goto l1589
 						}
 						position++
-						goto l1527
-					l1528:
-						position, tokenIndex = position1527, tokenIndex1527
+						goto l1588
+					l1589:
+						position, tokenIndex = position1588, tokenIndex1588
 						if buffer[position] != rune('S') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1527:
+				l1588:
 					{
-						position1529, tokenIndex1529 := position, tokenIndex
+						position1590, tokenIndex1590 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1530
+							goto l1591
 						}
 						position++
-						goto l1529
-					l1530:
-						position, tokenIndex = position1529, tokenIndex1529
+						goto l1590
+					l1591:
+						position, tokenIndex = position1590, tokenIndex1590
 						if buffer[position] != rune('E') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1529:
+				l1590:
 					{
-						position1531, tokenIndex1531 := position, tokenIndex
+						position1592, tokenIndex1592 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1532
+							goto l1593
 						}
 						position++
-						goto l1531
-					l1532:
-						position, tokenIndex = position1531, tokenIndex1531
+						goto l1592
+					l1593:
+						position, tokenIndex = position1592, tokenIndex1592
 						if buffer[position] != rune('C') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1531:
+				l1592:
 					{
-						position1533, tokenIndex1533 := position, tokenIndex
+						position1594, tokenIndex1594 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1534
-						}
-						position++
-						goto l1533
-					l1534:
-						position, tokenIndex = position1533, tokenIndex1533
+							goto l1595
+						}
+						position++
+						goto l1594
+					l1595:
+						position, tokenIndex = position1594, tokenIndex1594
 						if buffer[position] != rune('O') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1533:
+				l1594:
 					{
-						position1535, tokenIndex1535 := position, tokenIndex
+						position1596, tokenIndex1596 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1536
+							goto l1597
 						}
 						position++
-						goto l1535
-					l1536:
-						position, tokenIndex = position1535, tokenIndex1535
+						goto l1596
+					l1597:
+						position, tokenIndex = position1596, tokenIndex1596
 						if buffer[position] != rune('N') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1535:
+				l1596:
 					{
-						position1537, tokenIndex1537 := position, tokenIndex
+						position1598, tokenIndex1598 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1538
+							goto l1599
 						}
 						position++
-						goto l1537
-					l1538:
-						position, tokenIndex = position1537, tokenIndex1537
+						goto l1598
+					l1599:
+						position, tokenIndex = position1598, tokenIndex1598
 						if buffer[position] != rune('D') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1537:
+				l1598:
 					{
-						position1539, tokenIndex1539 := position, tokenIndex
+						position1600, tokenIndex1600 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1540
+							goto l1601
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex = position1539, tokenIndex1539
+						goto l1600
+					l1601:
+						position, tokenIndex = position1600, tokenIndex1600
 						if buffer[position] != rune('S') {
-							goto l1514
+							goto l1575
 						}
 						position++
 					}
-				l1539:
-					add(rulePegText, position1516)
+				l1600:
+					add(rulePegText, position1577)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1514
+				if !_rules[ruleAction101]() {
+					goto l1575
 				}
-				add(ruleMILLISECONDS, position1515)
+				add(ruleMILLISECONDS, position1576)
 			}
 			return true
-		l1514:
-			position, tokenIndex = position1514, tokenIndex1514
+		l1575:
+			position, tokenIndex = position1575, tokenIndex1575
 			return false
 		},
-		/* 128 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action98)> */
+		/* 131 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action102)> */
 		func() bool {
-			position1541, tokenIndex1541 := position, tokenIndex
+			position1602, tokenIndex1602 := position, tokenIndex
 			{
-				position1542 := position
+				position1603 := position
 				{
-					position1543 := position
+					position1604 := position
 					{
-						position1544, tokenIndex1544 := position, tokenIndex
+						position1605, tokenIndex1605 := position, tokenIndex
 						if buffer[position] != rune('w') {
-							goto l1545
+							goto l1606
 						}
 						position++
-						goto l1544
-					l1545:
-						position, tokenIndex = position1544, tokenIndex1544
+						goto l1605
+					l1606:
+						position, tokenIndex = position1605, tokenIndex1605
 						if buffer[position] != rune('W') {
-							goto l1541
+							goto l1602
 						}
 						position++
 					}
-				l1544:
+				l1605:
 					{
-						position1546, tokenIndex1546 := position, tokenIndex
+						position1607, tokenIndex1607 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1547
+							goto l1608
 						}
 						position++
-						goto l1546
-					l1547:
-						position, tokenIndex = position1546, tokenIndex1546
+						goto l1607
+					l1608:
+						position, tokenIndex = position1607, tokenIndex1607
 						if buffer[position] != rune('A') {
-							goto l1541
+							goto l1602
 						}
 						position++
 					}
-				l1546:
+				l1607:
 					{
-						position1548, tokenIndex1548 := position, tokenIndex
+						position1609, tokenIndex1609 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1549
+							goto l1610
 						}
 						position++
-						goto l1548
-					l1549:
-						position, tokenIndex = position1548, tokenIndex1548
+						goto l1609
+					l1610:
+						position, tokenIndex = position1609, tokenIndex1609
 						if buffer[position] != rune('I') {
-							goto l1541
+							goto l1602
 						}
 						position++
 					}
-				l1548:
+				l1609:
 					{
-						position1550, tokenIndex1550 := position, tokenIndex
+						position1611, tokenIndex1611 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1551
+							goto l1612
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex = position1550, tokenIndex1550
+						goto l1611
+					l1612:
+						position, tokenIndex = position1611, tokenIndex1611
 						if buffer[position] != rune('T') {
-							goto l1541
+							goto l1602
 						}
 						position++
 					}
-				l1550:
-					add(rulePegText, position1543)
+				l1611:
+					add(rulePegText, position1604)
 				}
-				if !_rules[ruleAction98]() {
-					goto l1541
+				if !_rules[ruleAction102]() {
+					goto l1602
 				}
-				add(ruleWait, position1542)
+				add(ruleWait, position1603)
 			}
 			return true
-		l1541:
-			position, tokenIndex = position1541, tokenIndex1541
+		l1602:
+			position, tokenIndex = position1602, tokenIndex1602
 			return false
 		},
-		/* 129 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action99)> */
+		/* 132 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action103)> */
 		func() bool {
-			position1552, tokenIndex1552 := position, tokenIndex
+			position1613, tokenIndex1613 := position, tokenIndex
 			{
-				position1553 := position
+				position1614 := position
 				{
-					position1554 := position
+					position1615 := position
 					{
-						position1555, tokenIndex1555 := position, tokenIndex
+						position1616, tokenIndex1616 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1556
+							goto l1617
 						}
 						position++
-						goto l1555
-					l1556:
-						position, tokenIndex = position1555, tokenIndex1555
+						goto l1616
+					l1617:
+						position, tokenIndex = position1616, tokenIndex1616
 						if buffer[position] != rune('D') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1555:
+				l1616:
 					{
-						position1557, tokenIndex1557 := position, tokenIndex
+						position1618, tokenIndex1618 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1558
+							goto l1619
 						}
 						position++
-						goto l1557
-					l1558:
-						position, tokenIndex = position1557, tokenIndex1557
+						goto l1618
+					l1619:
+						position, tokenIndex = position1618, tokenIndex1618
 						if buffer[position] != rune('R') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1557:
+				l1618:
 					{
-						position1559, tokenIndex1559 := position, tokenIndex
+						position1620, tokenIndex1620 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1560
+							goto l1621
 						}
 						position++
-						goto l1559
-					l1560:
-						position, tokenIndex = position1559, tokenIndex1559
+						goto l1620
+					l1621:
+						position, tokenIndex = position1620, tokenIndex1620
 						if buffer[position] != rune('O') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1559:
+				l1620:
 					{
-						position1561, tokenIndex1561 := position, tokenIndex
+						position1622, tokenIndex1622 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1562
+							goto l1623
 						}
 						position++
-						goto l1561
-					l1562:
-						position, tokenIndex = position1561, tokenIndex1561
+						goto l1622
+					l1623:
+						position, tokenIndex = position1622, tokenIndex1622
 						if buffer[position] != rune('P') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1561:
+				l1622:
 					if !_rules[rulesp]() {
-						goto l1552
+						goto l1613
 					}
 					{
-						position1563, tokenIndex1563 := position, tokenIndex
+						position1624, tokenIndex1624 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1564
+							goto l1625
 						}
 						position++
-						goto l1563
-					l1564:
-						position, tokenIndex = position1563, tokenIndex1563
+						goto l1624
+					l1625:
+						position, tokenIndex = position1624, tokenIndex1624
 						if buffer[position] != rune('O') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1563:
+				l1624:
 					{
-						position1565, tokenIndex1565 := position, tokenIndex
+						position1626, tokenIndex1626 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1566
+							goto l1627
 						}
 						position++
-						goto l1565
-					l1566:
-						position, tokenIndex = position1565, tokenIndex1565
+						goto l1626
+					l1627:
+						position, tokenIndex = position1626, tokenIndex1626
 						if buffer[position] != rune('L') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1565:
+				l1626:
 					{
-						position1567, tokenIndex1567 := position, tokenIndex
+						position1628, tokenIndex1628 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1568
+							goto l1629
 						}
 						position++
-						goto l1567
-					l1568:
-						position, tokenIndex = position1567, tokenIndex1567
+						goto l1628
+					l1629:
+						position, tokenIndex = position1628, tokenIndex1628
 						if buffer[position] != rune('D') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1567:
+				l1628:
 					{
-						position1569, tokenIndex1569 := position, tokenIndex
+						position1630, tokenIndex1630 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1570
+							goto l1631
 						}
 						position++
-						goto l1569
-					l1570:
-						position, tokenIndex = position1569, tokenIndex1569
+						goto l1630
+					l1631:
+						position, tokenIndex = position1630, tokenIndex1630
 						if buffer[position] != rune('E') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1569:
+				l1630:
 					{
-						position1571, tokenIndex1571 := position, tokenIndex
+						position1632, tokenIndex1632 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1572
+							goto l1633
 						}
 						position++
-						goto l1571
-					l1572:
-						position, tokenIndex = position1571, tokenIndex1571
+						goto l1632
+					l1633:
+						position, tokenIndex = position1632, tokenIndex1632
 						if buffer[position] != rune('S') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1571:
+				l1632:
 					{
-						position1573, tokenIndex1573 := position, tokenIndex
+						position1634, tokenIndex1634 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1574
+							goto l1635
 						}
 						position++
-						goto l1573
-					l1574:
-						position, tokenIndex = position1573, tokenIndex1573
+						goto l1634
+					l1635:
+						position, tokenIndex = position1634, tokenIndex1634
 						if buffer[position] != rune('T') {
-							goto l1552
+							goto l1613
 						}
 						position++
 					}
-				l1573:
-					add(rulePegText, position1554)
+				l1634:
+					add(rulePegText, position1615)
 				}
-				if !_rules[ruleAction99]() {
-					goto l1552
+				if !_rules[ruleAction103]() {
+					goto l1613
 				}
-				add(ruleDropOldest, position1553)
+				add(ruleDropOldest, position1614)
 			}
 			return true
-		l1552:
-			position, tokenIndex = position1552, tokenIndex1552
+		l1613:
+			position, tokenIndex = position1613, tokenIndex1613
 			return false
 		},
-		/* 130 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action100)> */
+		/* 133 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action104)> */
 		func() bool {
-			position1575, tokenIndex1575 := position, tokenIndex
+			position1636, tokenIndex1636 := position, tokenIndex
 			{
-				position1576 := position
+				position1637 := position
 				{
-					position1577 := position
+					position1638 := position
 					{
-						position1578, tokenIndex1578 := position, tokenIndex
+						position1639, tokenIndex1639 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1579
+							goto l1640
 						}
 						position++
-						goto l1578
-					l1579:
-						position, tokenIndex = position1578, tokenIndex1578
+						goto l1639
+					l1640:
+						position, tokenIndex = position1639, tokenIndex1639
 						if buffer[position] != rune('D') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1578:
+				l1639:
 					{
-						position1580, tokenIndex1580 := position, tokenIndex
+						position1641, tokenIndex1641 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1581
+							goto l1642
 						}
 						position++
-						goto l1580
-					l1581:
-						position, tokenIndex = position1580, tokenIndex1580
+						goto l1641
+					l1642:
+						position, tokenIndex = position1641, tokenIndex1641
 						if buffer[position] != rune('R') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1580:
+				l1641:
 					{
-						position1582, tokenIndex1582 := position, tokenIndex
+						position1643, tokenIndex1643 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1583
+							goto l1644
 						}
 						position++
-						goto l1582
-					l1583:
-						position, tokenIndex = position1582, tokenIndex1582
+						goto l1643
+					l1644:
+						position, tokenIndex = position1643, tokenIndex1643
 						if buffer[position] != rune('O') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1582:
+				l1643:
 					{
-						position1584, tokenIndex1584 := position, tokenIndex
+						position1645, tokenIndex1645 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1585
+							goto l1646
 						}
 						position++
-						goto l1584
-					l1585:
-						position, tokenIndex = position1584, tokenIndex1584
+						goto l1645
+					l1646:
+						position, tokenIndex = position1645, tokenIndex1645
 						if buffer[position] != rune('P') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1584:
+				l1645:
 					if !_rules[rulesp]() {
-						goto l1575
+						goto l1636
 					}
 					{
-						position1586, tokenIndex1586 := position, tokenIndex
+						position1647, tokenIndex1647 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1587
+							goto l1648
 						}
 						position++
-						goto l1586
-					l1587:
-						position, tokenIndex = position1586, tokenIndex1586
+						goto l1647
+					l1648:
+						position, tokenIndex = position1647, tokenIndex1647
 						if buffer[position] != rune('N') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1586:
+				l1647:
 					{
-						position1588, tokenIndex1588 := position, tokenIndex
+						position1649, tokenIndex1649 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1589
+							goto l1650
 						}
 						position++
-						goto l1588
-					l1589:
-						position, tokenIndex = position1588, tokenIndex1588
+						goto l1649
+					l1650:
+						position, tokenIndex = position1649, tokenIndex1649
 						if buffer[position] != rune('E') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1588:
+				l1649:
 					{
-						position1590, tokenIndex1590 := position, tokenIndex
+						position1651, tokenIndex1651 := position, tokenIndex
 						if buffer[position] != rune('w') {
-							goto l1591
+							goto l1652
 						}
 						position++
-						goto l1590
-					l1591:
-						position, tokenIndex = position1590, tokenIndex1590
+						goto l1651
+					l1652:
+						position, tokenIndex = position1651, tokenIndex1651
 						if buffer[position] != rune('W') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1590:
+				l1651:
 					{
-						position1592, tokenIndex1592 := position, tokenIndex
+						position1653, tokenIndex1653 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1593
+							goto l1654
 						}
 						position++
-						goto l1592
-					l1593:
-						position, tokenIndex = position1592, tokenIndex1592
+						goto l1653
+					l1654:
+						position, tokenIndex = position1653, tokenIndex1653
 						if buffer[position] != rune('E') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1592:
+				l1653:
 					{
-						position1594, tokenIndex1594 := position, tokenIndex
+						position1655, tokenIndex1655 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1595
+							goto l1656
 						}
 						position++
-						goto l1594
-					l1595:
-						position, tokenIndex = position1594, tokenIndex1594
+						goto l1655
+					l1656:
+						position, tokenIndex = position1655, tokenIndex1655
 						if buffer[position] != rune('S') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1594:
+				l1655:
 					{
-						position1596, tokenIndex1596 := position, tokenIndex
+						position1657, tokenIndex1657 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1597
+							goto l1658
 						}
 						position++
-						goto l1596
-					l1597:
-						position, tokenIndex = position1596, tokenIndex1596
+						goto l1657
+					l1658:
+						position, tokenIndex = position1657, tokenIndex1657
 						if buffer[position] != rune('T') {
-							goto l1575
+							goto l1636
 						}
 						position++
 					}
-				l1596:
-					add(rulePegText, position1577)
+				l1657:
+					add(rulePegText, position1638)
 				}
-				if !_rules[ruleAction100]() {
-					goto l1575
+				if !_rules[ruleAction104]() {
+					goto l1636
 				}
-				add(ruleDropNewest, position1576)
+				add(ruleDropNewest, position1637)
 			}
 			return true
-		l1575:
-			position, tokenIndex = position1575, tokenIndex1575
+		l1636:
+			position, tokenIndex = position1636, tokenIndex1636
 			return false
 		},
-		/* 131 StreamIdentifier <- <(<ident> Action101)> */
+		/* 134 StreamIdentifier <- <(<ident> Action105)> */
 		func() bool {
-			position1598, tokenIndex1598 := position, tokenIndex
+			position1659, tokenIndex1659 := position, tokenIndex
 			{
-				position1599 := position
+				position1660 := position
 				{
-					position1600 := position
+					position1661 := position
 					if !_rules[ruleident]() {
-						goto l1598
+						goto l1659
 					}
-					add(rulePegText, position1600)
+					add(rulePegText, position1661)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1598
+				if !_rules[ruleAction105]() {
+					goto l1659
 				}
-				add(ruleStreamIdentifier, position1599)
+				add(ruleStreamIdentifier, position1660)
 			}
 			return true
-		l1598:
-			position, tokenIndex = position1598, tokenIndex1598
+		l1659:
+			position, tokenIndex = position1659, tokenIndex1659
 			return false
 		},
-		/* 132 SourceSinkType <- <(<ident> Action102)> */
+		/* 135 SourceSinkType <- <(<ident> Action106)> */
 		func() bool {
-			position1601, tokenIndex1601 := position, tokenIndex
+			position1662, tokenIndex1662 := position, tokenIndex
 			{
-				position1602 := position
+				position1663 := position
 				{
-					position1603 := position
+					position1664 := position
 					if !_rules[ruleident]() {
-						goto l1601
+						goto l1662
 					}
-					add(rulePegText, position1603)
+					add(rulePegText, position1664)
 				}
-				if !_rules[ruleAction102]() {
-					goto l1601
+				if !_rules[ruleAction106]() {
+					goto l1662
 				}
-				add(ruleSourceSinkType, position1602)
+				add(ruleSourceSinkType, position1663)
 			}
 			return true
-		l1601:
-			position, tokenIndex = position1601, tokenIndex1601
+		l1662:
+			position, tokenIndex = position1662, tokenIndex1662
 			return false
 		},
-		/* 133 SourceSinkParamKey <- <(<ident> Action103)> */
+		/* 136 SourceSinkParamKey <- <(<ident> Action107)> */
 		func() bool {
-			position1604, tokenIndex1604 := position, tokenIndex
+			position1665, tokenIndex1665 := position, tokenIndex
 			{
-				position1605 := position
+				position1666 := position
 				{
-					position1606 := position
+					position1667 := position
 					if !_rules[ruleident]() {
-						goto l1604
+						goto l1665
 					}
-					add(rulePegText, position1606)
+					add(rulePegText, position1667)
 				}
-				if !_rules[ruleAction103]() {
-					goto l1604
+				if !_rules[ruleAction107]() {
+					goto l1665
 				}
-				add(ruleSourceSinkParamKey, position1605)
+				add(ruleSourceSinkParamKey, position1666)
 			}
 			return true
-		l1604:
-			position, tokenIndex = position1604, tokenIndex1604
+		l1665:
+			position, tokenIndex = position1665, tokenIndex1665
 			return false
 		},
-		/* 134 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action104)> */
+		/* 137 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action108)> */
 		func() bool {
-			position1607, tokenIndex1607 := position, tokenIndex
+			position1668, tokenIndex1668 := position, tokenIndex
 			{
-				position1608 := position
+				position1669 := position
 				{
-					position1609 := position
+					position1670 := position
 					{
-						position1610, tokenIndex1610 := position, tokenIndex
+						position1671, tokenIndex1671 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1611
+							goto l1672
 						}
 						position++
-						goto l1610
-					l1611:
-						position, tokenIndex = position1610, tokenIndex1610
+						goto l1671
+					l1672:
+						position, tokenIndex = position1671, tokenIndex1671
 						if buffer[position] != rune('P') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1610:
+				l1671:
 					{
-						position1612, tokenIndex1612 := position, tokenIndex
+						position1673, tokenIndex1673 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1613
+							goto l1674
 						}
 						position++
-						goto l1612
-					l1613:
-						position, tokenIndex = position1612, tokenIndex1612
+						goto l1673
+					l1674:
+						position, tokenIndex = position1673, tokenIndex1673
 						if buffer[position] != rune('A') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1612:
+				l1673:
 					{
-						position1614, tokenIndex1614 := position, tokenIndex
+						position1675, tokenIndex1675 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1615
+							goto l1676
 						}
 						position++
-						goto l1614
-					l1615:
-						position, tokenIndex = position1614, tokenIndex1614
+						goto l1675
+					l1676:
+						position, tokenIndex = position1675, tokenIndex1675
 						if buffer[position] != rune('U') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1614:
+				l1675:
 					{
-						position1616, tokenIndex1616 := position, tokenIndex
+						position1677, tokenIndex1677 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1617
+							goto l1678
 						}
 						position++
-						goto l1616
-					l1617:
-						position, tokenIndex = position1616, tokenIndex1616
+						goto l1677
+					l1678:
+						position, tokenIndex = position1677, tokenIndex1677
 						if buffer[position] != rune('S') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1616:
+				l1677:
 					{
-						position1618, tokenIndex1618 := position, tokenIndex
+						position1679, tokenIndex1679 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1619
+							goto l1680
 						}
 						position++
-						goto l1618
-					l1619:
-						position, tokenIndex = position1618, tokenIndex1618
+						goto l1679
+					l1680:
+						position, tokenIndex = position1679, tokenIndex1679
 						if buffer[position] != rune('E') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1618:
+				l1679:
 					{
-						position1620, tokenIndex1620 := position, tokenIndex
+						position1681, tokenIndex1681 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1621
+							goto l1682
 						}
 						position++
-						goto l1620
-					l1621:
-						position, tokenIndex = position1620, tokenIndex1620
+						goto l1681
+					l1682:
+						position, tokenIndex = position1681, tokenIndex1681
 						if buffer[position] != rune('D') {
-							goto l1607
+							goto l1668
 						}
 						position++
 					}
-				l1620:
-					add(rulePegText, position1609)
+				l1681:
+					add(rulePegText, position1670)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1607
+				if !_rules[ruleAction108]() {
+					goto l1668
 				}
-				add(rulePaused, position1608)
+				add(rulePaused, position1669)
 			}
 			return true
-		l1607:
-			position, tokenIndex = position1607, tokenIndex1607
+		l1668:
+			position, tokenIndex = position1668, tokenIndex1668
 			return false
 		},
-		/* 135 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action105)> */
+		/* 138 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action109)> */
 		func() bool {
-			position1622, tokenIndex1622 := position, tokenIndex
+			position1683, tokenIndex1683 := position, tokenIndex
 			{
-				position1623 := position
+				position1684 := position
 				{
-					position1624 := position
+					position1685 := position
 					{
-						position1625, tokenIndex1625 := position, tokenIndex
+						position1686, tokenIndex1686 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1626
+							goto l1687
 						}
 						position++
-						goto l1625
-					l1626:
-						position, tokenIndex = position1625, tokenIndex1625
+						goto l1686
+					l1687:
+						position, tokenIndex = position1686, tokenIndex1686
 						if buffer[position] != rune('U') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1625:
+				l1686:
 					{
-						position1627, tokenIndex1627 := position, tokenIndex
+						position1688, tokenIndex1688 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1628
+							goto l1689
 						}
 						position++
-						goto l1627
-					l1628:
-						position, tokenIndex = position1627, tokenIndex1627
+						goto l1688
+					l1689:
+						position, tokenIndex = position1688, tokenIndex1688
 						if buffer[position] != rune('N') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1627:
+				l1688:
 					{
-						position1629, tokenIndex1629 := position, tokenIndex
+						position1690, tokenIndex1690 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1630
+							goto l1691
 						}
 						position++
-						goto l1629
-					l1630:
-						position, tokenIndex = position1629, tokenIndex1629
+						goto l1690
+					l1691:
+						position, tokenIndex = position1690, tokenIndex1690
 						if buffer[position] != rune('P') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1629:
+				l1690:
 					{
-						position1631, tokenIndex1631 := position, tokenIndex
+						position1692, tokenIndex1692 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1632
+							goto l1693
 						}
 						position++
-						goto l1631
-					l1632:
-						position, tokenIndex = position1631, tokenIndex1631
+						goto l1692
+					l1693:
+						position, tokenIndex = position1692, tokenIndex1692
 						if buffer[position] != rune('A') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1631:
+				l1692:
 					{
-						position1633, tokenIndex1633 := position, tokenIndex
+						position1694, tokenIndex1694 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1634
+							goto l1695
 						}
 						position++
-						goto l1633
-					l1634:
-						position, tokenIndex = position1633, tokenIndex1633
+						goto l1694
+					l1695:
+						position, tokenIndex = position1694, tokenIndex1694
 						if buffer[position] != rune('U') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1633:
+				l1694:
 					{
-						position1635, tokenIndex1635 := position, tokenIndex
+						position1696, tokenIndex1696 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1636
+							goto l1697
 						}
 						position++
-						goto l1635
-					l1636:
-						position, tokenIndex = position1635, tokenIndex1635
+						goto l1696
+					l1697:
+						position, tokenIndex = position1696, tokenIndex1696
 						if buffer[position] != rune('S') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1635:
+				l1696:
 					{
-						position1637, tokenIndex1637 := position, tokenIndex
+						position1698, tokenIndex1698 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1638
+							goto l1699
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex = position1637, tokenIndex1637
+						goto l1698
+					l1699:
+						position, tokenIndex = position1698, tokenIndex1698
 						if buffer[position] != rune('E') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1637:
+				l1698:
 					{
-						position1639, tokenIndex1639 := position, tokenIndex
+						position1700, tokenIndex1700 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1640
+							goto l1701
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex = position1639, tokenIndex1639
+						goto l1700
+					l1701:
+						position, tokenIndex = position1700, tokenIndex1700
 						if buffer[position] != rune('D') {
-							goto l1622
+							goto l1683
 						}
 						position++
 					}
-				l1639:
-					add(rulePegText, position1624)
+				l1700:
+					add(rulePegText, position1685)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1622
+				if !_rules[ruleAction109]() {
+					goto l1683
 				}
-				add(ruleUnpaused, position1623)
+				add(ruleUnpaused, position1684)
 			}
 			return true
-		l1622:
-			position, tokenIndex = position1622, tokenIndex1622
+		l1683:
+			position, tokenIndex = position1683, tokenIndex1683
 			return false
 		},
-		/* 136 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action106)> */
+		/* 139 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action110)> */
 		func() bool {
-			position1641, tokenIndex1641 := position, tokenIndex
+			position1702, tokenIndex1702 := position, tokenIndex
 			{
-				position1642 := position
+				position1703 := position
 				{
-					position1643 := position
+					position1704 := position
 					{
-						position1644, tokenIndex1644 := position, tokenIndex
+						position1705, tokenIndex1705 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1645
+							goto l1706
 						}
 						position++
-						goto l1644
-					l1645:
-						position, tokenIndex = position1644, tokenIndex1644
+						goto l1705
+					l1706:
+						position, tokenIndex = position1705, tokenIndex1705
 						if buffer[position] != rune('A') {
-							goto l1641
+							goto l1702
 						}
 						position++
 					}
-				l1644:
+				l1705:
 					{
-						position1646, tokenIndex1646 := position, tokenIndex
+						position1707, tokenIndex1707 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1647
+							goto l1708
 						}
 						position++
-						goto l1646
-					l1647:
-						position, tokenIndex = position1646, tokenIndex1646
+						goto l1707
+					l1708:
+						position, tokenIndex = position1707, tokenIndex1707
 						if buffer[position] != rune('S') {
-							goto l1641
+							goto l1702
 						}
 						position++
 					}
-				l1646:
+				l1707:
 					{
-						position1648, tokenIndex1648 := position, tokenIndex
+						position1709, tokenIndex1709 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1649
+							goto l1710
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex = position1648, tokenIndex1648
+						goto l1709
+					l1710:
+						position, tokenIndex = position1709, tokenIndex1709
 						if buffer[position] != rune('C') {
-							goto l1641
+							goto l1702
 						}
 						position++
 					}
-				l1648:
-					add(rulePegText, position1643)
+				l1709:
+					add(rulePegText, position1704)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1641
+				if !_rules[ruleAction110]() {
+					goto l1702
 				}
-				add(ruleAscending, position1642)
+				add(ruleAscending, position1703)
 			}
 			return true
-		l1641:
-			position, tokenIndex = position1641, tokenIndex1641
+		l1702:
+			position, tokenIndex = position1702, tokenIndex1702
 			return false
 		},
-		/* 137 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action107)> */
+		/* 140 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action111)> */
 		func() bool {
-			position1650, tokenIndex1650 := position, tokenIndex
+			position1711, tokenIndex1711 := position, tokenIndex
 			{
-				position1651 := position
+				position1712 := position
 				{
-					position1652 := position
+					position1713 := position
 					{
-						position1653, tokenIndex1653 := position, tokenIndex
+						position1714, tokenIndex1714 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1654
+							goto l1715
 						}
 						position++
-						goto l1653
-					l1654:
-						position, tokenIndex = position1653, tokenIndex1653
+						goto l1714
+					l1715:
+						position, tokenIndex = position1714, tokenIndex1714
 						if buffer[position] != rune('D') {
-							goto l1650
+							goto l1711
 						}
 						position++
 					}
-				l1653:
+				l1714:
 					{
-						position1655, tokenIndex1655 := position, tokenIndex
+						position1716, tokenIndex1716 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1656
+							goto l1717
 						}
 						position++
-						goto l1655
-					l1656:
-						position, tokenIndex = position1655, tokenIndex1655
+						goto l1716
+					l1717:
+						position, tokenIndex = position1716, tokenIndex1716
 						if buffer[position] != rune('E') {
-							goto l1650
+							goto l1711
 						}
 						position++
 					}
-				l1655:
+				l1716:
 					{
-						position1657, tokenIndex1657 := position, tokenIndex
+						position1718, tokenIndex1718 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1658
+							goto l1719
 						}
 						position++
-						goto l1657
-					l1658:
-						position, tokenIndex = position1657, tokenIndex1657
+						goto l1718
+					l1719:
+						position, tokenIndex = position1718, tokenIndex1718
 						if buffer[position] != rune('S') {
-							goto l1650
+							goto l1711
 						}
 						position++
 					}
-				l1657:
+				l1718:
 					{
-						position1659, tokenIndex1659 := position, tokenIndex
+						position1720, tokenIndex1720 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l1660
+							goto l1721
 						}
 						position++
-						goto l1659
-					l1660:
-						position, tokenIndex = position1659, tokenIndex1659
+						goto l1720
+					l1721:
+						position, tokenIndex = position1720, tokenIndex1720
 						if buffer[position] != rune('C') {
-							goto l1650
+							goto l1711
 						}
 						position++
 					}
-				l1659:
-					add(rulePegText, position1652)
+				l1720:
+					add(rulePegText, position1713)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1650
+				if !_rules[ruleAction111]() {
+					goto l1711
 				}
-				add(ruleDescending, position1651)
+				add(ruleDescending, position1712)
 			}
 			return true
-		l1650:
-			position, tokenIndex = position1650, tokenIndex1650
+		l1711:
+			position, tokenIndex = position1711, tokenIndex1711
 			return false
 		},
-		/* 138 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 141 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
 		func() bool {
-			position1661, tokenIndex1661 := position, tokenIndex
+			position1722, tokenIndex1722 := position, tokenIndex
 			{
-				position1662 := position
+				position1723 := position
 				{
-					position1663, tokenIndex1663 := position, tokenIndex
+					position1724, tokenIndex1724 := position, tokenIndex
 					if !_rules[ruleBool]() {
-						goto l1664
+						goto l1725
 					}
-					goto l1663
-				l1664:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1725:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleInt]() {
-						goto l1665
+						goto l1726
 					}
-					goto l1663
-				l1665:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1726:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleFloat]() {
-						goto l1666
+						goto l1727
 					}
-					goto l1663
-				l1666:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1727:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleString]() {
-						goto l1667
+						goto l1728
 					}
-					goto l1663
-				l1667:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1728:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleBlob]() {
-						goto l1668
+						goto l1729
 					}
-					goto l1663
-				l1668:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1729:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleTimestamp]() {
-						goto l1669
+						goto l1730
 					}
-					goto l1663
-				l1669:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1730:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleArray]() {
-						goto l1670
+						goto l1731
 					}
-					goto l1663
-				l1670:
-					position, tokenIndex = position1663, tokenIndex1663
+					goto l1724
+				l1731:
+					position, tokenIndex = position1724, tokenIndex1724
 					if !_rules[ruleMap]() {
-						goto l1661
+						goto l1722
 					}
 				}
-			l1663:
-				add(ruleType, position1662)
+			l1724:
+				add(ruleType, position1723)
 			}
 			return true
-		l1661:
-			position, tokenIndex = position1661, tokenIndex1661
+		l1722:
+			position, tokenIndex = position1722, tokenIndex1722
 			return false
 		},
-		/* 139 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action108)> */
+		/* 142 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action112)> */
 		func() bool {
-			position1671, tokenIndex1671 := position, tokenIndex
+			position1732, tokenIndex1732 := position, tokenIndex
 			{
-				position1672 := position
+				position1733 := position
 				{
-					position1673 := position
+					position1734 := position
 					{
-						position1674, tokenIndex1674 := position, tokenIndex
+						position1735, tokenIndex1735 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1675
+							goto l1736
 						}
 						position++
-						goto l1674
-					l1675:
-						position, tokenIndex = position1674, tokenIndex1674
+						goto l1735
+					l1736:
+						position, tokenIndex = position1735, tokenIndex1735
 						if buffer[position] != rune('B') {
-							goto l1671
+							goto l1732
 						}
 						position++
 					}
-				l1674:
+				l1735:
 					{
-						position1676, tokenIndex1676 := position, tokenIndex
+						position1737, tokenIndex1737 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1677
+							goto l1738
 						}
 						position++
-						goto l1676
-					l1677:
-						position, tokenIndex = position1676, tokenIndex1676
+						goto l1737
+					l1738:
+						position, tokenIndex = position1737, tokenIndex1737
 						if buffer[position] != rune('O') {
-							goto l1671
+							goto l1732
 						}
 						position++
 					}
-				l1676:
+				l1737:
 					{
-						position1678, tokenIndex1678 := position, tokenIndex
+						position1739, tokenIndex1739 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1679
+							goto l1740
 						}
 						position++
-						goto l1678
-					l1679:
-						position, tokenIndex = position1678, tokenIndex1678
+						goto l1739
+					l1740:
+						position, tokenIndex = position1739, tokenIndex1739
 						if buffer[position] != rune('O') {
-							goto l1671
+							goto l1732
 						}
 						position++
 					}
-				l1678:
+				l1739:
 					{
-						position1680, tokenIndex1680 := position, tokenIndex
+						position1741, tokenIndex1741 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1681
+							goto l1742
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex = position1680, tokenIndex1680
+						goto l1741
+					l1742:
+						position, tokenIndex = position1741, tokenIndex1741
 						if buffer[position] != rune('L') {
-							goto l1671
+							goto l1732
 						}
 						position++
 					}
-				l1680:
-					add(rulePegText, position1673)
+				l1741:
+					add(rulePegText, position1734)
 				}
-				if !_rules[ruleAction108]() {
-					goto l1671
+				if !_rules[ruleAction112]() {
+					goto l1732
 				}
-				add(ruleBool, position1672)
+				add(ruleBool, position1733)
 			}
 			return true
-		l1671:
-			position, tokenIndex = position1671, tokenIndex1671
+		l1732:
+			position, tokenIndex = position1732, tokenIndex1732
 			return false
 		},
-		/* 140 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action109)> */
+		/* 143 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action113)> */
 		func() bool {
-			position1682, tokenIndex1682 := position, tokenIndex
+			position1743, tokenIndex1743 := position, tokenIndex
 			{
-				position1683 := position
+				position1744 := position
 				{
-					position1684 := position
+					position1745 := position
 					{
-						position1685, tokenIndex1685 := position, tokenIndex
+						position1746, tokenIndex1746 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1686
+							goto l1747
 						}
 						position++
-						goto l1685
-					l1686:
-						position, tokenIndex = position1685, tokenIndex1685
+						goto l1746
+					l1747:
+						position, tokenIndex = position1746, tokenIndex1746
 						if buffer[position] != rune('I') {
-							goto l1682
+							goto l1743
 						}
 						position++
 					}
-				l1685:
+				l1746:
 					{
-						position1687, tokenIndex1687 := position, tokenIndex
+						position1748, tokenIndex1748 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1688
+							goto l1749
 						}
 						position++
-						goto l1687
-					l1688:
-						position, tokenIndex = position1687, tokenIndex1687
+						goto l1748
+					l1749:
+						position, tokenIndex = position1748, tokenIndex1748
 						if buffer[position] != rune('N') {
-							goto l1682
+							goto l1743
 						}
 						position++
 					}
-				l1687:
+				l1748:
 					{
-						position1689, tokenIndex1689 := position, tokenIndex
+						position1750, tokenIndex1750 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1690
+							goto l1751
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex = position1689, tokenIndex1689
+						goto l1750
+					l1751:
+						position, tokenIndex = position1750, tokenIndex1750
 						if buffer[position] != rune('T') {
-							goto l1682
+							goto l1743
 						}
 						position++
 					}
-				l1689:
-					add(rulePegText, position1684)
+				l1750:
+					add(rulePegText, position1745)
 				}
-				if !_rules[ruleAction109]() {
-					goto l1682
+				if !_rules[ruleAction113]() {
+					goto l1743
 				}
-				add(ruleInt, position1683)
+				add(ruleInt, position1744)
 			}
 			return true
-		l1682:
-			position, tokenIndex = position1682, tokenIndex1682
+		l1743:
+			position, tokenIndex = position1743, tokenIndex1743
 			return false
 		},
-		/* 141 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action110)> */
+		/* 144 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action114)> */
 		func() bool {
-			position1691, tokenIndex1691 := position, tokenIndex
+			position1752, tokenIndex1752 := position, tokenIndex
 			{
-				position1692 := position
+				position1753 := position
 				{
-					position1693 := position
+					position1754 := position
 					{
-						position1694, tokenIndex1694 := position, tokenIndex
+						position1755, tokenIndex1755 := position, tokenIndex
 						if buffer[position] != rune('f') {
-							goto l1695
+							goto l1756
 						}
 						position++
-						goto l1694
-					l1695:
-						position, tokenIndex = position1694, tokenIndex1694
+						goto l1755
+					l1756:
+						position, tokenIndex = position1755, tokenIndex1755
 						if buffer[position] != rune('F') {
-							goto l1691
+							goto l1752
 						}
 						position++
 					}
-				l1694:
+				l1755:
 					{
-						position1696, tokenIndex1696 := position, tokenIndex
+						position1757, tokenIndex1757 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1697
+							goto l1758
 						}
 						position++
-						goto l1696
-					l1697:
-						position, tokenIndex = position1696, tokenIndex1696
+						goto l1757
+					l1758:
+						position, tokenIndex = position1757, tokenIndex1757
 						if buffer[position] != rune('L') {
-							goto l1691
+							goto l1752
 						}
 						position++
 					}
-				l1696:
+				l1757:
 					{
-						position1698, tokenIndex1698 := position, tokenIndex
+						position1759, tokenIndex1759 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1699
+							goto l1760
 						}
 						position++
-						goto l1698
-					l1699:
-						position, tokenIndex = position1698, tokenIndex1698
+						goto l1759
+					l1760:
+						position, tokenIndex = position1759, tokenIndex1759
 						if buffer[position] != rune('O') {
-							goto l1691
+							goto l1752
 						}
 						position++
 					}
-				l1698:
+				l1759:
 					{
-						position1700, tokenIndex1700 := position, tokenIndex
+						position1761, tokenIndex1761 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1701
+							goto l1762
 						}
 						position++
-						goto l1700
-					l1701:
-						position, tokenIndex = position1700, tokenIndex1700
+						goto l1761
+					l1762:
+						position, tokenIndex = position1761, tokenIndex1761
 						if buffer[position] != rune('A') {
-							goto l1691
+							goto l1752
 						}
 						position++
 					}
-				l1700:
+				l1761:
 					{
-						position1702, tokenIndex1702 := position, tokenIndex
+						position1763, tokenIndex1763 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1703
+							goto l1764
 						}
 						position++
-						goto l1702
-					l1703:
-						position, tokenIndex = position1702, tokenIndex1702
+						goto l1763
+					l1764:
+						position, tokenIndex = position1763, tokenIndex1763
 						if buffer[position] != rune('T') {
-							goto l1691
+							goto l1752
 						}
 						position++
 					}
-				l1702:
-					add(rulePegText, position1693)
+				l1763:
+					add(rulePegText, position1754)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1691
+				if !_rules[ruleAction114]() {
+					goto l1752
 				}
-				add(ruleFloat, position1692)
+				add(ruleFloat, position1753)
 			}
 			return true
-		l1691:
-			position, tokenIndex = position1691, tokenIndex1691
+		l1752:
+			position, tokenIndex = position1752, tokenIndex1752
 			return false
 		},
-		/* 142 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action111)> */
+		/* 145 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action115)> */
 		func() bool {
-			position1704, tokenIndex1704 := position, tokenIndex
+			position1765, tokenIndex1765 := position, tokenIndex
 			{
-				position1705 := position
+				position1766 := position
 				{
-					position1706 := position
+					position1767 := position
 					{
-						position1707, tokenIndex1707 := position, tokenIndex
+						position1768, tokenIndex1768 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1708
+							goto l1769
 						}
 						position++
-						goto l1707
-					l1708:
-						position, tokenIndex = position1707, tokenIndex1707
+						goto l1768
+					l1769:
+						position, tokenIndex = position1768, tokenIndex1768
 						if buffer[position] != rune('S') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1707:
+				l1768:
 					{
-						position1709, tokenIndex1709 := position, tokenIndex
+						position1770, tokenIndex1770 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1710
+							goto l1771
 						}
 						position++
-						goto l1709
-					l1710:
-						position, tokenIndex = position1709, tokenIndex1709
+						goto l1770
+					l1771:
+						position, tokenIndex = position1770, tokenIndex1770
 						if buffer[position] != rune('T') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1709:
+				l1770:
 					{
-						position1711, tokenIndex1711 := position, tokenIndex
+						position1772, tokenIndex1772 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1712
+							goto l1773
 						}
 						position++
-						goto l1711
-					l1712:
-						position, tokenIndex = position1711, tokenIndex1711
+						goto l1772
+					l1773:
+						position, tokenIndex = position1772, tokenIndex1772
 						if buffer[position] != rune('R') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1711:
+				l1772:
 					{
-						position1713, tokenIndex1713 := position, tokenIndex
+						position1774, tokenIndex1774 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1714
+							goto l1775
 						}
 						position++
-						goto l1713
-					l1714:
-						position, tokenIndex = position1713, tokenIndex1713
+						goto l1774
+					l1775:
+						position, tokenIndex = position1774, tokenIndex1774
 						if buffer[position] != rune('I') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1713:
+				l1774:
 					{
-						position1715, tokenIndex1715 := position, tokenIndex
+						position1776, tokenIndex1776 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1716
+							goto l1777
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex = position1715, tokenIndex1715
+						goto l1776
+					l1777:
+						position, tokenIndex = position1776, tokenIndex1776
 						if buffer[position] != rune('N') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1715:
+				l1776:
 					{
-						position1717, tokenIndex1717 := position, tokenIndex
+						position1778, tokenIndex1778 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l1718
+							goto l1779
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex = position1717, tokenIndex1717
+						goto l1778
+					l1779:
+						position, tokenIndex = position1778, tokenIndex1778
 						if buffer[position] != rune('G') {
-							goto l1704
+							goto l1765
 						}
 						position++
 					}
-				l1717:
-					add(rulePegText, position1706)
+				l1778:
+					add(rulePegText, position1767)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1704
+				if !_rules[ruleAction115]() {
+					goto l1765
 				}
-				add(ruleString, position1705)
+				add(ruleString, position1766)
 			}
 			return true
-		l1704:
-			position, tokenIndex = position1704, tokenIndex1704
+		l1765:
+			position, tokenIndex = position1765, tokenIndex1765
 			return false
 		},
-		/* 143 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action112)> */
+		/* 146 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action116)> */
 		func() bool {
-			position1719, tokenIndex1719 := position, tokenIndex
+			position1780, tokenIndex1780 := position, tokenIndex
 			{
-				position1720 := position
+				position1781 := position
 				{
-					position1721 := position
+					position1782 := position
 					{
-						position1722, tokenIndex1722 := position, tokenIndex
+						position1783, tokenIndex1783 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1723
+							goto l1784
 						}
 						position++
-						goto l1722
-					l1723:
-						position, tokenIndex = position1722, tokenIndex1722
+						goto l1783
+					l1784:
+						position, tokenIndex = position1783, tokenIndex1783
 						if buffer[position] != rune('B') {
-							goto l1719
+							goto l1780
 						}
 						position++
 					}
-				l1722:
+				l1783:
 					{
-						position1724, tokenIndex1724 := position, tokenIndex
+						position1785, tokenIndex1785 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1725
+							goto l1786
 						}
 						position++
-						goto l1724
-					l1725:
-						position, tokenIndex = position1724, tokenIndex1724
+						goto l1785
+					l1786:
+						position, tokenIndex = position1785, tokenIndex1785
 						if buffer[position] != rune('L') {
-							goto l1719
+							goto l1780
 						}
 						position++
 					}
-				l1724:
+				l1785:
 					{
-						position1726, tokenIndex1726 := position, tokenIndex
+						position1787, tokenIndex1787 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1727
+							goto l1788
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex = position1726, tokenIndex1726
+						goto l1787
+					l1788:
+						position, tokenIndex = position1787, tokenIndex1787
 						if buffer[position] != rune('O') {
-							goto l1719
+							goto l1780
 						}
 						position++
 					}
-				l1726:
+				l1787:
 					{
-						position1728, tokenIndex1728 := position, tokenIndex
+						position1789, tokenIndex1789 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l1729
+							goto l1790
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex = position1728, tokenIndex1728
+						goto l1789
+					l1790:
+						position, tokenIndex = position1789, tokenIndex1789
 						if buffer[position] != rune('B') {
-							goto l1719
+							goto l1780
 						}
 						position++
 					}
-				l1728:
-					add(rulePegText, position1721)
+				l1789:
+					add(rulePegText, position1782)
 				}
-				if !_rules[ruleAction112]() {
-					goto l1719
+				if !_rules[ruleAction116]() {
+					goto l1780
 				}
-				add(ruleBlob, position1720)
+				add(ruleBlob, position1781)
 			}
 			return true
-		l1719:
-			position, tokenIndex = position1719, tokenIndex1719
+		l1780:
+			position, tokenIndex = position1780, tokenIndex1780
 			return false
 		},
-		/* 144 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action113)> */
+		/* 147 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action117)> */
 		func() bool {
-			position1730, tokenIndex1730 := position, tokenIndex
+			position1791, tokenIndex1791 := position, tokenIndex
 			{
-				position1731 := position
+				position1792 := position
 				{
-					position1732 := position
+					position1793 := position
 					{
-						position1733, tokenIndex1733 := position, tokenIndex
+						position1794, tokenIndex1794 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1734
+							goto l1795
 						}
 						position++
-						goto l1733
-					l1734:
-						position, tokenIndex = position1733, tokenIndex1733
+						goto l1794
+					l1795:
+						position, tokenIndex = position1794, tokenIndex1794
 						if buffer[position] != rune('T') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1733:
+				l1794:
 					{
-						position1735, tokenIndex1735 := position, tokenIndex
+						position1796, tokenIndex1796 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1736
+							goto l1797
 						}
 						position++
-						goto l1735
-					l1736:
-						position, tokenIndex = position1735, tokenIndex1735
+						goto l1796
+					l1797:
+						position, tokenIndex = position1796, tokenIndex1796
 						if buffer[position] != rune('I') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1735:
+				l1796:
 					{
-						position1737, tokenIndex1737 := position, tokenIndex
+						position1798, tokenIndex1798 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1738
+							goto l1799
 						}
 						position++
-						goto l1737
-					l1738:
-						position, tokenIndex = position1737, tokenIndex1737
+						goto l1798
+					l1799:
+						position, tokenIndex = position1798, tokenIndex1798
 						if buffer[position] != rune('M') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1737:
+				l1798:
 					{
-						position1739, tokenIndex1739 := position, tokenIndex
+						position1800, tokenIndex1800 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1740
+							goto l1801
 						}
 						position++
-						goto l1739
-					l1740:
-						position, tokenIndex = position1739, tokenIndex1739
+						goto l1800
+					l1801:
+						position, tokenIndex = position1800, tokenIndex1800
 						if buffer[position] != rune('E') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1739:
+				l1800:
 					{
-						position1741, tokenIndex1741 := position, tokenIndex
+						position1802, tokenIndex1802 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1742
+							goto l1803
 						}
 						position++
-						goto l1741
-					l1742:
-						position, tokenIndex = position1741, tokenIndex1741
+						goto l1802
+					l1803:
+						position, tokenIndex = position1802, tokenIndex1802
 						if buffer[position] != rune('S') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1741:
+				l1802:
 					{
-						position1743, tokenIndex1743 := position, tokenIndex
+						position1804, tokenIndex1804 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1744
+							goto l1805
 						}
 						position++
-						goto l1743
-					l1744:
-						position, tokenIndex = position1743, tokenIndex1743
+						goto l1804
+					l1805:
+						position, tokenIndex = position1804, tokenIndex1804
 						if buffer[position] != rune('T') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1743:
+				l1804:
 					{
-						position1745, tokenIndex1745 := position, tokenIndex
+						position1806, tokenIndex1806 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1746
+							goto l1807
 						}
 						position++
-						goto l1745
-					l1746:
-						position, tokenIndex = position1745, tokenIndex1745
+						goto l1806
+					l1807:
+						position, tokenIndex = position1806, tokenIndex1806
 						if buffer[position] != rune('A') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1745:
+				l1806:
 					{
-						position1747, tokenIndex1747 := position, tokenIndex
+						position1808, tokenIndex1808 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1748
+							goto l1809
 						}
 						position++
-						goto l1747
-					l1748:
-						position, tokenIndex = position1747, tokenIndex1747
+						goto l1808
+					l1809:
+						position, tokenIndex = position1808, tokenIndex1808
 						if buffer[position] != rune('M') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1747:
+				l1808:
 					{
-						position1749, tokenIndex1749 := position, tokenIndex
+						position1810, tokenIndex1810 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1750
+							goto l1811
 						}
 						position++
-						goto l1749
-					l1750:
-						position, tokenIndex = position1749, tokenIndex1749
+						goto l1810
+					l1811:
+						position, tokenIndex = position1810, tokenIndex1810
 						if buffer[position] != rune('P') {
-							goto l1730
+							goto l1791
 						}
 						position++
 					}
-				l1749:
-					add(rulePegText, position1732)
+				l1810:
+					add(rulePegText, position1793)
 				}
-				if !_rules[ruleAction113]() {
-					goto l1730
+				if !_rules[ruleAction117]() {
+					goto l1791
 				}
-				add(ruleTimestamp, position1731)
+				add(ruleTimestamp, position1792)
 			}
 			return true
-		l1730:
-			position, tokenIndex = position1730, tokenIndex1730
+		l1791:
+			position, tokenIndex = position1791, tokenIndex1791
 			return false
 		},
-		/* 145 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action114)> */
+		/* 148 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action118)> */
 		func() bool {
-			position1751, tokenIndex1751 := position, tokenIndex
+			position1812, tokenIndex1812 := position, tokenIndex
 			{
-				position1752 := position
+				position1813 := position
 				{
-					position1753 := position
+					position1814 := position
 					{
-						position1754, tokenIndex1754 := position, tokenIndex
+						position1815, tokenIndex1815 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1755
+							goto l1816
 						}
 						position++
-						goto l1754
-					l1755:
-						position, tokenIndex = position1754, tokenIndex1754
+						goto l1815
+					l1816:
+						position, tokenIndex = position1815, tokenIndex1815
 						if buffer[position] != rune('A') {
-							goto l1751
+							goto l1812
 						}
 						position++
 					}
-				l1754:
+				l1815:
 					{
-						position1756, tokenIndex1756 := position, tokenIndex
+						position1817, tokenIndex1817 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1757
+							goto l1818
 						}
 						position++
-						goto l1756
-					l1757:
-						position, tokenIndex = position1756, tokenIndex1756
+						goto l1817
+					l1818:
+						position, tokenIndex = position1817, tokenIndex1817
 						if buffer[position] != rune('R') {
-							goto l1751
+							goto l1812
 						}
 						position++
 					}
-				l1756:
+				l1817:
 					{
-						position1758, tokenIndex1758 := position, tokenIndex
+						position1819, tokenIndex1819 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1759
+							goto l1820
 						}
 						position++
-						goto l1758
-					l1759:
-						position, tokenIndex = position1758, tokenIndex1758
+						goto l1819
+					l1820:
+						position, tokenIndex = position1819, tokenIndex1819
 						if buffer[position] != rune('R') {
-							goto l1751
+							goto l1812
 						}
 						position++
 					}
-				l1758:
+				l1819:
 					{
-						position1760, tokenIndex1760 := position, tokenIndex
+						position1821, tokenIndex1821 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1761
+							goto l1822
 						}
 						position++
-						goto l1760
-					l1761:
-						position, tokenIndex = position1760, tokenIndex1760
+						goto l1821
+					l1822:
+						position, tokenIndex = position1821, tokenIndex1821
 						if buffer[position] != rune('A') {
-							goto l1751
+							goto l1812
 						}
 						position++
 					}
-				l1760:
+				l1821:
 					{
-						position1762, tokenIndex1762 := position, tokenIndex
+						position1823, tokenIndex1823 := position, tokenIndex
 						if buffer[position] != rune('y') {
-							goto l1763
+							goto l1824
 						}
 						position++
-						goto l1762
-					l1763:
-						position, tokenIndex = position1762, tokenIndex1762
+						goto l1823
+					l1824:
+						position, tokenIndex = position1823, tokenIndex1823
 						if buffer[position] != rune('Y') {
-							goto l1751
+							goto l1812
 						}
 						position++
 					}
-				l1762:
-					add(rulePegText, position1753)
+				l1823:
+					add(rulePegText, position1814)
 				}
-				if !_rules[ruleAction114]() {
-					goto l1751
+				if !_rules[ruleAction118]() {
+					goto l1812
 				}
-				add(ruleArray, position1752)
+				add(ruleArray, position1813)
 			}
 			return true
-		l1751:
-			position, tokenIndex = position1751, tokenIndex1751
+		l1812:
+			position, tokenIndex = position1812, tokenIndex1812
 			return false
 		},
-		/* 146 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action115)> */
+		/* 149 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action119)> */
 		func() bool {
-			position1764, tokenIndex1764 := position, tokenIndex
+			position1825, tokenIndex1825 := position, tokenIndex
 			{
-				position1765 := position
+				position1826 := position
 				{
-					position1766 := position
+					position1827 := position
 					{
-						position1767, tokenIndex1767 := position, tokenIndex
+						position1828, tokenIndex1828 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1768
+							goto l1829
 						}
 						position++
-						goto l1767
-					l1768:
-						position, tokenIndex = position1767, tokenIndex1767
+						goto l1828
+					l1829:
+						position, tokenIndex = position1828, tokenIndex1828
 						if buffer[position] != rune('M') {
-							goto l1764
+							goto l1825
 						}
 						position++
 					}
-				l1767:
+				l1828:
 					{
-						position1769, tokenIndex1769 := position, tokenIndex
+						position1830, tokenIndex1830 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1770
+							goto l1831
 						}
 						position++
-						goto l1769
-					l1770:
-						position, tokenIndex = position1769, tokenIndex1769
+						goto l1830
+					l1831:
+						position, tokenIndex = position1830, tokenIndex1830
 						if buffer[position] != rune('A') {
-							goto l1764
+							goto l1825
 						}
 						position++
 					}
-				l1769:
+				l1830:
 					{
-						position1771, tokenIndex1771 := position, tokenIndex
+						position1832, tokenIndex1832 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1772
+							goto l1833
 						}
 						position++
-						goto l1771
-					l1772:
-						position, tokenIndex = position1771, tokenIndex1771
+						goto l1832
+					l1833:
+						position, tokenIndex = position1832, tokenIndex1832
 						if buffer[position] != rune('P') {
-							goto l1764
+							goto l1825
 						}
 						position++
 					}
-				l1771:
-					add(rulePegText, position1766)
+				l1832:
+					add(rulePegText, position1827)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1764
+				if !_rules[ruleAction119]() {
+					goto l1825
 				}
-				add(ruleMap, position1765)
+				add(ruleMap, position1826)
 			}
 			return true
-		l1764:
-			position, tokenIndex = position1764, tokenIndex1764
+		l1825:
+			position, tokenIndex = position1825, tokenIndex1825
 			return false
 		},
-		/* 147 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action116)> */
+		/* 150 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action120)> */
 		func() bool {
-			position1773, tokenIndex1773 := position, tokenIndex
+			position1834, tokenIndex1834 := position, tokenIndex
 			{
-				position1774 := position
+				position1835 := position
 				{
-					position1775 := position
+					position1836 := position
 					{
-						position1776, tokenIndex1776 := position, tokenIndex
+						position1837, tokenIndex1837 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1777
+							goto l1838
 						}
 						position++
-						goto l1776
-					l1777:
-						position, tokenIndex = position1776, tokenIndex1776
+						goto l1837
+					l1838:
+						position, tokenIndex = position1837, tokenIndex1837
 						if buffer[position] != rune('O') {
-							goto l1773
+							goto l1834
 						}
 						position++
 					}
-				l1776:
+				l1837:
 					{
-						position1778, tokenIndex1778 := position, tokenIndex
+						position1839, tokenIndex1839 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1779
+							goto l1840
 						}
 						position++
-						goto l1778
-					l1779:
-						position, tokenIndex = position1778, tokenIndex1778
+						goto l1839
+					l1840:
+						position, tokenIndex = position1839, tokenIndex1839
 						if buffer[position] != rune('R') {
-							goto l1773
+							goto l1834
 						}
 						position++
 					}
-				l1778:
-					add(rulePegText, position1775)
+				l1839:
+					add(rulePegText, position1836)
 				}
-				if !_rules[ruleAction116]() {
-					goto l1773
+				if !_rules[ruleAction120]() {
+					goto l1834
 				}
-				add(ruleOr, position1774)
+				add(ruleOr, position1835)
 			}
 			return true
-		l1773:
-			position, tokenIndex = position1773, tokenIndex1773
+		l1834:
+			position, tokenIndex = position1834, tokenIndex1834
 			return false
 		},
-		/* 148 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action117)> */
+		/* 151 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action121)> */
 		func() bool {
-			position1780, tokenIndex1780 := position, tokenIndex
+			position1841, tokenIndex1841 := position, tokenIndex
 			{
-				position1781 := position
+				position1842 := position
 				{
-					position1782 := position
+					position1843 := position
 					{
-						position1783, tokenIndex1783 := position, tokenIndex
+						position1844, tokenIndex1844 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1784
+							goto l1845
 						}
 						position++
-						goto l1783
-					l1784:
-						position, tokenIndex = position1783, tokenIndex1783
+						goto l1844
+					l1845:
+						position, tokenIndex = position1844, tokenIndex1844
 						if buffer[position] != rune('A') {
-							goto l1780
+							goto l1841
 						}
 						position++
 					}
-				l1783:
+				l1844:
 					{
-						position1785, tokenIndex1785 := position, tokenIndex
+						position1846, tokenIndex1846 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1786
+							goto l1847
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex = position1785, tokenIndex1785
+						goto l1846
+					l1847:
+						position, tokenIndex = position1846, tokenIndex1846
 						if buffer[position] != rune('N') {
-							goto l1780
+							goto l1841
 						}
 						position++
 					}
-				l1785:
+				l1846:
 					{
-						position1787, tokenIndex1787 := position, tokenIndex
+						position1848, tokenIndex1848 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1788
+							goto l1849
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex = position1787, tokenIndex1787
+						goto l1848
+					l1849:
+						position, tokenIndex = position1848, tokenIndex1848
 						if buffer[position] != rune('D') {
-							goto l1780
+							goto l1841
 						}
 						position++
 					}
-				l1787:
-					add(rulePegText, position1782)
+				l1848:
+					add(rulePegText, position1843)
 				}
-				if !_rules[ruleAction117]() {
-					goto l1780
+				if !_rules[ruleAction121]() {
+					goto l1841
 				}
-				add(ruleAnd, position1781)
+				add(ruleAnd, position1842)
 			}
 			return true
-		l1780:
-			position, tokenIndex = position1780, tokenIndex1780
+		l1841:
+			position, tokenIndex = position1841, tokenIndex1841
 			return false
 		},
-		/* 149 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action118)> */
+		/* 152 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action122)> */
 		func() bool {
-			position1789, tokenIndex1789 := position, tokenIndex
+			position1850, tokenIndex1850 := position, tokenIndex
 			{
-				position1790 := position
+				position1851 := position
 				{
-					position1791 := position
+					position1852 := position
 					{
-						position1792, tokenIndex1792 := position, tokenIndex
+						position1853, tokenIndex1853 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1793
+							goto l1854
 						}
 						position++
-						goto l1792
-					l1793:
-						position, tokenIndex = position1792, tokenIndex1792
+						goto l1853
+					l1854:
+						position, tokenIndex = position1853, tokenIndex1853
 						if buffer[position] != rune('N') {
-							goto l1789
+							goto l1850
 						}
 						position++
 					}
-				l1792:
+				l1853:
 					{
-						position1794, tokenIndex1794 := position, tokenIndex
+						position1855, tokenIndex1855 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1795
+							goto l1856
 						}
 						position++
-						goto l1794
-					l1795:
-						position, tokenIndex = position1794, tokenIndex1794
+						goto l1855
+					l1856:
+						position, tokenIndex = position1855, tokenIndex1855
 						if buffer[position] != rune('O') {
-							goto l1789
+							goto l1850
 						}
 						position++
 					}
-				l1794:
+				l1855:
 					{
-						position1796, tokenIndex1796 := position, tokenIndex
+						position1857, tokenIndex1857 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1797
+							goto l1858
 						}
 						position++
-						goto l1796
-					l1797:
-						position, tokenIndex = position1796, tokenIndex1796
+						goto l1857
+					l1858:
+						position, tokenIndex = position1857, tokenIndex1857
 						if buffer[position] != rune('T') {
-							goto l1789
+							goto l1850
 						}
 						position++
 					}
-				l1796:
-					add(rulePegText, position1791)
+				l1857:
+					add(rulePegText, position1852)
 				}
-				if !_rules[ruleAction118]() {
-					goto l1789
+				if !_rules[ruleAction122]() {
+					goto l1850
 				}
-				add(ruleNot, position1790)
+				add(ruleNot, position1851)
 			}
 			return true
-		l1789:
-			position, tokenIndex = position1789, tokenIndex1789
+		l1850:
+			position, tokenIndex = position1850, tokenIndex1850
 			return false
 		},
-		/* 150 Equal <- <(<'='> Action119)> */
+		/* 153 Equal <- <(<'='> Action123)> */
 		func() bool {
-			position1798, tokenIndex1798 := position, tokenIndex
+			position1859, tokenIndex1859 := position, tokenIndex
 			{
-				position1799 := position
+				position1860 := position
 				{
-					position1800 := position
+					position1861 := position
 					if buffer[position] != rune('=') {
-						goto l1798
+						goto l1859
 					}
 					position++
-					add(rulePegText, position1800)
+					add(rulePegText, position1861)
 				}
-				if !_rules[ruleAction119]() {
-					goto l1798
+				if !_rules[ruleAction123]() {
+					goto l1859
 				}
-				add(ruleEqual, position1799)
+				add(ruleEqual, position1860)
 			}
 			return true
-		l1798:
-			position, tokenIndex = position1798, tokenIndex1798
+		l1859:
+			position, tokenIndex = position1859, tokenIndex1859
 			return false
 		},
-		/* 151 Less <- <(<'<'> Action120)> */
+		/* 154 Less <- <(<'<'> Action124)> */
 		func() bool {
-			position1801, tokenIndex1801 := position, tokenIndex
+			position1862, tokenIndex1862 := position, tokenIndex
 			{
-				position1802 := position
+				position1863 := position
 				{
-					position1803 := position
+					position1864 := position
 					if buffer[position] != rune('<') {
-						goto l1801
+						goto l1862
 					}
 					position++
-					add(rulePegText, position1803)
+					add(rulePegText, position1864)
 				}
-				if !_rules[ruleAction120]() {
-					goto l1801
+				if !_rules[ruleAction124]() {
+					goto l1862
 				}
-				add(ruleLess, position1802)
+				add(ruleLess, position1863)
 			}
 			return true
-		l1801:
-			position, tokenIndex = position1801, tokenIndex1801
+		l1862:
+			position, tokenIndex = position1862, tokenIndex1862
 			return false
 		},
-		/* 152 LessOrEqual <- <(<('<' '=')> Action121)> */
+		/* 155 LessOrEqual <- <(<('<' '=')> Action125)> */
 		func() bool {
-			position1804, tokenIndex1804 := position, tokenIndex
+			position1865, tokenIndex1865 := position, tokenIndex
 			{
-				position1805 := position
+				position1866 := position
 				{
-					position1806 := position
+					position1867 := position
 					if buffer[position] != rune('<') {
-						goto l1804
+						goto l1865
 					}
 					position++
 					if buffer[position] != rune('=') {
-						goto l1804
+						goto l1865
 					}
 					position++
-					add(rulePegText, position1806)
+					add(rulePegText, position1867)
 				}
-				if !_rules[ruleAction121]() {
-					goto l1804
+				if !_rules[ruleAction125]() {
+					goto l1865
 				}
-				add(ruleLessOrEqual, position1805)
+				add(ruleLessOrEqual, position1866)
 			}
 			return true
-		l1804:
-			position, tokenIndex = position1804, tokenIndex1804
+		l1865:
+			position, tokenIndex = position1865, tokenIndex1865
 			return false
 		},
-		/* 153 Greater <- <(<'>'> Action122)> */
+		/* 156 Greater <- <(<'>'> Action126)> */
 		func() bool {
-			position1807, tokenIndex1807 := position, tokenIndex
+			position1868, tokenIndex1868 := position, tokenIndex
 			{
-				position1808 := position
+				position1869 := position
 				{
-					position1809 := position
+					position1870 := position
 					if buffer[position] != rune('>') {
-						goto l1807
+						goto l1868
 					}
 					position++
-					add(rulePegText, position1809)
+					add(rulePegText, position1870)
 				}
-				if !_rules[ruleAction122]() {
-					goto l1807
+				if !_rules[ruleAction126]() {
+					goto l1868
 				}
-				add(ruleGreater, position1808)
+				add(ruleGreater, position1869)
 			}
 			return true
-		l1807:
-			position, tokenIndex = position1807, tokenIndex1807
+		l1868:
+			position, tokenIndex = position1868, tokenIndex1868
 			return false
 		},
-		/* 154 GreaterOrEqual <- <(<('>' '=')> Action123)> */
+		/* 157 GreaterOrEqual <- <(<('>' '=')> Action127)> */
 		func() bool {
-			position1810, tokenIndex1810 := position, tokenIndex
+			position1871, tokenIndex1871 := position, tokenIndex
 			{
-				position1811 := position
+				position1872 := position
 				{
-					position1812 := position
+					position1873 := position
 					if buffer[position] != rune('>') {
-						goto l1810
+						goto l1871
 					}
 					position++
 					if buffer[position] != rune('=') {
-						goto l1810
+						goto l1871
 					}
 					position++
-					add(rulePegText, position1812)
+					add(rulePegText, position1873)
 				}
-				if !_rules[ruleAction123]() {
-					goto l1810
+				if !_rules[ruleAction127]() {
+					goto l1871
 				}
-				add(ruleGreaterOrEqual, position1811)
+				add(ruleGreaterOrEqual, position1872)
 			}
 			return true
-		l1810:
-			position, tokenIndex = position1810, tokenIndex1810
+		l1871:
+			position, tokenIndex = position1871, tokenIndex1871
 			return false
 		},
-		/* 155 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action124)> */
+		/* 158 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action128)> */
 		func() bool {
-			position1813, tokenIndex1813 := position, tokenIndex
+			position1874, tokenIndex1874 := position, tokenIndex
 			{
-				position1814 := position
+				position1875 := position
 				{
-					position1815 := position
+					position1876 := position
 					{
-						position1816, tokenIndex1816 := position, tokenIndex
+						position1877, tokenIndex1877 := position, tokenIndex
 						if buffer[position] != rune('!') {
-							goto l1817
+							goto l1878
 						}
 						position++
 						if buffer[position] != rune('=') {
-							goto l1817
+							goto l1878
 						}
 						position++
-						goto l1816
-					l1817:
-						position, tokenIndex = position1816, tokenIndex1816
+						goto l1877
+					l1878:
+						position, tokenIndex = position1877, tokenIndex1877
 						if buffer[position] != rune('<') {
-							goto l1813
+							goto l1874
 						}
 						position++
 						if buffer[position] != rune('>') {
-							goto l1813
+							goto l1874
 						}
 						position++
 					}
-				l1816:
-					add(rulePegText, position1815)
+				l1877:
+					add(rulePegText, position1876)
 				}
-				if !_rules[ruleAction124]() {
-					goto l1813
+				if !_rules[ruleAction128]() {
+					goto l1874
 				}
-				add(ruleNotEqual, position1814)
+				add(ruleNotEqual, position1875)
 			}
 			return true
-		l1813:
-			position, tokenIndex = position1813, tokenIndex1813
+		l1874:
+			position, tokenIndex = position1874, tokenIndex1874
 			return false
 		},
-		/* 156 Concat <- <(<('|' '|')> Action125)> */
+		/* 159 Concat <- <(<('|' '|')> Action129)> */
 		func() bool {
-			position1818, tokenIndex1818 := position, tokenIndex
+			position1879, tokenIndex1879 := position, tokenIndex
 			{
-				position1819 := position
+				position1880 := position
 				{
-					position1820 := position
+					position1881 := position
 					if buffer[position] != rune('|') {
-						goto l1818
+						goto l1879
 					}
 					position++
 					if buffer[position] != rune('|') {
-						goto l1818
+						goto l1879
 					}
 					position++
-					add(rulePegText, position1820)
+					add(rulePegText, position1881)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1818
+				if !_rules[ruleAction129]() {
+					goto l1879
 				}
-				add(ruleConcat, position1819)
+				add(ruleConcat, position1880)
 			}
 			return true
-		l1818:
-			position, tokenIndex = position1818, tokenIndex1818
+		l1879:
+			position, tokenIndex = position1879, tokenIndex1879
 			return false
 		},
-		/* 157 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action126)> */
+		/* 160 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action130)> */
 		func() bool {
-			position1821, tokenIndex1821 := position, tokenIndex
+			position1882, tokenIndex1882 := position, tokenIndex
 			{
-				position1822 := position
+				position1883 := position
 				{
-					position1823 := position
+					position1884 := position
 					{
-						position1824, tokenIndex1824 := position, tokenIndex
+						position1885, tokenIndex1885 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1825
+							goto l1886
 						}
 						position++
-						goto l1824
-					l1825:
-						position, tokenIndex = position1824, tokenIndex1824
+						goto l1885
+					l1886:
+						position, tokenIndex = position1885, tokenIndex1885
 						if buffer[position] != rune('I') {
-							goto l1821
+							goto l1882
 						}
 						position++
 					}
-				l1824:
+				l1885:
 					{
-						position1826, tokenIndex1826 := position, tokenIndex
+						position1887, tokenIndex1887 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1827
+							goto l1888
 						}
 						position++
-						goto l1826
-					l1827:
-						position, tokenIndex = position1826, tokenIndex1826
+						goto l1887
+					l1888:
+						position, tokenIndex = position1887, tokenIndex1887
 						if buffer[position] != rune('S') {
-							goto l1821
+							goto l1882
 						}
 						position++
 					}
-				l1826:
-					add(rulePegText, position1823)
+				l1887:
+					add(rulePegText, position1884)
 				}
-				if !_rules[ruleAction126]() {
-					goto l1821
+				if !_rules[ruleAction130]() {
+					goto l1882
 				}
-				add(ruleIs, position1822)
+				add(ruleIs, position1883)
 			}
 			return true
-		l1821:
-			position, tokenIndex = position1821, tokenIndex1821
+		l1882:
+			position, tokenIndex = position1882, tokenIndex1882
 			return false
 		},
-		/* 158 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action127)> */
+		/* 161 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action131)> */
 		func() bool {
-			position1828, tokenIndex1828 := position, tokenIndex
+			position1889, tokenIndex1889 := position, tokenIndex
 			{
-				position1829 := position
+				position1890 := position
 				{
-					position1830 := position
+					position1891 := position
 					{
-						position1831, tokenIndex1831 := position, tokenIndex
+						position1892, tokenIndex1892 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1832
+							goto l1893
 						}
 						position++
-						goto l1831
-					l1832:
-						position, tokenIndex = position1831, tokenIndex1831
+						goto l1892
+					l1893:
+						position, tokenIndex = position1892, tokenIndex1892
 						if buffer[position] != rune('I') {
-							goto l1828
+							goto l1889
 						}
 						position++
 					}
-				l1831:
+				l1892:
 					{
-						position1833, tokenIndex1833 := position, tokenIndex
+						position1894, tokenIndex1894 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1834
+							goto l1895
 						}
 						position++
-						goto l1833
-					l1834:
-						position, tokenIndex = position1833, tokenIndex1833
+						goto l1894
+					l1895:
+						position, tokenIndex = position1894, tokenIndex1894
 						if buffer[position] != rune('S') {
-							goto l1828
+							goto l1889
 						}
 						position++
 					}
-				l1833:
+				l1894:
 					if !_rules[rulesp]() {
-						goto l1828
+						goto l1889
 					}
 					{
-						position1835, tokenIndex1835 := position, tokenIndex
+						position1896, tokenIndex1896 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1836
+							goto l1897
 						}
 						position++
-						goto l1835
-					l1836:
-						position, tokenIndex = position1835, tokenIndex1835
+						goto l1896
+					l1897:
+						position, tokenIndex = position1896, tokenIndex1896
 						if buffer[position] != rune('N') {
-							goto l1828
+							goto l1889
 						}
 						position++
 					}
-				l1835:
+				l1896:
 					{
-						position1837, tokenIndex1837 := position, tokenIndex
+						position1898, tokenIndex1898 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1838
+							goto l1899
 						}
 						position++
-						goto l1837
-					l1838:
-						position, tokenIndex = position1837, tokenIndex1837
+						goto l1898
+					l1899:
+						position, tokenIndex = position1898, tokenIndex1898
 						if buffer[position] != rune('O') {
-							goto l1828
+							goto l1889
 						}
 						position++
 					}
-				l1837:
+				l1898:
 					{
-						position1839, tokenIndex1839 := position, tokenIndex
+						position1900, tokenIndex1900 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1840
+							goto l1901
 						}
 						position++
-						goto l1839
-					l1840:
-						position, tokenIndex = position1839, tokenIndex1839
+						goto l1900
+					l1901:
+						position, tokenIndex = position1900, tokenIndex1900
 						if buffer[position] != rune('T') {
-							goto l1828
+							goto l1889
 						}
 						position++
 					}
-				l1839:
-					add(rulePegText, position1830)
+				l1900:
+					add(rulePegText, position1891)
 				}
-				if !_rules[ruleAction127]() {
-					goto l1828
+				if !_rules[ruleAction131]() {
+					goto l1889
 				}
-				add(ruleIsNot, position1829)
+				add(ruleIsNot, position1890)
 			}
 			return true
-		l1828:
-			position, tokenIndex = position1828, tokenIndex1828
+		l1889:
+			position, tokenIndex = position1889, tokenIndex1889
 			return false
 		},
-		/* 159 Plus <- <(<'+'> Action128)> */
+		/* 162 Plus <- <(<'+'> Action132)> */
 		func() bool {
-			position1841, tokenIndex1841 := position, tokenIndex
+			position1902, tokenIndex1902 := position, tokenIndex
 			{
-				position1842 := position
+				position1903 := position
 				{
-					position1843 := position
+					position1904 := position
 					if buffer[position] != rune('+') {
-						goto l1841
+						goto l1902
 					}
 					position++
-					add(rulePegText, position1843)
+					add(rulePegText, position1904)
 				}
-				if !_rules[ruleAction128]() {
-					goto l1841
+				if !_rules[ruleAction132]() {
+					goto l1902
 				}
-				add(rulePlus, position1842)
+				add(rulePlus, position1903)
 			}
 			return true
-		l1841:
-			position, tokenIndex = position1841, tokenIndex1841
+		l1902:
+			position, tokenIndex = position1902, tokenIndex1902
 			return false
 		},
-		/* 160 Minus <- <(<'-'> Action129)> */
+		/* 163 Minus <- <(<'-'> Action133)> */
 		func() bool {
-			position1844, tokenIndex1844 := position, tokenIndex
+			position1905, tokenIndex1905 := position, tokenIndex
 			{
-				position1845 := position
+				position1906 := position
 				{
-					position1846 := position
+					position1907 := position
 					if buffer[position] != rune('-') {
-						goto l1844
+						goto l1905
 					}
 					position++
-					add(rulePegText, position1846)
+					add(rulePegText, position1907)
 				}
-				if !_rules[ruleAction129]() {
-					goto l1844
+				if !_rules[ruleAction133]() {
+					goto l1905
 				}
-				add(ruleMinus, position1845)
+				add(ruleMinus, position1906)
 			}
 			return true
-		l1844:
-			position, tokenIndex = position1844, tokenIndex1844
+		l1905:
+			position, tokenIndex = position1905, tokenIndex1905
 			return false
 		},
-		/* 161 Multiply <- <(<'*'> Action130)> */
+		/* 164 Multiply <- <(<'*'> Action134)> */
 		func() bool {
-			position1847, tokenIndex1847 := position, tokenIndex
+			position1908, tokenIndex1908 := position, tokenIndex
 			{
-				position1848 := position
+				position1909 := position
 				{
-					position1849 := position
+					position1910 := position
 					if buffer[position] != rune('*') {
-						goto l1847
+						goto l1908
 					}
 					position++
-					add(rulePegText, position1849)
+					add(rulePegText, position1910)
 				}
-				if !_rules[ruleAction130]() {
-					goto l1847
+				if !_rules[ruleAction134]() {
+					goto l1908
 				}
-				add(ruleMultiply, position1848)
+				add(ruleMultiply, position1909)
 			}
 			return true
-		l1847:
-			position, tokenIndex = position1847, tokenIndex1847
+		l1908:
+			position, tokenIndex = position1908, tokenIndex1908
 			return false
 		},
-		/* 162 Divide <- <(<'/'> Action131)> */
+		/* 165 Divide <- <(<'/'> Action135)> */
 		func() bool {
-			position1850, tokenIndex1850 := position, tokenIndex
+			position1911, tokenIndex1911 := position, tokenIndex
 			{
-				position1851 := position
+				position1912 := position
 				{
-					position1852 := position
+					position1913 := position
 					if buffer[position] != rune('/') {
-						goto l1850
+						goto l1911
 					}
 					position++
-					add(rulePegText, position1852)
+					add(rulePegText, position1913)
 				}
-				if !_rules[ruleAction131]() {
-					goto l1850
+				if !_rules[ruleAction135]() {
+					goto l1911
 				}
-				add(ruleDivide, position1851)
+				add(ruleDivide, position1912)
 			}
 			return true
-		l1850:
-			position, tokenIndex = position1850, tokenIndex1850
+		l1911:
+			position, tokenIndex = position1911, tokenIndex1911
 			return false
 		},
-		/* 163 Modulo <- <(<'%'> Action132)> */
+		/* 166 Modulo <- <(<'%'> Action136)> */
 		func() bool {
-			position1853, tokenIndex1853 := position, tokenIndex
+			position1914, tokenIndex1914 := position, tokenIndex
 			{
-				position1854 := position
+				position1915 := position
 				{
-					position1855 := position
+					position1916 := position
 					if buffer[position] != rune('%') {
-						goto l1853
+						goto l1914
 					}
 					position++
-					add(rulePegText, position1855)
+					add(rulePegText, position1916)
 				}
-				if !_rules[ruleAction132]() {
-					goto l1853
+				if !_rules[ruleAction136]() {
+					goto l1914
 				}
-				add(ruleModulo, position1854)
+				add(ruleModulo, position1915)
 			}
 			return true
-		l1853:
-			position, tokenIndex = position1853, tokenIndex1853
+		l1914:
+			position, tokenIndex = position1914, tokenIndex1914
 			return false
 		},
-		/* 164 UnaryMinus <- <(<'-'> Action133)> */
+		/* 167 UnaryMinus <- <(<'-'> Action137)> */
 		func() bool {
-			position1856, tokenIndex1856 := position, tokenIndex
+			position1917, tokenIndex1917 := position, tokenIndex
 			{
-				position1857 := position
+				position1918 := position
 				{
-					position1858 := position
+					position1919 := position
 					if buffer[position] != rune('-') {
-						goto l1856
+						goto l1917
 					}
 					position++
-					add(rulePegText, position1858)
+					add(rulePegText, position1919)
 				}
-				if !_rules[ruleAction133]() {
-					goto l1856
+				if !_rules[ruleAction137]() {
+					goto l1917
 				}
-				add(ruleUnaryMinus, position1857)
+				add(ruleUnaryMinus, position1918)
 			}
 			return true
-		l1856:
-			position, tokenIndex = position1856, tokenIndex1856
+		l1917:
+			position, tokenIndex = position1917, tokenIndex1917
 			return false
 		},
-		/* 165 Identifier <- <(<ident> Action134)> */
+		/* 168 Identifier <- <(<ident> Action138)> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
+			position1920, tokenIndex1920 := position, tokenIndex
 			{
-				position1860 := position
+				position1921 := position
 				{
-					position1861 := position
+					position1922 := position
 					if !_rules[ruleident]() {
-						goto l1859
+						goto l1920
 					}
-					add(rulePegText, position1861)
+					add(rulePegText, position1922)
 				}
-				if !_rules[ruleAction134]() {
-					goto l1859
+				if !_rules[ruleAction138]() {
+					goto l1920
 				}
-				add(ruleIdentifier, position1860)
+				add(ruleIdentifier, position1921)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
+		l1920:
+			position, tokenIndex = position1920, tokenIndex1920
 			return false
 		},
-		/* 166 TargetIdentifier <- <(<('*' / jsonSetPath)> Action135)> */
+		/* 169 TargetIdentifier <- <(<('*' / jsonSetPath)> Action139)> */
 		func() bool {
-			position1862, tokenIndex1862 := position, tokenIndex
+			position1923, tokenIndex1923 := position, tokenIndex
 			{
-				position1863 := position
+				position1924 := position
 				{
-					position1864 := position
+					position1925 := position
 					{
-						position1865, tokenIndex1865 := position, tokenIndex
+						position1926, tokenIndex1926 := position, tokenIndex
 						if buffer[position] != rune('*') {
-							goto l1866
+							goto l1927
 						}
 						position++
-						goto l1865
-					l1866:
-						position, tokenIndex = position1865, tokenIndex1865
+						goto l1926
+					l1927:
+						position, tokenIndex = position1926, tokenIndex1926
 						if !_rules[rulejsonSetPath]() {
-							goto l1862
+							goto l1923
 						}
 					}
-				l1865:
-					add(rulePegText, position1864)
+				l1926:
+					add(rulePegText, position1925)
 				}
-				if !_rules[ruleAction135]() {
-					goto l1862
+				if !_rules[ruleAction139]() {
+					goto l1923
 				}
-				add(ruleTargetIdentifier, position1863)
+				add(ruleTargetIdentifier, position1924)
 			}
 			return true
-		l1862:
-			position, tokenIndex = position1862, tokenIndex1862
+		l1923:
+			position, tokenIndex = position1923, tokenIndex1923
 			return false
 		},
-		/* 167 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 170 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1867, tokenIndex1867 := position, tokenIndex
+			position1928, tokenIndex1928 := position, tokenIndex
 			{
-				position1868 := position
+				position1929 := position
 				{
-					position1869, tokenIndex1869 := position, tokenIndex
+					position1930, tokenIndex1930 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1870
+						goto l1931
 					}
 					position++
-					goto l1869
-				l1870:
-					position, tokenIndex = position1869, tokenIndex1869
+					goto l1930
+				l1931:
+					position, tokenIndex = position1930, tokenIndex1930
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1867
+						goto l1928
 					}
 					position++
 				}
-			l1869:
-			l1871:
+			l1930:
+			l1932:
 				{
-					position1872, tokenIndex1872 := position, tokenIndex
+					position1933, tokenIndex1933 := position, tokenIndex
 					{
-						position1873, tokenIndex1873 := position, tokenIndex
+						position1934, tokenIndex1934 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1874
+							goto l1935
 						}
 						position++
-						goto l1873
-					l1874:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l1934
+					l1935:
+						position, tokenIndex = position1934, tokenIndex1934
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1875
+							goto l1936
 						}
 						position++
-						goto l1873
-					l1875:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l1934
+					l1936:
+						position, tokenIndex = position1934, tokenIndex1934
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1876
+							goto l1937
 						}
 						position++
-						goto l1873
-					l1876:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l1934
+					l1937:
+						position, tokenIndex = position1934, tokenIndex1934
 						if buffer[position] != rune('_') {
-							goto l1872
+							goto l1933
 						}
 						position++
 					}
-				l1873:
-					goto l1871
-				l1872:
-					position, tokenIndex = position1872, tokenIndex1872
+				l1934:
+					goto l1932
+				l1933:
+					position, tokenIndex = position1933, tokenIndex1933
 				}
-				add(ruleident, position1868)
+				add(ruleident, position1929)
 			}
 			return true
-		l1867:
-			position, tokenIndex = position1867, tokenIndex1867
+		l1928:
+			position, tokenIndex = position1928, tokenIndex1928
 			return false
 		},
-		/* 168 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 171 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
+			position1938, tokenIndex1938 := position, tokenIndex
 			{
-				position1878 := position
+				position1939 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1877
+					goto l1938
 				}
-			l1879:
+			l1940:
 				{
-					position1880, tokenIndex1880 := position, tokenIndex
+					position1941, tokenIndex1941 := position, tokenIndex
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1880
+						goto l1941
 					}
-					goto l1879
-				l1880:
-					position, tokenIndex = position1880, tokenIndex1880
+					goto l1940
+				l1941:
+					position, tokenIndex = position1941, tokenIndex1941
 				}
-				add(rulejsonGetPath, position1878)
+				add(rulejsonGetPath, position1939)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
+		l1938:
+			position, tokenIndex = position1938, tokenIndex1938
 			return false
 		},
-		/* 169 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 172 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1881, tokenIndex1881 := position, tokenIndex
+			position1942, tokenIndex1942 := position, tokenIndex
 			{
-				position1882 := position
+				position1943 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1881
+					goto l1942
 				}
-			l1883:
+			l1944:
 				{
-					position1884, tokenIndex1884 := position, tokenIndex
+					position1945, tokenIndex1945 := position, tokenIndex
 					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1884
+						goto l1945
 					}
-					goto l1883
-				l1884:
-					position, tokenIndex = position1884, tokenIndex1884
+					goto l1944
+				l1945:
+					position, tokenIndex = position1945, tokenIndex1945
 				}
-				add(rulejsonSetPath, position1882)
+				add(rulejsonSetPath, position1943)
 			}
 			return true
-		l1881:
-			position, tokenIndex = position1881, tokenIndex1881
+		l1942:
+			position, tokenIndex = position1942, tokenIndex1942
 			return false
 		},
-		/* 170 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 173 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
 		func() bool {
-			position1885, tokenIndex1885 := position, tokenIndex
+			position1946, tokenIndex1946 := position, tokenIndex
 			{
-				position1886 := position
+				position1947 := position
 				{
-					position1887, tokenIndex1887 := position, tokenIndex
+					position1948, tokenIndex1948 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1888
+						goto l1949
 					}
-					goto l1887
-				l1888:
-					position, tokenIndex = position1887, tokenIndex1887
+					goto l1948
+				l1949:
+					position, tokenIndex = position1948, tokenIndex1948
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1885
+						goto l1946
 					}
 				}
-			l1887:
-				add(rulejsonPathHead, position1886)
+			l1948:
+				add(rulejsonPathHead, position1947)
 			}
 			return true
-		l1885:
-			position, tokenIndex = position1885, tokenIndex1885
+		l1946:
+			position, tokenIndex = position1946, tokenIndex1946
 			return false
 		},
-		/* 171 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 174 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position1889, tokenIndex1889 := position, tokenIndex
+			position1950, tokenIndex1950 := position, tokenIndex
 			{
-				position1890 := position
+				position1951 := position
 				{
-					position1891, tokenIndex1891 := position, tokenIndex
+					position1952, tokenIndex1952 := position, tokenIndex
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1892
+						goto l1953
 					}
-					goto l1891
-				l1892:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l1952
+				l1953:
+					position, tokenIndex = position1952, tokenIndex1952
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
+						goto l1954
 					}
-					goto l1891
-				l1893:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l1952
+				l1954:
+					position, tokenIndex = position1952, tokenIndex1952
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1894
+						goto l1955
 					}
-					goto l1891
-				l1894:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l1952
+				l1955:
+					position, tokenIndex = position1952, tokenIndex1952
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1895
+						goto l1956
 					}
-					goto l1891
-				l1895:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l1952
+				l1956:
+					position, tokenIndex = position1952, tokenIndex1952
 					if !_rules[rulejsonArraySlice]() {
-						goto l1896
+						goto l1957
 					}
-					goto l1891
-				l1896:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l1952
+				l1957:
+					position, tokenIndex = position1952, tokenIndex1952
 					if !_rules[rulejsonArrayAccess]() {
-						goto l1889
+						goto l1950
 					}
 				}
-			l1891:
-				add(rulejsonGetPathNonHead, position1890)
+			l1952:
+				add(rulejsonGetPathNonHead, position1951)
 			}
 			return true
-		l1889:
-			position, tokenIndex = position1889, tokenIndex1889
+		l1950:
+			position, tokenIndex = position1950, tokenIndex1950
 			return false
 		},
-		/* 172 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 175 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
 		func() bool {
-			position1897, tokenIndex1897 := position, tokenIndex
+			position1958, tokenIndex1958 := position, tokenIndex
 			{
-				position1898 := position
+				position1959 := position
 				{
-					position1899, tokenIndex1899 := position, tokenIndex
+					position1960, tokenIndex1960 := position, tokenIndex
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1900
+						goto l1961
 					}
-					goto l1899
-				l1900:
-					position, tokenIndex = position1899, tokenIndex1899
+					goto l1960
+				l1961:
+					position, tokenIndex = position1960, tokenIndex1960
 					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1897
+						goto l1958
 					}
 				}
-			l1899:
-				add(rulejsonSetPathNonHead, position1898)
+			l1960:
+				add(rulejsonSetPathNonHead, position1959)
 			}
 			return true
-		l1897:
-			position, tokenIndex = position1897, tokenIndex1897
+		l1958:
+			position, tokenIndex = position1958, tokenIndex1958
 			return false
 		},
-		/* 173 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 176 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
 		func() bool {
-			position1901, tokenIndex1901 := position, tokenIndex
+			position1962, tokenIndex1962 := position, tokenIndex
 			{
-				position1902 := position
+				position1963 := position
 				{
-					position1903, tokenIndex1903 := position, tokenIndex
+					position1964, tokenIndex1964 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l1904
+						goto l1965
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1904
+						goto l1965
 					}
-					goto l1903
-				l1904:
-					position, tokenIndex = position1903, tokenIndex1903
+					goto l1964
+				l1965:
+					position, tokenIndex = position1964, tokenIndex1964
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1901
+						goto l1962
 					}
 				}
-			l1903:
-				add(rulejsonMapSingleLevel, position1902)
+			l1964:
+				add(rulejsonMapSingleLevel, position1963)
 			}
 			return true
-		l1901:
-			position, tokenIndex = position1901, tokenIndex1901
+		l1962:
+			position, tokenIndex = position1962, tokenIndex1962
 			return false
 		},
-		/* 174 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 177 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
 		func() bool {
-			position1905, tokenIndex1905 := position, tokenIndex
+			position1966, tokenIndex1966 := position, tokenIndex
 			{
-				position1906 := position
+				position1967 := position
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l1966
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l1966
 				}
 				position++
 				{
-					position1907, tokenIndex1907 := position, tokenIndex
+					position1968, tokenIndex1968 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1908
+						goto l1969
 					}
-					goto l1907
-				l1908:
-					position, tokenIndex = position1907, tokenIndex1907
+					goto l1968
+				l1969:
+					position, tokenIndex = position1968, tokenIndex1968
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1905
+						goto l1966
 					}
 				}
-			l1907:
-				add(rulejsonMapMultipleLevel, position1906)
+			l1968:
+				add(rulejsonMapMultipleLevel, position1967)
 			}
 			return true
-		l1905:
-			position, tokenIndex = position1905, tokenIndex1905
+		l1966:
+			position, tokenIndex = position1966, tokenIndex1966
 			return false
 		},
-		/* 175 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 178 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
 		func() bool {
-			position1909, tokenIndex1909 := position, tokenIndex
+			position1970, tokenIndex1970 := position, tokenIndex
 			{
-				position1910 := position
+				position1971 := position
 				{
-					position1911 := position
+					position1972 := position
 					{
-						position1912, tokenIndex1912 := position, tokenIndex
+						position1973, tokenIndex1973 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1913
+							goto l1974
 						}
 						position++
-						goto l1912
-					l1913:
-						position, tokenIndex = position1912, tokenIndex1912
+						goto l1973
+					l1974:
+						position, tokenIndex = position1973, tokenIndex1973
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1909
+							goto l1970
 						}
 						position++
 					}
-				l1912:
-				l1914:
+				l1973:
+				l1975:
 					{
-						position1915, tokenIndex1915 := position, tokenIndex
+						position1976, tokenIndex1976 := position, tokenIndex
 						{
-							position1916, tokenIndex1916 := position, tokenIndex
+							position1977, tokenIndex1977 := position, tokenIndex
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1917
+								goto l1978
 							}
 							position++
-							goto l1916
-						l1917:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l1977
+						l1978:
+							position, tokenIndex = position1977, tokenIndex1977
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1918
+								goto l1979
 							}
 							position++
-							goto l1916
-						l1918:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l1977
+						l1979:
+							position, tokenIndex = position1977, tokenIndex1977
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1919
+								goto l1980
 							}
 							position++
-							goto l1916
-						l1919:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l1977
+						l1980:
+							position, tokenIndex = position1977, tokenIndex1977
 							if buffer[position] != rune('_') {
-								goto l1915
+								goto l1976
 							}
 							position++
 						}
-					l1916:
-						goto l1914
-					l1915:
-						position, tokenIndex = position1915, tokenIndex1915
+					l1977:
+						goto l1975
+					l1976:
+						position, tokenIndex = position1976, tokenIndex1976
 					}
-					add(rulePegText, position1911)
+					add(rulePegText, position1972)
 				}
-				add(rulejsonMapAccessString, position1910)
+				add(rulejsonMapAccessString, position1971)
 			}
 			return true
-		l1909:
-			position, tokenIndex = position1909, tokenIndex1909
+		l1970:
+			position, tokenIndex = position1970, tokenIndex1970
 			return false
 		},
-		/* 176 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 179 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
 		func() bool {
-			position1920, tokenIndex1920 := position, tokenIndex
+			position1981, tokenIndex1981 := position, tokenIndex
 			{
-				position1921 := position
+				position1982 := position
 				if buffer[position] != rune('[') {
-					goto l1920
+					goto l1981
 				}
 				position++
 				if !_rules[ruledoubleQuotedString]() {
-					goto l1920
+					goto l1981
 				}
 				if buffer[position] != rune(']') {
-					goto l1920
+					goto l1981
 				}
 				position++
-				add(rulejsonMapAccessBracket, position1921)
+				add(rulejsonMapAccessBracket, position1982)
 			}
 			return true
-		l1920:
-			position, tokenIndex = position1920, tokenIndex1920
+		l1981:
+			position, tokenIndex = position1981, tokenIndex1981
 			return false
 		},
-		/* 177 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 180 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1922, tokenIndex1922 := position, tokenIndex
+			position1983, tokenIndex1983 := position, tokenIndex
 			{
-				position1923 := position
+				position1984 := position
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l1983
 				}
 				position++
 				{
-					position1924 := position
-				l1925:
+					position1985 := position
+				l1986:
 					{
-						position1926, tokenIndex1926 := position, tokenIndex
+						position1987, tokenIndex1987 := position, tokenIndex
 						{
-							position1927, tokenIndex1927 := position, tokenIndex
+							position1988, tokenIndex1988 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l1989
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l1989
 							}
 							position++
-							goto l1927
-						l1928:
-							position, tokenIndex = position1927, tokenIndex1927
+							goto l1988
+						l1989:
+							position, tokenIndex = position1988, tokenIndex1988
 							{
-								position1929, tokenIndex1929 := position, tokenIndex
+								position1990, tokenIndex1990 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l1929
+									goto l1990
 								}
 								position++
-								goto l1926
-							l1929:
-								position, tokenIndex = position1929, tokenIndex1929
+								goto l1987
+							l1990:
+								position, tokenIndex = position1990, tokenIndex1990
 							}
 							if !matchDot() {
-								goto l1926
+								goto l1987
 							}
 						}
-					l1927:
-						goto l1925
-					l1926:
-						position, tokenIndex = position1926, tokenIndex1926
+					l1988:
+						goto l1986
+					l1987:
+						position, tokenIndex = position1987, tokenIndex1987
 					}
-					add(rulePegText, position1924)
+					add(rulePegText, position1985)
 				}
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l1983
 				}
 				position++
-				add(ruledoubleQuotedString, position1923)
+				add(ruledoubleQuotedString, position1984)
 			}
 			return true
-		l1922:
-			position, tokenIndex = position1922, tokenIndex1922
+		l1983:
+			position, tokenIndex = position1983, tokenIndex1983
 			return false
 		},
-		/* 178 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 181 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1930, tokenIndex1930 := position, tokenIndex
+			position1991, tokenIndex1991 := position, tokenIndex
 			{
-				position1931 := position
+				position1992 := position
 				if buffer[position] != rune('[') {
-					goto l1930
+					goto l1991
 				}
 				position++
 				{
-					position1932 := position
+					position1993 := position
 					{
-						position1933, tokenIndex1933 := position, tokenIndex
+						position1994, tokenIndex1994 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1933
+							goto l1994
 						}
 						position++
-						goto l1934
-					l1933:
-						position, tokenIndex = position1933, tokenIndex1933
+						goto l1995
+					l1994:
+						position, tokenIndex = position1994, tokenIndex1994
 					}
-				l1934:
+				l1995:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
+						goto l1991
 					}
 					position++
-				l1935:
+				l1996:
 					{
-						position1936, tokenIndex1936 := position, tokenIndex
+						position1997, tokenIndex1997 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1936
+							goto l1997
 						}
 						position++
-						goto l1935
-					l1936:
-						position, tokenIndex = position1936, tokenIndex1936
+						goto l1996
+					l1997:
+						position, tokenIndex = position1997, tokenIndex1997
 					}
-					add(rulePegText, position1932)
+					add(rulePegText, position1993)
 				}
 				if buffer[position] != rune(']') {
-					goto l1930
+					goto l1991
 				}
 				position++
-				add(rulejsonArrayAccess, position1931)
+				add(rulejsonArrayAccess, position1992)
 			}
 			return true
-		l1930:
-			position, tokenIndex = position1930, tokenIndex1930
+		l1991:
+			position, tokenIndex = position1991, tokenIndex1991
 			return false
 		},
-		/* 179 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 182 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1937, tokenIndex1937 := position, tokenIndex
+			position1998, tokenIndex1998 := position, tokenIndex
 			{
-				position1938 := position
+				position1999 := position
 				if buffer[position] != rune('[') {
-					goto l1937
+					goto l1998
 				}
 				position++
 				{
-					position1939 := position
+					position2000 := position
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1937
+						goto l1998
 					}
 					position++
-				l1940:
+				l2001:
 					{
-						position1941, tokenIndex1941 := position, tokenIndex
+						position2002, tokenIndex2002 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
+							goto l2002
 						}
 						position++
-						goto l1940
-					l1941:
-						position, tokenIndex = position1941, tokenIndex1941
+						goto l2001
+					l2002:
+						position, tokenIndex = position2002, tokenIndex2002
 					}
-					add(rulePegText, position1939)
+					add(rulePegText, position2000)
 				}
 				if buffer[position] != rune(']') {
-					goto l1937
+					goto l1998
 				}
 				position++
-				add(rulejsonNonNegativeArrayAccess, position1938)
+				add(rulejsonNonNegativeArrayAccess, position1999)
 			}
 			return true
-		l1937:
-			position, tokenIndex = position1937, tokenIndex1937
+		l1998:
+			position, tokenIndex = position1998, tokenIndex1998
 			return false
 		},
-		/* 180 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 183 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1942, tokenIndex1942 := position, tokenIndex
+			position2003, tokenIndex2003 := position, tokenIndex
 			{
-				position1943 := position
+				position2004 := position
 				if buffer[position] != rune('[') {
-					goto l1942
+					goto l2003
 				}
 				position++
 				{
-					position1944 := position
+					position2005 := position
 					{
-						position1945, tokenIndex1945 := position, tokenIndex
+						position2006, tokenIndex2006 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1945
+							goto l2006
 						}
 						position++
-						goto l1946
-					l1945:
-						position, tokenIndex = position1945, tokenIndex1945
+						goto l2007
+					l2006:
+						position, tokenIndex = position2006, tokenIndex2006
 					}
-				l1946:
+				l2007:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2003
 					}
 					position++
-				l1947:
+				l2008:
 					{
-						position1948, tokenIndex1948 := position, tokenIndex
+						position2009, tokenIndex2009 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1948
+							goto l2009
 						}
 						position++
-						goto l1947
-					l1948:
-						position, tokenIndex = position1948, tokenIndex1948
+						goto l2008
+					l2009:
+						position, tokenIndex = position2009, tokenIndex2009
 					}
 					if buffer[position] != rune(':') {
-						goto l1942
+						goto l2003
 					}
 					position++
 					{
-						position1949, tokenIndex1949 := position, tokenIndex
+						position2010, tokenIndex2010 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1949
+							goto l2010
 						}
 						position++
-						goto l1950
-					l1949:
-						position, tokenIndex = position1949, tokenIndex1949
+						goto l2011
+					l2010:
+						position, tokenIndex = position2010, tokenIndex2010
 					}
-				l1950:
+				l2011:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2003
 					}
 					position++
-				l1951:
+				l2012:
 					{
-						position1952, tokenIndex1952 := position, tokenIndex
+						position2013, tokenIndex2013 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
+							goto l2013
 						}
 						position++
-						goto l1951
-					l1952:
-						position, tokenIndex = position1952, tokenIndex1952
+						goto l2012
+					l2013:
+						position, tokenIndex = position2013, tokenIndex2013
 					}
 					{
-						position1953, tokenIndex1953 := position, tokenIndex
+						position2014, tokenIndex2014 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1953
+							goto l2014
 						}
 						position++
 						{
-							position1955, tokenIndex1955 := position, tokenIndex
+							position2016, tokenIndex2016 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1955
+								goto l2016
 							}
 							position++
-							goto l1956
-						l1955:
-							position, tokenIndex = position1955, tokenIndex1955
+							goto l2017
+						l2016:
+							position, tokenIndex = position2016, tokenIndex2016
 						}
-					l1956:
+					l2017:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1953
+							goto l2014
 						}
 						position++
-					l1957:
+					l2018:
 						{
-							position1958, tokenIndex1958 := position, tokenIndex
+							position2019, tokenIndex2019 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1958
+								goto l2019
 							}
 							position++
-							goto l1957
-						l1958:
-							position, tokenIndex = position1958, tokenIndex1958
+							goto l2018
+						l2019:
+							position, tokenIndex = position2019, tokenIndex2019
 						}
-						goto l1954
-					l1953:
-						position, tokenIndex = position1953, tokenIndex1953
+						goto l2015
+					l2014:
+						position, tokenIndex = position2014, tokenIndex2014
 					}
-				l1954:
-					add(rulePegText, position1944)
+				l2015:
+					add(rulePegText, position2005)
 				}
 				if buffer[position] != rune(']') {
-					goto l1942
+					goto l2003
 				}
 				position++
-				add(rulejsonArraySlice, position1943)
+				add(rulejsonArraySlice, position2004)
 			}
 			return true
-		l1942:
-			position, tokenIndex = position1942, tokenIndex1942
+		l2003:
+			position, tokenIndex = position2003, tokenIndex2003
 			return false
 		},
-		/* 181 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 184 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1959, tokenIndex1959 := position, tokenIndex
+			position2020, tokenIndex2020 := position, tokenIndex
 			{
-				position1960 := position
+				position2021 := position
 				if buffer[position] != rune('[') {
-					goto l1959
+					goto l2020
 				}
 				position++
 				{
-					position1961 := position
+					position2022 := position
 					{
-						position1962, tokenIndex1962 := position, tokenIndex
+						position2023, tokenIndex2023 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1963
+							goto l2024
 						}
 						position++
 						{
-							position1964, tokenIndex1964 := position, tokenIndex
+							position2025, tokenIndex2025 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1964
+								goto l2025
 							}
 							position++
-							goto l1965
-						l1964:
-							position, tokenIndex = position1964, tokenIndex1964
+							goto l2026
+						l2025:
+							position, tokenIndex = position2025, tokenIndex2025
 						}
-					l1965:
+					l2026:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1963
+							goto l2024
 						}
 						position++
-					l1966:
+					l2027:
 						{
-							position1967, tokenIndex1967 := position, tokenIndex
+							position2028, tokenIndex2028 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1967
+								goto l2028
 							}
 							position++
-							goto l1966
-						l1967:
-							position, tokenIndex = position1967, tokenIndex1967
+							goto l2027
+						l2028:
+							position, tokenIndex = position2028, tokenIndex2028
 						}
-						goto l1962
-					l1963:
-						position, tokenIndex = position1962, tokenIndex1962
+						goto l2023
+					l2024:
+						position, tokenIndex = position2023, tokenIndex2023
 						{
-							position1968, tokenIndex1968 := position, tokenIndex
+							position2029, tokenIndex2029 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1968
+								goto l2029
 							}
 							position++
-							goto l1969
-						l1968:
-							position, tokenIndex = position1968, tokenIndex1968
+							goto l2030
+						l2029:
+							position, tokenIndex = position2029, tokenIndex2029
 						}
-					l1969:
+					l2030:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1959
+							goto l2020
 						}
 						position++
-					l1970:
+					l2031:
 						{
-							position1971, tokenIndex1971 := position, tokenIndex
+							position2032, tokenIndex2032 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1971
+								goto l2032
 							}
 							position++
-							goto l1970
-						l1971:
-							position, tokenIndex = position1971, tokenIndex1971
+							goto l2031
+						l2032:
+							position, tokenIndex = position2032, tokenIndex2032
 						}
 						if buffer[position] != rune(':') {
-							goto l1959
+							goto l2020
 						}
 						position++
 					}
-				l1962:
-					add(rulePegText, position1961)
+				l2023:
+					add(rulePegText, position2022)
 				}
 				if buffer[position] != rune(']') {
-					goto l1959
+					goto l2020
 				}
 				position++
-				add(rulejsonArrayPartialSlice, position1960)
+				add(rulejsonArrayPartialSlice, position2021)
 			}
 			return true
-		l1959:
-			position, tokenIndex = position1959, tokenIndex1959
+		l2020:
+			position, tokenIndex = position2020, tokenIndex2020
 			return false
 		},
-		/* 182 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 185 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1972, tokenIndex1972 := position, tokenIndex
+			position2033, tokenIndex2033 := position, tokenIndex
 			{
-				position1973 := position
+				position2034 := position
 				if buffer[position] != rune('[') {
-					goto l1972
+					goto l2033
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l1972
+					goto l2033
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l1972
+					goto l2033
 				}
 				position++
-				add(rulejsonArrayFullSlice, position1973)
+				add(rulejsonArrayFullSlice, position2034)
 			}
 			return true
-		l1972:
-			position, tokenIndex = position1972, tokenIndex1972
+		l2033:
+			position, tokenIndex = position2033, tokenIndex2033
 			return false
 		},
-		/* 183 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 186 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1974, tokenIndex1974 := position, tokenIndex
+			position2035, tokenIndex2035 := position, tokenIndex
 			{
-				position1975 := position
+				position2036 := position
 				{
-					position1976, tokenIndex1976 := position, tokenIndex
+					position2037, tokenIndex2037 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1977
+						goto l2038
 					}
 					position++
-					goto l1976
-				l1977:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2037
+				l2038:
+					position, tokenIndex = position2037, tokenIndex2037
 					if buffer[position] != rune('\t') {
-						goto l1978
+						goto l2039
 					}
 					position++
-					goto l1976
-				l1978:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2037
+				l2039:
+					position, tokenIndex = position2037, tokenIndex2037
 					if buffer[position] != rune('\n') {
-						goto l1979
+						goto l2040
 					}
 					position++
-					goto l1976
-				l1979:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2037
+				l2040:
+					position, tokenIndex = position2037, tokenIndex2037
 					if buffer[position] != rune('\r') {
-						goto l1980
+						goto l2041
 					}
 					position++
-					goto l1976
-				l1980:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2037
+				l2041:
+					position, tokenIndex = position2037, tokenIndex2037
 					if !_rules[rulecomment]() {
-						goto l1981
+						goto l2042
 					}
-					goto l1976
-				l1981:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2037
+				l2042:
+					position, tokenIndex = position2037, tokenIndex2037
 					if !_rules[rulefinalComment]() {
-						goto l1974
+						goto l2035
 					}
 				}
-			l1976:
-				add(rulespElem, position1975)
+			l2037:
+				add(rulespElem, position2036)
 			}
 			return true
-		l1974:
-			position, tokenIndex = position1974, tokenIndex1974
+		l2035:
+			position, tokenIndex = position2035, tokenIndex2035
 			return false
 		},
-		/* 184 sp <- <spElem+> */
+		/* 187 sp <- <spElem+> */
 		func() bool {
-			position1982, tokenIndex1982 := position, tokenIndex
+			position2043, tokenIndex2043 := position, tokenIndex
 			{
-				position1983 := position
+				position2044 := position
 				if !_rules[rulespElem]() {
-					goto l1982
+					goto l2043
 				}
-			l1984:
+			l2045:
 				{
-					position1985, tokenIndex1985 := position, tokenIndex
+					position2046, tokenIndex2046 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1985
+						goto l2046
 					}
-					goto l1984
-				l1985:
-					position, tokenIndex = position1985, tokenIndex1985
+					goto l2045
+				l2046:
+					position, tokenIndex = position2046, tokenIndex2046
 				}
-				add(rulesp, position1983)
+				add(rulesp, position2044)
 			}
 			return true
-		l1982:
-			position, tokenIndex = position1982, tokenIndex1982
+		l2043:
+			position, tokenIndex = position2043, tokenIndex2043
 			return false
 		},
-		/* 185 spOpt <- <spElem*> */
+		/* 188 spOpt <- <spElem*> */
 		func() bool {
 			{
-				position1987 := position
-			l1988:
+				position2048 := position
+			l2049:
 				{
-					position1989, tokenIndex1989 := position, tokenIndex
+					position2050, tokenIndex2050 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1989
+						goto l2050
 					}
-					goto l1988
-				l1989:
-					position, tokenIndex = position1989, tokenIndex1989
+					goto l2049
+				l2050:
+					position, tokenIndex = position2050, tokenIndex2050
 				}
-				add(rulespOpt, position1987)
+				add(rulespOpt, position2048)
 			}
 			return true
 		},
-		/* 186 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 189 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1990, tokenIndex1990 := position, tokenIndex
+			position2051, tokenIndex2051 := position, tokenIndex
 			{
-				position1991 := position
+				position2052 := position
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2051
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2051
 				}
 				position++
-			l1992:
+			l2053:
 				{
-					position1993, tokenIndex1993 := position, tokenIndex
+					position2054, tokenIndex2054 := position, tokenIndex
 					{
-						position1994, tokenIndex1994 := position, tokenIndex
+						position2055, tokenIndex2055 := position, tokenIndex
 						{
-							position1995, tokenIndex1995 := position, tokenIndex
+							position2056, tokenIndex2056 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l1996
+								goto l2057
 							}
 							position++
-							goto l1995
-						l1996:
-							position, tokenIndex = position1995, tokenIndex1995
+							goto l2056
+						l2057:
+							position, tokenIndex = position2056, tokenIndex2056
 							if buffer[position] != rune('\n') {
-								goto l1994
+								goto l2055
 							}
 							position++
 						}
-					l1995:
-						goto l1993
-					l1994:
-						position, tokenIndex = position1994, tokenIndex1994
+					l2056:
+						goto l2054
+					l2055:
+						position, tokenIndex = position2055, tokenIndex2055
 					}
 					if !matchDot() {
-						goto l1993
+						goto l2054
 					}
-					goto l1992
-				l1993:
-					position, tokenIndex = position1993, tokenIndex1993
+					goto l2053
+				l2054:
+					position, tokenIndex = position2054, tokenIndex2054
 				}
 				{
-					position1997, tokenIndex1997 := position, tokenIndex
+					position2058, tokenIndex2058 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l1998
+						goto l2059
 					}
 					position++
-					goto l1997
-				l1998:
-					position, tokenIndex = position1997, tokenIndex1997
+					goto l2058
+				l2059:
+					position, tokenIndex = position2058, tokenIndex2058
 					if buffer[position] != rune('\n') {
-						goto l1990
+						goto l2051
 					}
 					position++
 				}
-			l1997:
-				add(rulecomment, position1991)
+			l2058:
+				add(rulecomment, position2052)
 			}
 			return true
-		l1990:
-			position, tokenIndex = position1990, tokenIndex1990
+		l2051:
+			position, tokenIndex = position2051, tokenIndex2051
 			return false
 		},
-		/* 187 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 190 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1999, tokenIndex1999 := position, tokenIndex
+			position2060, tokenIndex2060 := position, tokenIndex
 			{
-				position2000 := position
+				position2061 := position
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2060
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2060
 				}
 				position++
-			l2001:
+			l2062:
 				{
-					position2002, tokenIndex2002 := position, tokenIndex
+					position2063, tokenIndex2063 := position, tokenIndex
 					{
-						position2003, tokenIndex2003 := position, tokenIndex
+						position2064, tokenIndex2064 := position, tokenIndex
 						{
-							position2004, tokenIndex2004 := position, tokenIndex
+							position2065, tokenIndex2065 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l2005
+								goto l2066
 							}
 							position++
-							goto l2004
-						l2005:
-							position, tokenIndex = position2004, tokenIndex2004
+							goto l2065
+						l2066:
+							position, tokenIndex = position2065, tokenIndex2065
 							if buffer[position] != rune('\n') {
-								goto l2003
+								goto l2064
 							}
 							position++
 						}
-					l2004:
-						goto l2002
-					l2003:
-						position, tokenIndex = position2003, tokenIndex2003
+					l2065:
+						goto l2063
+					l2064:
+						position, tokenIndex = position2064, tokenIndex2064
 					}
 					if !matchDot() {
-						goto l2002
+						goto l2063
 					}
-					goto l2001
-				l2002:
-					position, tokenIndex = position2002, tokenIndex2002
+					goto l2062
+				l2063:
+					position, tokenIndex = position2063, tokenIndex2063
 				}
 				{
-					position2006, tokenIndex2006 := position, tokenIndex
+					position2067, tokenIndex2067 := position, tokenIndex
 					if !matchDot() {
-						goto l2006
+						goto l2067
 					}
-					goto l1999
-				l2006:
-					position, tokenIndex = position2006, tokenIndex2006
+					goto l2060
+				l2067:
+					position, tokenIndex = position2067, tokenIndex2067
 				}
-				add(rulefinalComment, position2000)
+				add(rulefinalComment, position2061)
 			}
 			return true
-		l1999:
-			position, tokenIndex = position1999, tokenIndex1999
+		l2060:
+			position, tokenIndex = position2060, tokenIndex2060
 			return false
 		},
 		nil,
-		/* 190 Action0 <- <{
+		/* 193 Action0 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -16904,7 +17462,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 191 Action1 <- <{
+		/* 194 Action1 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -16913,7 +17471,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 192 Action2 <- <{
+		/* 195 Action2 <- <{
 		    p.AssembleSelect()
 		}> */
 		func() bool {
@@ -16922,7 +17480,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 193 Action3 <- <{
+		/* 196 Action3 <- <{
 		    p.AssembleSelectUnion(begin, end)
 		}> */
 		func() bool {
@@ -16931,7 +17489,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 194 Action4 <- <{
+		/* 197 Action4 <- <{
 		    p.AssembleCreateStreamAsSelect()
 		}> */
 		func() bool {
@@ -16940,7 +17498,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 195 Action5 <- <{
+		/* 198 Action5 <- <{
 		    p.AssembleCreateStreamAsSelectUnion()
 		}> */
 		func() bool {
@@ -16949,7 +17507,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 196 Action6 <- <{
+		/* 199 Action6 <- <{
 		    p.AssembleCreateSource()
 		}> */
 		func() bool {
@@ -16958,7 +17516,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 197 Action7 <- <{
+		/* 200 Action7 <- <{
 		    p.AssembleCreateSink()
 		}> */
 		func() bool {
@@ -16967,7 +17525,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 198 Action8 <- <{
+		/* 201 Action8 <- <{
 		    p.AssembleCreateState()
 		}> */
 		func() bool {
@@ -16976,7 +17534,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 199 Action9 <- <{
+		/* 202 Action9 <- <{
 		    p.AssembleUpdateState()
 		}> */
 		func() bool {
@@ -16985,7 +17543,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 200 Action10 <- <{
+		/* 203 Action10 <- <{
 		    p.AssembleUpdateSource()
 		}> */
 		func() bool {
@@ -16994,7 +17552,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 201 Action11 <- <{
+		/* 204 Action11 <- <{
 		    p.AssembleUpdateSink()
 		}> */
 		func() bool {
@@ -17003,7 +17561,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 202 Action12 <- <{
+		/* 205 Action12 <- <{
 		    p.AssembleInsertIntoFrom()
 		}> */
 		func() bool {
@@ -17012,7 +17570,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 203 Action13 <- <{
+		/* 206 Action13 <- <{
 		    p.AssemblePauseSource()
 		}> */
 		func() bool {
@@ -17021,7 +17579,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 204 Action14 <- <{
+		/* 207 Action14 <- <{
 		    p.AssembleResumeSource()
 		}> */
 		func() bool {
@@ -17030,7 +17588,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 205 Action15 <- <{
+		/* 208 Action15 <- <{
 		    p.AssembleRewindSource()
 		}> */
 		func() bool {
@@ -17039,7 +17597,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 206 Action16 <- <{
+		/* 209 Action16 <- <{
 		    p.AssembleDropSource()
 		}> */
 		func() bool {
@@ -17048,7 +17606,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 207 Action17 <- <{
+		/* 210 Action17 <- <{
 		    p.AssembleDropStream()
 		}> */
 		func() bool {
@@ -17057,7 +17615,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 208 Action18 <- <{
+		/* 211 Action18 <- <{
 		    p.AssembleDropSink()
 		}> */
 		func() bool {
@@ -17066,7 +17624,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 209 Action19 <- <{
+		/* 212 Action19 <- <{
 		    p.AssembleDropState()
 		}> */
 		func() bool {
@@ -17075,7 +17633,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 210 Action20 <- <{
+		/* 213 Action20 <- <{
 		    p.AssembleLoadState()
 		}> */
 		func() bool {
@@ -17084,7 +17642,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 211 Action21 <- <{
+		/* 214 Action21 <- <{
 		    p.AssembleLoadStateOrCreate()
 		}> */
 		func() bool {
@@ -17093,7 +17651,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 212 Action22 <- <{
+		/* 215 Action22 <- <{
 		    p.AssembleSaveState()
 		}> */
 		func() bool {
@@ -17102,7 +17660,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 213 Action23 <- <{
+		/* 216 Action23 <- <{
 		    p.AssembleEval(begin, end)
 		}> */
 		func() bool {
@@ -17111,7 +17669,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 214 Action24 <- <{
+		/* 217 Action24 <- <{
 		    p.AssembleEmitter()
 		}> */
 		func() bool {
@@ -17120,7 +17678,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 215 Action25 <- <{
+		/* 218 Action25 <- <{
 		    p.AssembleEmitterOptions(begin, end)
 		}> */
 		func() bool {
@@ -17129,7 +17687,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 216 Action26 <- <{
+		/* 219 Action26 <- <{
 		    p.AssembleEmitterLimit()
 		}> */
 		func() bool {
@@ -17138,7 +17696,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 217 Action27 <- <{
+		/* 220 Action27 <- <{
 		    p.AssembleEmitterSampling(CountBasedSampling, 1)
 		}> */
 		func() bool {
@@ -17147,7 +17705,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 218 Action28 <- <{
+		/* 221 Action28 <- <{
 		    p.AssembleEmitterSampling(RandomizedSampling, 1)
 		}> */
 		func() bool {
@@ -17156,7 +17714,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 219 Action29 <- <{
+		/* 222 Action29 <- <{
 		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
 		}> */
 		func() bool {
@@ -17165,7 +17723,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 220 Action30 <- <{
+		/* 223 Action30 <- <{
 		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 		}> */
 		func() bool {
@@ -17174,7 +17732,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 221 Action31 <- <{
+		/* 224 Action31 <- <{
 		    p.AssembleProjections(begin, end)
 		}> */
 		func() bool {
@@ -17183,7 +17741,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 222 Action32 <- <{
+		/* 225 Action32 <- <{
 		    p.AssembleAlias()
 		}> */
 		func() bool {
@@ -17192,7 +17750,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 223 Action33 <- <{
+		/* 226 Action33 <- <{
 		    // This is *always* executed, even if there is no
 		    // FROM clause present in the statement.
 		    p.AssembleWindowedFrom(begin, end)
@@ -17203,7 +17761,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 224 Action34 <- <{
+		/* 227 Action34 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
@@ -17212,7 +17770,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 225 Action35 <- <{
+		/* 228 Action35 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
@@ -17221,7 +17779,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 226 Action36 <- <{
+		/* 229 Action36 <- <{
 		    // This is *always* executed, even if there is no
 		    // WHERE clause present in the statement.
 		    p.AssembleFilter(begin, end)
@@ -17232,7 +17790,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 227 Action37 <- <{
+		/* 230 Action37 <- <{
 		    // This is *always* executed, even if there is no
 		    // GROUP BY clause present in the statement.
 		    p.AssembleGrouping(begin, end)
@@ -17243,7 +17801,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 228 Action38 <- <{
+		/* 231 Action38 <- <{
 		    // This is *always* executed, even if there is no
 		    // HAVING clause present in the statement.
 		    p.AssembleHaving(begin, end)
@@ -17254,7 +17812,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 229 Action39 <- <{
+		/* 232 Action39 <- <{
 		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
@@ -17263,7 +17821,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 230 Action40 <- <{
+		/* 233 Action40 <- <{
 		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
@@ -17272,7 +17830,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 231 Action41 <- <{
+		/* 234 Action41 <- <{
 		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
@@ -17281,7 +17839,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 232 Action42 <- <{
+		/* 235 Action42 <- <{
 		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
@@ -17290,7 +17848,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 233 Action43 <- <{
+		/* 236 Action43 <- <{
 		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
@@ -17299,7 +17857,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 234 Action44 <- <{
+		/* 237 Action44 <- <{
 		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
@@ -17308,7 +17866,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 235 Action45 <- <{
+		/* 238 Action45 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
@@ -17317,7 +17875,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 236 Action46 <- <{
+		/* 239 Action46 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
@@ -17326,7 +17884,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 237 Action47 <- <{
+		/* 240 Action47 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
@@ -17335,7 +17893,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 238 Action48 <- <{
+		/* 241 Action48 <- <{
 		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
@@ -17344,7 +17902,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 239 Action49 <- <{
+		/* 242 Action49 <- <{
 		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
@@ -17353,7 +17911,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 240 Action50 <- <{
+		/* 243 Action50 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
@@ -17363,7 +17921,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 241 Action51 <- <{
+		/* 244 Action51 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
@@ -17372,7 +17930,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 242 Action52 <- <{
+		/* 245 Action52 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
@@ -17381,7 +17939,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 243 Action53 <- <{
+		/* 246 Action53 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
@@ -17390,7 +17948,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 244 Action54 <- <{
+		/* 247 Action54 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17399,7 +17957,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 245 Action55 <- <{
+		/* 248 Action55 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17408,7 +17966,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 246 Action56 <- <{
+		/* 249 Action56 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
@@ -17417,7 +17975,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 247 Action57 <- <{
+		/* 250 Action57 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17426,7 +17984,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 248 Action58 <- <{
+		/* 251 Action58 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17435,7 +17993,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 249 Action59 <- <{
+		/* 252 Action59 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17444,7 +18002,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 250 Action60 <- <{
+		/* 253 Action60 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17453,7 +18011,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 251 Action61 <- <{
+		/* 254 Action61 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
@@ -17462,7 +18020,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 252 Action62 <- <{
+		/* 255 Action62 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
@@ -17471,7 +18029,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 253 Action63 <- <{
+		/* 256 Action63 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
@@ -17480,7 +18038,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 254 Action64 <- <{
+		/* 257 Action64 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
@@ -17489,8 +18047,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 255 Action65 <- <{
-		    p.AssembleFuncAppSelector()
+		/* 258 Action65 <- <{
+		    p.AssembleTryCast(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17498,650 +18056,688 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 256 Action66 <- <{
+		/* 259 Action66 <- <{
+		    p.AssembleTry(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction66, position)
+			}
+			return true
+		},
+		/* 260 Action67 <- <{
+		    p.AssembleFuncAppFilter()
+		}> */
+		func() bool {
+			{
+				add(ruleAction67, position)
+			}
+			return true
+		},
+		/* 261 Action68 <- <{
+		    p.AssembleFuncAppSelector()
+		}> */
+		func() bool {
+			{
+				add(ruleAction68, position)
+			}
+			return true
+		},
+		/* 262 Action69 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRaw(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 257 Action67 <- <{
+		/* 263 Action70 <- <{
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 258 Action68 <- <{
+		/* 264 Action71 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 259 Action69 <- <{
+		/* 265 Action72 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 260 Action70 <- <{
+		/* 266 Action73 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction73, position)
+			}
+			return true
+		},
+		/* 267 Action74 <- <{
+		    // This is *always* executed, even if there is no
+		    // FILTER clause present in the function call.
+		    p.AssembleFilter(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 261 Action71 <- <{
+		/* 268 Action75 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 262 Action72 <- <{
+		/* 269 Action76 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 263 Action73 <- <{
+		/* 270 Action77 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 264 Action74 <- <{
+		/* 271 Action78 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 265 Action75 <- <{
+		/* 272 Action79 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 266 Action76 <- <{
+		/* 273 Action80 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 267 Action77 <- <{
+		/* 274 Action81 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 268 Action78 <- <{
+		/* 275 Action82 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 269 Action79 <- <{
+		/* 276 Action83 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 270 Action80 <- <{
+		/* 277 Action84 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 271 Action81 <- <{
+		/* 278 Action85 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 272 Action82 <- <{
+		/* 279 Action86 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction86, position)
 			}
 			return true
 		},
-		/* 273 Action83 <- <{
+		/* 280 Action87 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 274 Action84 <- <{
+		/* 281 Action88 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 275 Action85 <- <{
+		/* 282 Action89 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 276 Action86 <- <{
+		/* 283 Action90 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 277 Action87 <- <{
+		/* 284 Action91 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 278 Action88 <- <{
+		/* 285 Action92 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 279 Action89 <- <{
+		/* 286 Action93 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 280 Action90 <- <{
+		/* 287 Action94 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 281 Action91 <- <{
+		/* 288 Action95 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 282 Action92 <- <{
+		/* 289 Action96 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 283 Action93 <- <{
+		/* 290 Action97 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 284 Action94 <- <{
+		/* 291 Action98 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 285 Action95 <- <{
+		/* 292 Action99 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 286 Action96 <- <{
+		/* 293 Action100 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 287 Action97 <- <{
+		/* 294 Action101 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 288 Action98 <- <{
+		/* 295 Action102 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 289 Action99 <- <{
+		/* 296 Action103 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 290 Action100 <- <{
+		/* 297 Action104 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 291 Action101 <- <{
+		/* 298 Action105 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 292 Action102 <- <{
+		/* 299 Action106 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 293 Action103 <- <{
+		/* 300 Action107 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 294 Action104 <- <{
+		/* 301 Action108 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction108, position)
 			}
 			return true
 		},
-		/* 295 Action105 <- <{
+		/* 302 Action109 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 296 Action106 <- <{
+		/* 303 Action110 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 297 Action107 <- <{
+		/* 304 Action111 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 298 Action108 <- <{
+		/* 305 Action112 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 299 Action109 <- <{
+		/* 306 Action113 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 300 Action110 <- <{
+		/* 307 Action114 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 301 Action111 <- <{
+		/* 308 Action115 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 302 Action112 <- <{
+		/* 309 Action116 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 303 Action113 <- <{
+		/* 310 Action117 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction117, position)
 			}
 			return true
 		},
-		/* 304 Action114 <- <{
+		/* 311 Action118 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 305 Action115 <- <{
+		/* 312 Action119 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 306 Action116 <- <{
+		/* 313 Action120 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction120, position)
 			}
 			return true
 		},
-		/* 307 Action117 <- <{
+		/* 314 Action121 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 308 Action118 <- <{
+		/* 315 Action122 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 309 Action119 <- <{
+		/* 316 Action123 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 310 Action120 <- <{
+		/* 317 Action124 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 311 Action121 <- <{
+		/* 318 Action125 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 312 Action122 <- <{
+		/* 319 Action126 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 313 Action123 <- <{
+		/* 320 Action127 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 314 Action124 <- <{
+		/* 321 Action128 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 315 Action125 <- <{
+		/* 322 Action129 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 316 Action126 <- <{
+		/* 323 Action130 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 317 Action127 <- <{
+		/* 324 Action131 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 318 Action128 <- <{
+		/* 325 Action132 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 319 Action129 <- <{
+		/* 326 Action133 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction133, position)
 			}
 			return true
 		},
-		/* 320 Action130 <- <{
+		/* 327 Action134 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction134, position)
 			}
 			return true
 		},
-		/* 321 Action131 <- <{
+		/* 328 Action135 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 322 Action132 <- <{
+		/* 329 Action136 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction136, position)
 			}
 			return true
 		},
-		/* 323 Action133 <- <{
+		/* 330 Action137 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction137, position)
 			}
 			return true
 		},
-		/* 324 Action134 <- <{
+		/* 331 Action138 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction134, position)
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 325 Action135 <- <{
+		/* 332 Action139 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction135, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
@@ -20,27 +20,35 @@ func TestExpressionParser(t *testing.T) {
 		"NULL": {[]Expression{NullLiteral{}}, "NULL"},
 		// Function Application
 		"f()": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{}}, nil}}, "f()"},
+			ExpressionsAST{[]Expression{}}, nil, nil}}, "f()"},
 		"now()": {[]Expression{FuncAppAST{FuncName("now"),
-			ExpressionsAST{[]Expression{}}, nil}}, "now()"},
+			ExpressionsAST{[]Expression{}}, nil, nil}}, "now()"},
 		"f(a)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil}}, "f(a)"},
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, nil}}, "f(a)"},
 		"f(*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{}}}, nil}}, "f(*)"},
+			ExpressionsAST{[]Expression{Wildcard{}}}, nil, nil}}, "f(*)"},
 		"f(x:*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil}}, "f(x:*)"},
+			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil, nil}}, "f(x:*)"},
 		"f(x:* ORDER BY a)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{Wildcard{"x"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}}}, "f(x:* ORDER BY a)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, nil}}, "f(x:* ORDER BY a)"},
 		"f(a ORDER BY a DESC, b, c ASC)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}}}, "f(a ORDER BY a DESC, b, c ASC)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}, nil}}, "f(a ORDER BY a DESC, b, c ASC)"},
 		"count(a ORDER BY count(b))": {[]Expression{FuncAppAST{FuncName("count"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
 			[]SortedExpressionAST{{FuncAppAST{FuncName("count"),
-				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
+				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil, nil}, UnspecifiedKeyword}}, nil}}, "count(a ORDER BY count(b))"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil, nil}}, `f(2.1, "a")`},
+		"count(*) FILTER (WHERE a > 0)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{Wildcard{}}}, nil,
+			BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{0}}}}, "count(*) FILTER (WHERE a > 0)"},
+		"f(a ORDER BY b DESC) filter(where c)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
+			[]SortedExpressionAST{{RowValue{"", "b"}, No}},
+			RowValue{"", "c"}}}, "f(a ORDER BY b DESC) FILTER (WHERE c)"},
+		"f(a) FILTER (WHERE)": {nil, ""},
 		// Type Cast
 		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
 		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
@@ -55,6 +63,17 @@ func TestExpressionParser(t *testing.T) {
 		"x:int::STRING":        {[]Expression{TypeCastAST{RowValue{"x", "int"}, String}}, "x:int::STRING"},
 		"ts()::STRING":         {[]Expression{TypeCastAST{RowMeta{"", TimestampMeta}, String}}, "ts()::STRING"},
 		"tab:ts()::STRING":     {[]Expression{TypeCastAST{RowMeta{"tab", TimestampMeta}, String}}, "tab:ts()::STRING"},
+		// Try
+		"TRY_CAST(a AS INT)": {[]Expression{TryAST{TypeCastAST{RowValue{"", "a"}, Int}, nil}}, "TRY_CAST(a AS INT)"},
+		"try_cast( 2.1  AS  FLOAT )": {[]Expression{TryAST{TypeCastAST{FloatLiteral{2.1}, Float}, nil}},
+			"TRY_CAST(2.1 AS FLOAT)"},
+		"TRY(f(a))": {[]Expression{TryAST{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, nil}, nil}}, "TRY(f(a))"},
+		"TRY(a::INT, 0)": {[]Expression{TryAST{TypeCastAST{RowValue{"", "a"}, Int},
+			NumericLiteral{0}}}, "TRY(a::INT, 0)"},
+		"TRY(CAST(a AS INT))": {[]Expression{TryAST{TypeCastAST{RowValue{"", "a"}, Int}, nil}}, "TRY_CAST(a AS INT)"},
+		"try_f(a)": {[]Expression{FuncAppAST{FuncName("try_f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, nil}}, "try_f(a)"},
 		// RowValue
 		"a":         {[]Expression{RowValue{"", "a"}}, "a"},
 		"-a":        {[]Expression{UnaryOpAST{UnaryMinus, RowValue{"", "a"}}}, "-a"},
@@ -277,7 +296,7 @@ func TestExpressionParser(t *testing.T) {
 		"a, 3.1, false,-2": {[]Expression{RowValue{"", "a"}, FloatLiteral{3.1}, BoolLiteral{false}, UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "a, 3.1, FALSE, -2"},
 		`"日本語", 13`:        {[]Expression{StringLiteral{"日本語"}, NumericLiteral{13}}, `"日本語", 13`},
 		`concat(a, "Pi", 3.1), b`: {[]Expression{FuncAppAST{FuncName("concat"), ExpressionsAST{
-			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil},
+			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil, nil},
 			RowValue{"", "b"}}, `concat(a, "Pi", 3.1), b`},
 	}
 
@@ -1103,6 +1103,46 @@ func (ps *parseStack) AssembleTypeCast(begin int, end int) {
 	}
 }
 
+// AssembleTryCast takes the two elements from the stack that
+// correspond to the input[begin:end] string and replaces them by
+// a single TryAST element wrapping a TypeCastAST element.
+//
+//  Any
+//  Type
+//   =>
+//  TryAST{TypeCastAST{Any, Type}, nil}
+func (ps *parseStack) AssembleTryCast(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) != 2 {
+		panic(fmt.Sprintf("cannot turn %+v into a try cast", elems))
+	}
+	target := elems[1].(Type)
+	ps.PushComponent(begin, end, TryAST{TypeCastAST{elems[0].(Expression), target}, nil})
+}
+
+// AssembleTry takes the one or two elements from the stack that
+// correspond to the input[begin:end] string and replaces them by
+// a single TryAST element.
+//
+//  Any
+//   =>
+//  TryAST{Any, nil}
+// or
+//  Any
+//  Any
+//   =>
+//  TryAST{Any, Any}
+func (ps *parseStack) AssembleTry(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 1 {
+		ps.PushComponent(begin, end, TryAST{elems[0].(Expression), nil})
+	} else if len(elems) == 2 {
+		ps.PushComponent(begin, end, TryAST{elems[0].(Expression), elems[1].(Expression)})
+	} else {
+		panic(fmt.Sprintf("cannot turn %+v into a try expression", elems))
+	}
+}
+
 // AssembleFuncApp takes the topmost elements from the stack, assuming
 // they are components of a function application clause, and replaces
 // them by a single FuncAppAST element.
@@ -1130,7 +1170,7 @@ func (ps *parseStack) AssembleFuncApp() {
 	}
 
 	// assemble the FuncAppAST and push it back
-	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs})
+	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs, nil})
 }
 
 func (ps *parseStack) AssembleFuncAppSelector() {
@@ -1145,6 +1185,24 @@ func (ps *parseStack) AssembleFuncAppSelector() {
 	})
 }
 
+// AssembleFuncAppFilter takes the topmost elements from the stack,
+// assuming they are a function application and its (possibly empty)
+// FILTER clause, and replaces them by a single FuncAppAST element.
+//
+//  FilterAST
+//  FuncAppAST
+//   =>
+//  FuncAppAST{FuncName, ExpressionsAST, Ordering, Filter}
+func (ps *parseStack) AssembleFuncAppFilter() {
+	_filter, _func := ps.pop2()
+
+	filter := _filter.comp.(FilterAST)
+	funcApp := _func.comp.(FuncAppAST)
+	funcApp.Filter = filter.Filter
+
+	ps.PushComponent(_func.begin, _filter.end, funcApp)
+}
+
 // AssembleSortedExpression takes the topmost elements from the stack,
 // assuming they are components of an ORDER BY clause, and replaces
 // them by a single SortedExpressionAST element.
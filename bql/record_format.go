@@ -0,0 +1,533 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecordDecoder reads records from an input stream.
+type RecordDecoder interface {
+	// Decode reads the next record. It returns io.EOF when there's no more
+	// record. It returns *RecordError when the record is malformed but
+	// the decoder can continue reading subsequent records. Other errors
+	// are fatal.
+	Decode() (data.Map, error)
+}
+
+// RecordEncoder writes records to an output stream.
+type RecordEncoder interface {
+	// Encode writes a record. The record must be written to the underlying
+	// writer before it returns, that is, the encoder shouldn't buffer it.
+	Encode(m data.Map) error
+}
+
+// RecordError is returned from RecordDecoder.Decode when a record is
+// malformed. The caller can skip the record and continue decoding.
+type RecordError struct {
+	// Err is the cause of the error.
+	Err error
+
+	// Record is the raw representation of the malformed record. It's used
+	// in log messages.
+	Record string
+}
+
+func (e *RecordError) Error() string {
+	return e.Err.Error()
+}
+
+// RecordFormat creates RecordDecoders and RecordEncoders of a format such
+// as JSON Lines or CSV. Sources and sinks reading or writing streams of
+// records, such as the file source and the file sink, use RecordFormats
+// selected by their "format" parameter.
+//
+// params are parameters given to the source or the sink. They also have
+// parameters of the source or the sink itself, so a RecordFormat should
+// ignore parameters it doesn't know. A RecordFormat that only supports
+// one direction should return an error from the other method.
+type RecordFormat interface {
+	// NewDecoder creates a RecordDecoder reading from r. It shouldn't read
+	// anything from r until Decode is called.
+	NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error)
+
+	// NewEncoder creates a RecordEncoder writing to w. It shouldn't write
+	// anything to w until Encode is called.
+	NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error)
+}
+
+var (
+	globalRecordFormatsMutex sync.RWMutex
+	globalRecordFormats      = map[string]RecordFormat{}
+)
+
+// RegisterGlobalRecordFormat adds a RecordFormat which can be used from all
+// topologies. Call it from init functions.
+func RegisterGlobalRecordFormat(name string, f RecordFormat) error {
+	// Because a format is specified by a string parameter, its name doesn't
+	// have to be a valid symbol. For example, "raw" is a reserved word.
+	if name == "" {
+		return fmt.Errorf("the name of a record format must not be empty")
+	}
+
+	globalRecordFormatsMutex.Lock()
+	defer globalRecordFormatsMutex.Unlock()
+	lowerName := strings.ToLower(name)
+	if _, ok := globalRecordFormats[lowerName]; ok {
+		return fmt.Errorf("record format '%v' is already registered", name)
+	}
+	globalRecordFormats[lowerName] = f
+	return nil
+}
+
+// MustRegisterGlobalRecordFormat is like RegisterGlobalRecordFormat but
+// panics if an error occurred.
+func MustRegisterGlobalRecordFormat(name string, f RecordFormat) {
+	if err := RegisterGlobalRecordFormat(name, f); err != nil {
+		panic(fmt.Errorf("bql.MustRegisterGlobalRecordFormat: cannot register '%v': %v", name, err))
+	}
+}
+
+// LookupGlobalRecordFormat returns a RecordFormat having the name. It
+// returns core.NotExistError if the format isn't registered.
+func LookupGlobalRecordFormat(name string) (RecordFormat, error) {
+	globalRecordFormatsMutex.RLock()
+	defer globalRecordFormatsMutex.RUnlock()
+	if f, ok := globalRecordFormats[strings.ToLower(name)]; ok {
+		return f, nil
+	}
+	return nil, core.NotExistError(fmt.Errorf("record format '%v' is not registered", name))
+}
+
+// lookupRecordFormatParam returns the RecordFormat specified by the "format"
+// parameter. It returns the "jsonl" format when the parameter is missing.
+func lookupRecordFormatParam(params data.Map) (string, RecordFormat, error) {
+	name := "jsonl"
+	if v, ok := params["format"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return "", nil, fmt.Errorf("'format' parameter must be a string: %v", err)
+		}
+		name = s
+	}
+	f, err := LookupGlobalRecordFormat(name)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, f, nil
+}
+
+// lineReader reads lines without trailing newlines.
+type lineReader struct {
+	r *bufio.Reader
+}
+
+func (l *lineReader) readLine() ([]byte, error) {
+	line, err := l.r.ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return nil, err
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r")), nil
+}
+
+type jsonlFormat struct{}
+
+type jsonlDecoder struct {
+	lineReader
+}
+
+func (d *jsonlDecoder) Decode() (data.Map, error) {
+	for {
+		line, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		m := data.Map{}
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, &RecordError{Err: err, Record: string(line)}
+		}
+		return m, nil
+	}
+}
+
+type jsonlEncoder struct {
+	w io.Writer
+}
+
+func (e *jsonlEncoder) Encode(m data.Map) error {
+	_, err := fmt.Fprintln(e.w, m.String())
+	return err
+}
+
+func (jsonlFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	return &jsonlDecoder{lineReader{bufio.NewReader(r)}}, nil
+}
+
+func (jsonlFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	return &jsonlEncoder{w}, nil
+}
+
+// rawFormat reads each line as a record having one string field. Its
+// parameter is:
+//
+//	field: the name of the field (default: "line")
+type rawFormat struct{}
+
+func rawFieldParam(params data.Map) (string, error) {
+	v := &struct {
+		Field string
+	}{
+		Field: "line",
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return "", err
+	}
+	if v.Field == "" {
+		return "", fmt.Errorf("'field' parameter must not be empty")
+	}
+	return v.Field, nil
+}
+
+type rawDecoder struct {
+	lineReader
+	field string
+}
+
+func (d *rawDecoder) Decode() (data.Map, error) {
+	line, err := d.readLine()
+	if err != nil {
+		return nil, err
+	}
+	return data.Map{d.field: data.String(line)}, nil
+}
+
+type rawEncoder struct {
+	w     io.Writer
+	field string
+}
+
+func (e *rawEncoder) Encode(m data.Map) error {
+	v, ok := m[e.field]
+	if !ok {
+		return fmt.Errorf("the record doesn't have the field '%v'", e.field)
+	}
+	s, err := data.ToString(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(e.w, s)
+	return err
+}
+
+func (rawFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	f, err := rawFieldParam(params)
+	if err != nil {
+		return nil, err
+	}
+	return &rawDecoder{lineReader{bufio.NewReader(r)}, f}, nil
+}
+
+func (rawFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	f, err := rawFieldParam(params)
+	if err != nil {
+		return nil, err
+	}
+	return &rawEncoder{w, f}, nil
+}
+
+// msgpackFormat reads and writes a stream of concatenated msgpack maps.
+type msgpackFormat struct{}
+
+type msgpackEncoder struct {
+	w io.Writer
+}
+
+func (e *msgpackEncoder) Encode(m data.Map) error {
+	b, err := data.MarshalMsgpack(m)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (msgpackFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	return data.NewMsgpackDecoder(r), nil
+}
+
+func (msgpackFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	return &msgpackEncoder{w}, nil
+}
+
+// csvFormat reads and writes delimiter-separated values. Its parameters
+// are:
+//
+//	header: whether the first line has column names (default: true)
+//	columns: an array of column names. It overrides the header. It's
+//	         required when header is false.
+//	column_types: a map from a column name to its type, which is one of
+//	              "string" (default), "int", "float", "bool", "timestamp",
+//	              and "auto". "auto" converts a value to an int or a float
+//	              when possible. Empty values of non-string columns are
+//	              converted to null.
+//	delimiter: the delimiter of values (default: "," for csv and "\t" for
+//	           tsv)
+//
+// When the encoder doesn't have columns, the sorted keys of the first
+// record are used.
+type csvFormat struct {
+	delimiter rune
+}
+
+type csvParams struct {
+	header      bool
+	columns     []string
+	columnTypes map[string]func(string) (data.Value, error)
+	delimiter   rune
+}
+
+var csvColumnConverters = map[string]func(string) (data.Value, error){
+	"string": func(s string) (data.Value, error) {
+		return data.String(s), nil
+	},
+	"int": func(s string) (data.Value, error) {
+		i, err := data.ToInt(data.String(s))
+		if err != nil {
+			return nil, err
+		}
+		return data.Int(i), nil
+	},
+	"float": func(s string) (data.Value, error) {
+		f, err := data.ToFloat(data.String(s))
+		if err != nil {
+			return nil, err
+		}
+		return data.Float(f), nil
+	},
+	"bool": func(s string) (data.Value, error) {
+		b, err := data.ToBool(data.String(s))
+		if err != nil {
+			return nil, err
+		}
+		return data.Bool(b), nil
+	},
+	"timestamp": func(s string) (data.Value, error) {
+		t, err := data.ToTimestamp(data.String(s))
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t), nil
+	},
+	"auto": func(s string) (data.Value, error) {
+		if i, err := data.ToInt(data.String(s)); err == nil {
+			return data.Int(i), nil
+		}
+		if f, err := data.ToFloat(data.String(s)); err == nil {
+			return data.Float(f), nil
+		}
+		return data.String(s), nil
+	},
+}
+
+func (f *csvFormat) params(params data.Map) (*csvParams, error) {
+	v := &struct {
+		Header      bool
+		Columns     []string
+		ColumnTypes map[string]string
+		Delimiter   string
+	}{
+		Header: true,
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	p := &csvParams{
+		header:      v.Header,
+		columns:     v.Columns,
+		columnTypes: map[string]func(string) (data.Value, error){},
+		delimiter:   f.delimiter,
+	}
+	for c, t := range v.ColumnTypes {
+		conv, ok := csvColumnConverters[strings.ToLower(t)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type of column '%v': %v", c, t)
+		}
+		if strings.ToLower(t) == "string" {
+			// string columns are handled in the same way as columns
+			// without types so that empty values are kept.
+			continue
+		}
+		p.columnTypes[c] = conv
+	}
+	if v.Delimiter != "" {
+		d, size := utf8.DecodeRuneInString(v.Delimiter)
+		if size != len(v.Delimiter) {
+			return nil, fmt.Errorf("'delimiter' parameter must be one character: %v", v.Delimiter)
+		}
+		p.delimiter = d
+	}
+	return p, nil
+}
+
+type csvDecoder struct {
+	*csvParams
+	r *csv.Reader
+
+	// skipHeader is true when the header has to be skipped because columns
+	// are given as a parameter.
+	skipHeader bool
+}
+
+func (d *csvDecoder) Decode() (data.Map, error) {
+	if d.skipHeader {
+		if _, err := d.r.Read(); err != nil {
+			return nil, err
+		}
+		d.skipHeader = false
+	}
+	if d.columns == nil {
+		header, err := d.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		d.columns = header
+	}
+
+	rec, err := d.r.Read()
+	if err != nil {
+		if _, ok := err.(*csv.ParseError); ok {
+			return nil, &RecordError{Err: err, Record: strings.Join(rec, string(d.delimiter))}
+		}
+		return nil, err
+	}
+	raw := strings.Join(rec, string(d.delimiter))
+	if len(rec) != len(d.columns) {
+		return nil, &RecordError{
+			Err:    fmt.Errorf("the record has %v values but there are %v columns", len(rec), len(d.columns)),
+			Record: raw,
+		}
+	}
+
+	m := make(data.Map, len(rec))
+	for i, s := range rec {
+		c := d.columns[i]
+		conv, ok := d.columnTypes[c]
+		if !ok {
+			m[c] = data.String(s)
+			continue
+		}
+		if s == "" {
+			m[c] = data.Null{}
+			continue
+		}
+		v, err := conv(s)
+		if err != nil {
+			return nil, &RecordError{
+				Err:    fmt.Errorf("cannot convert the value of column '%v': %v", c, err),
+				Record: raw,
+			}
+		}
+		m[c] = v
+	}
+	return m, nil
+}
+
+type csvEncoder struct {
+	*csvParams
+	w *csv.Writer
+}
+
+func (e *csvEncoder) Encode(m data.Map) error {
+	if e.columns == nil {
+		for k := range m {
+			e.columns = append(e.columns, k)
+		}
+		sort.Strings(e.columns)
+	}
+	if e.header {
+		if err := e.w.Write(e.columns); err != nil {
+			return err
+		}
+		e.header = false
+	}
+
+	rec := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		v, ok := m[c]
+		if !ok {
+			continue
+		}
+		s, err := data.ToString(v)
+		if err != nil {
+			return err
+		}
+		rec[i] = s
+	}
+	if err := e.w.Write(rec); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (f *csvFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	p, err := f.params(params)
+	if err != nil {
+		return nil, err
+	}
+	if !p.header && len(p.columns) == 0 {
+		return nil, fmt.Errorf("'columns' parameter is required when 'header' is false")
+	}
+
+	cr := csv.NewReader(r)
+	cr.Comma = p.delimiter
+	cr.FieldsPerRecord = -1
+	if p.delimiter == '\t' {
+		// Values in TSV usually aren't quoted.
+		cr.LazyQuotes = true
+	}
+	return &csvDecoder{
+		csvParams:  p,
+		r:          cr,
+		skipHeader: p.header && len(p.columns) > 0,
+	}, nil
+}
+
+func (f *csvFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	p, err := f.params(params)
+	if err != nil {
+		return nil, err
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = p.delimiter
+	return &csvEncoder{
+		csvParams: p,
+		w:         cw,
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalRecordFormat("jsonl", jsonlFormat{})
+	// "json" is an alias of "jsonl" because each record is a JSON object.
+	MustRegisterGlobalRecordFormat("json", jsonlFormat{})
+	MustRegisterGlobalRecordFormat("raw", rawFormat{})
+	MustRegisterGlobalRecordFormat("msgpack", msgpackFormat{})
+	MustRegisterGlobalRecordFormat("csv", &csvFormat{delimiter: ','})
+	MustRegisterGlobalRecordFormat("tsv", &csvFormat{delimiter: '\t'})
+}
@@ -0,0 +1,358 @@
+package bql
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func decodeAllRecords(f RecordFormat, input string, params data.Map) ([]data.Map, []error, error) {
+	dec, err := f.NewDecoder(strings.NewReader(input), params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		res  []data.Map
+		errs []error
+	)
+	for {
+		m, err := dec.Decode()
+		if err == io.EOF {
+			return res, errs, nil
+		} else if err != nil {
+			if _, ok := err.(*RecordError); !ok {
+				return nil, nil, err
+			}
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, m)
+	}
+}
+
+func encodeAllRecords(f RecordFormat, params data.Map, ms ...data.Map) (string, error) {
+	buf := bytes.NewBuffer(nil)
+	enc, err := f.NewEncoder(buf, params)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range ms {
+		if err := enc.Encode(m); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+func TestRecordFormatRegistry(t *testing.T) {
+	Convey("Given the global record format registry", t, func() {
+		Convey("When looking up built-in formats", func() {
+			Convey("Then they should be found", func() {
+				for _, name := range []string{"jsonl", "json", "raw", "msgpack", "csv", "tsv", "CSV"} {
+					_, err := LookupGlobalRecordFormat(name)
+					So(err, ShouldBeNil)
+				}
+			})
+		})
+
+		Convey("When looking up a missing format", func() {
+			_, err := LookupGlobalRecordFormat("no_such_format")
+
+			Convey("Then it should fail with NotExistError", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When registering a format having an existing name", func() {
+			err := RegisterGlobalRecordFormat("JSONL", jsonlFormat{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When registering a format having an empty name", func() {
+			err := RegisterGlobalRecordFormat("", jsonlFormat{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestJSONLRecordFormat(t *testing.T) {
+	Convey("Given the jsonl format", t, func() {
+		f := jsonlFormat{}
+
+		Convey("When decoding records having empty and malformed lines", func() {
+			ms, errs, err := decodeAllRecords(f, "{\"a\":1}\n\n {\"a\":2} \r\n{\"a\":\n{\"a\":3}", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should skip empty lines", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.Int(1)},
+					{"a": data.Int(2)},
+					{"a": data.Int(3)},
+				})
+			})
+
+			Convey("Then it should report the malformed line", func() {
+				So(errs, ShouldHaveLength, 1)
+				So(errs[0].(*RecordError).Record, ShouldEqual, `{"a":`)
+			})
+		})
+
+		Convey("When encoding records", func() {
+			s, err := encodeAllRecords(f, nil, data.Map{"a": data.Int(1)}, data.Map{"b": data.String("x")})
+			So(err, ShouldBeNil)
+
+			Convey("Then each record should be written in a line", func() {
+				So(s, ShouldEqual, "{\"a\":1}\n{\"b\":\"x\"}\n")
+			})
+		})
+	})
+}
+
+func TestRawRecordFormat(t *testing.T) {
+	Convey("Given the raw format", t, func() {
+		f := rawFormat{}
+
+		Convey("When decoding lines", func() {
+			ms, errs, err := decodeAllRecords(f, "a b\r\n\nc", nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then each line should be a record", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{"line": data.String("a b")},
+					{"line": data.String("")},
+					{"line": data.String("c")},
+				})
+			})
+		})
+
+		Convey("When decoding lines with a custom field", func() {
+			ms, _, err := decodeAllRecords(f, "a\n", data.Map{"field": data.String("msg")})
+			So(err, ShouldBeNil)
+
+			Convey("Then the field should be used", func() {
+				So(ms, ShouldResemble, []data.Map{{"msg": data.String("a")}})
+			})
+		})
+
+		Convey("When encoding records", func() {
+			s, err := encodeAllRecords(f, nil, data.Map{"line": data.String("a")}, data.Map{"line": data.Int(1)})
+			So(err, ShouldBeNil)
+
+			Convey("Then the field should be written in a line", func() {
+				So(s, ShouldEqual, "a\n1\n")
+			})
+		})
+
+		Convey("When encoding a record without the field", func() {
+			_, err := encodeAllRecords(f, nil, data.Map{"a": data.String("a")})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating a decoder with an empty field", func() {
+			_, err := f.NewDecoder(strings.NewReader(""), data.Map{"field": data.String("")})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestMsgpackRecordFormat(t *testing.T) {
+	Convey("Given the msgpack format", t, func() {
+		f := msgpackFormat{}
+
+		Convey("When encoding and decoding records", func() {
+			ms := []data.Map{
+				{"a": data.Int(1)},
+				{"b": data.Array{data.String("x"), data.Float(1.5)}},
+			}
+			s, err := encodeAllRecords(f, nil, ms...)
+			So(err, ShouldBeNil)
+			res, errs, err := decodeAllRecords(f, s, nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then the records should be restored", func() {
+				So(res, ShouldResemble, ms)
+			})
+		})
+	})
+}
+
+func TestCSVRecordFormat(t *testing.T) {
+	csvFmt, err := LookupGlobalRecordFormat("csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsvFmt, err := LookupGlobalRecordFormat("tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Given the csv format", t, func() {
+		Convey("When decoding records with a header", func() {
+			ms, errs, err := decodeAllRecords(csvFmt, "a,b\n1,\"x,y\"\n2\n3,z\n", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then values should be strings", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.String("1"), "b": data.String("x,y")},
+					{"a": data.String("3"), "b": data.String("z")},
+				})
+			})
+
+			Convey("Then a record having a wrong number of values should be reported", func() {
+				So(errs, ShouldHaveLength, 1)
+				So(errs[0].(*RecordError).Record, ShouldEqual, "2")
+			})
+		})
+
+		Convey("When decoding records with column types", func() {
+			ts := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+			ms, errs, err := decodeAllRecords(csvFmt,
+				"i,f,b,t,a,s\n1,1.5,true,2015-01-02T03:04:05Z,2.5,x\n,,,,,\nx,1,1,1,y,1\n",
+				data.Map{
+					"column_types": data.Map{
+						"i": data.String("int"),
+						"f": data.String("float"),
+						"b": data.String("bool"),
+						"t": data.String("timestamp"),
+						"a": data.String("auto"),
+						"s": data.String("string"),
+					},
+				})
+			So(err, ShouldBeNil)
+
+			Convey("Then values should be converted", func() {
+				So(ms, ShouldHaveLength, 2)
+				So(ms[0], ShouldResemble, data.Map{
+					"i": data.Int(1),
+					"f": data.Float(1.5),
+					"b": data.True,
+					"t": data.Timestamp(ts),
+					"a": data.Float(2.5),
+					"s": data.String("x"),
+				})
+			})
+
+			Convey("Then empty values should be null except for strings", func() {
+				So(ms[1], ShouldResemble, data.Map{
+					"i": data.Null{},
+					"f": data.Null{},
+					"b": data.Null{},
+					"t": data.Null{},
+					"a": data.Null{},
+					"s": data.String(""),
+				})
+			})
+
+			Convey("Then an inconvertible value should be reported", func() {
+				So(errs, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When decoding records with columns", func() {
+			params := data.Map{"columns": data.Array{data.String("x"), data.String("y")}}
+
+			Convey("Then the header should be skipped", func() {
+				ms, _, err := decodeAllRecords(csvFmt, "a,b\n1,2\n", params)
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"x": data.String("1"), "y": data.String("2")},
+				})
+			})
+
+			Convey("Then the first line should be a record without a header", func() {
+				params["header"] = data.False
+				ms, _, err := decodeAllRecords(csvFmt, "a,b\n1,2\n", params)
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"x": data.String("a"), "y": data.String("b")},
+					{"x": data.String("1"), "y": data.String("2")},
+				})
+			})
+		})
+
+		Convey("When decoding records with a custom delimiter", func() {
+			ms, _, err := decodeAllRecords(csvFmt, "a;b\n1;2\n", data.Map{"delimiter": data.String(";")})
+			So(err, ShouldBeNil)
+
+			Convey("Then the delimiter should be used", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.String("1"), "b": data.String("2")},
+				})
+			})
+		})
+
+		Convey("When decoding tsv records", func() {
+			ms, _, err := decodeAllRecords(tsvFmt, "a\tb\n1\tx\"y\n", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then values should be separated by tabs", func() {
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.String("1"), "b": data.String("x\"y")},
+				})
+			})
+		})
+
+		Convey("When encoding records without columns", func() {
+			s, err := encodeAllRecords(csvFmt, nil,
+				data.Map{"b": data.String("x,y"), "a": data.Int(1)},
+				data.Map{"a": data.Float(2.5), "c": data.True})
+			So(err, ShouldBeNil)
+
+			Convey("Then the keys of the first record should be columns", func() {
+				So(s, ShouldEqual, "a,b\n1,\"x,y\"\n2.5,\n")
+			})
+		})
+
+		Convey("When encoding tsv records without a header", func() {
+			s, err := encodeAllRecords(tsvFmt, data.Map{
+				"header":  data.False,
+				"columns": data.Array{data.String("b"), data.String("a")},
+			}, data.Map{"a": data.Int(1), "b": data.Int(2)})
+			So(err, ShouldBeNil)
+
+			Convey("Then only values should be written", func() {
+				So(s, ShouldEqual, "2\t1\n")
+			})
+		})
+
+		Convey("When creating a decoder with invalid parameters", func() {
+			Convey("Then missing columns without a header should result in an error", func() {
+				_, err := csvFmt.NewDecoder(strings.NewReader(""), data.Map{"header": data.False})
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an unsupported column type should result in an error", func() {
+				_, err := csvFmt.NewDecoder(strings.NewReader(""), data.Map{
+					"column_types": data.Map{"a": data.String("complex")},
+				})
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then a multi-character delimiter should result in an error", func() {
+				_, err := csvFmt.NewDecoder(strings.NewReader(""), data.Map{"delimiter": data.String("::")})
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
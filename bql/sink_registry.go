@@ -60,9 +60,18 @@ func NewDefaultSinkCreatorRegistry() SinkCreatorRegistry {
 	}
 }
 
+// reservedSinkTypeNames are reserved words which are allowed to be sink
+// type names. core.ValidateSymbol still rejects them for other names such as
+// node names.
+var reservedSinkTypeNames = map[string]struct{}{
+	"sql": struct{}{},
+}
+
 func (r *defaultSinkCreatorRegistry) Register(typeName string, c SinkCreator) error {
-	if err := core.ValidateSymbol(typeName); err != nil {
-		return fmt.Errorf("invalid name for sink type: %s", err.Error())
+	if _, ok := reservedSinkTypeNames[strings.ToLower(typeName)]; !ok {
+		if err := core.ValidateSymbol(typeName); err != nil {
+			return fmt.Errorf("invalid name for sink type: %s", err.Error())
+		}
 	}
 
 	r.m.Lock()
@@ -19,6 +19,17 @@ func TestEmptyDefaultSinkCreatorRegistry(t *testing.T) {
 			})
 		})
 
+		Convey("When adding a creator having a reserved word as the type name", func() {
+			err1 := r.Register("sql", SinkCreatorFunc(createCollectorSink))
+			err2 := r.Register("select", SinkCreatorFunc(createCollectorSink))
+
+			Convey("Then only a name allowed for sink types should be accepted", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldNotBeNil)
+				So(core.ValidateSymbol("sql"), ShouldNotBeNil)
+			})
+		})
+
 		Convey("When looking up a nonexistent creator", func() {
 			_, err := r.Lookup("test_sink")
 
@@ -0,0 +1,317 @@
+package bql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// socketDecoder decodes records received by the tcp source and the udp
+// source into tuples.
+type socketDecoder struct {
+	tsField  data.Path
+	ioParams *IOParams
+
+	formatName string
+	format     RecordFormat
+	params     data.Map
+
+	// remoteAddrField is the name of the field having the address of the
+	// sender. It isn't added when it's empty.
+	remoteAddrField string
+}
+
+// writeAll decodes records read from r and writes them. It returns an error
+// returned from the Writer as it is, and returns other fatal errors wrapped
+// in socketReadError.
+func (d *socketDecoder) writeAll(ctx *core.Context, w core.Writer, r io.Reader, remote net.Addr) error {
+	dec, err := d.format.NewDecoder(r, d.params)
+	if err != nil {
+		return &socketReadError{err}
+	}
+	for recordNumber := 0; ; recordNumber++ {
+		m, err := dec.Decode()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if e, ok := err.(*RecordError); ok {
+				ctx.ErrLog(e.Err).WithField("node_name", d.ioParams.Name).
+					WithField("format", d.formatName).
+					WithField("remote_addr", remote.String()).
+					WithField("record_number", recordNumber).
+					WithField("body", e.Record).Warning("Ignoring the record due to a parse error")
+				continue
+			}
+			return &socketReadError{err}
+		}
+
+		if d.remoteAddrField != "" {
+			m[d.remoteAddrField] = data.String(remote.String())
+		}
+		t := core.NewTuple(m)
+		if d.tsField != nil {
+			if v, err := t.Data.Get(d.tsField); err == nil {
+				if ts, err := data.ToTimestamp(v); err != nil {
+					ctx.ErrLog(err).WithField("node_name", d.ioParams.Name).
+						WithField("record_number", recordNumber).
+						WithField("timestamp_field", d.tsField).
+						WithField("timestamp_field_value", v).
+						Warning("Cannot convert a value in timestamp_field to a timestamp")
+				} else {
+					t.Timestamp = ts
+				}
+			}
+		}
+		if err := w.Write(ctx, t); err != nil {
+			return err
+		}
+	}
+}
+
+// socketReadError is an error which occurred while reading records from a
+// connection. It only closes the connection and doesn't stop the source.
+type socketReadError struct {
+	err error
+}
+
+func (e *socketReadError) Error() string {
+	return e.err.Error()
+}
+
+// tcpSource listens on a TCP address and emits records read from each
+// connection. Connections are handled concurrently.
+type tcpSource struct {
+	socketDecoder
+	listener net.Listener
+
+	m       sync.Mutex
+	conns   map[net.Conn]struct{}
+	stopped bool
+	err     error
+	wg      sync.WaitGroup
+}
+
+func (s *tcpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	defer func() {
+		s.closeAll()
+		s.wg.Wait()
+	}()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.m.Lock()
+			defer s.m.Unlock()
+			if s.err != nil {
+				return s.err
+			}
+			if s.stopped {
+				return nil
+			}
+			return err
+		}
+
+		s.m.Lock()
+		if s.stopped {
+			s.m.Unlock()
+			conn.Close()
+			continue
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.m.Unlock()
+		go s.serve(ctx, w, conn)
+	}
+}
+
+func (s *tcpSource) serve(ctx *core.Context, w core.Writer, conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.m.Lock()
+		delete(s.conns, conn)
+		s.m.Unlock()
+		conn.Close()
+	}()
+
+	err := s.writeAll(ctx, w, conn, conn.RemoteAddr())
+	if err == nil {
+		return
+	}
+	if e, ok := err.(*socketReadError); ok {
+		s.m.Lock()
+		stopped := s.stopped
+		s.m.Unlock()
+		if !stopped {
+			ctx.ErrLog(e.err).WithField("node_name", s.ioParams.Name).
+				WithField("remote_addr", conn.RemoteAddr().String()).
+				Warning("Closing the connection due to a read error")
+		}
+		return
+	}
+
+	// An error from the Writer stops the source.
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.err == nil {
+		s.err = err
+		s.listener.Close()
+	}
+}
+
+// closeAll closes all connections being handled.
+func (s *tcpSource) closeAll() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.stopped = true
+	for c := range s.conns {
+		c.Close()
+	}
+}
+
+func (s *tcpSource) Stop(ctx *core.Context) error {
+	s.closeAll()
+	return s.listener.Close()
+}
+
+// udpSource emits records read from each datagram received on a UDP
+// address. A datagram can have more than one record.
+type udpSource struct {
+	socketDecoder
+	conn net.PacketConn
+
+	m       sync.Mutex
+	stopped bool
+}
+
+func (s *udpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	buf := make([]byte, 64*1024)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			s.m.Lock()
+			defer s.m.Unlock()
+			if s.stopped {
+				return nil
+			}
+			return err
+		}
+
+		err = s.writeAll(ctx, w, bytes.NewReader(buf[:n]), addr)
+		if e, ok := err.(*socketReadError); ok {
+			ctx.ErrLog(e.err).WithField("node_name", s.ioParams.Name).
+				WithField("remote_addr", addr.String()).
+				Warning("Ignoring the rest of the datagram due to a read error")
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *udpSource) Stop(ctx *core.Context) error {
+	s.m.Lock()
+	s.stopped = true
+	s.m.Unlock()
+	return s.conn.Close()
+}
+
+// newSocketDecoder creates a socketDecoder from parameters of the tcp source
+// and the udp source, and returns it with the address to listen on.
+func newSocketDecoder(ioParams *IOParams, params data.Map) (string, *socketDecoder, error) {
+	v := &struct {
+		Address         string `bql:",required"`
+		TimestampField  string
+		RemoteAddrField string
+	}{
+		RemoteAddrField: "remote_addr",
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return "", nil, err
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		var err error
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return "", nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+
+	formatName, format, err := lookupRecordFormatParam(params)
+	if err != nil {
+		return "", nil, err
+	}
+	// validate format parameters before the source starts
+	if _, err := format.NewDecoder(bytes.NewReader(nil), params); err != nil {
+		return "", nil, err
+	}
+
+	return v.Address, &socketDecoder{
+		tsField:         tsField,
+		ioParams:        ioParams,
+		formatName:      formatName,
+		format:          format,
+		params:          params,
+		remoteAddrField: v.RemoteAddrField,
+	}, nil
+}
+
+// newTCPSource creates a tcpSource. The source starts listening on the
+// address when it's created so that an invalid address is reported by
+// CREATE SOURCE.
+func newTCPSource(ioParams *IOParams, params data.Map) (*tcpSource, error) {
+	addr, d, err := newSocketDecoder(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &tcpSource{
+		socketDecoder: *d,
+		listener:      l,
+		conns:         map[net.Conn]struct{}{},
+	}, nil
+}
+
+func createTCPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	s, err := newTCPSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+// newUDPSource creates a udpSource listening on the address.
+func newUDPSource(ioParams *IOParams, params data.Map) (*udpSource, error) {
+	addr, d, err := newSocketDecoder(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &udpSource{
+		socketDecoder: *d,
+		conn:          conn,
+	}, nil
+}
+
+func createUDPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	s, err := newUDPSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("tcp", SourceCreatorFunc(createTCPSource))
+	MustRegisterGlobalSourceCreator("udp", SourceCreatorFunc(createUDPSource))
+}
@@ -0,0 +1,168 @@
+package bql
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// startSocketSource runs GenerateStream of the source until the test ends.
+func startSocketSource(ctx *core.Context, s core.Source, w core.Writer) <-chan error {
+	ch := make(chan error, 1)
+	go func() {
+		ch <- s.GenerateStream(ctx, w)
+	}()
+	Reset(func() {
+		s.Stop(ctx)
+	})
+	return ch
+}
+
+func TestTCPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a tcp source", t, func() {
+		params := data.Map{
+			"address": data.String("127.0.0.1:0"),
+		}
+		c := &tupleCollector{}
+
+		Convey("When clients send JSON Lines", func() {
+			s, err := newTCPSource(&IOParams{}, params)
+			So(err, ShouldBeNil)
+			src := core.ImplementSourceStop(s)
+			ch := startSocketSource(ctx, src, c)
+
+			conn1, err := net.Dial("tcp", s.listener.Addr().String())
+			So(err, ShouldBeNil)
+			defer conn1.Close()
+			fmt.Fprint(conn1, "{\"i\":1}\nbroken\n{\"i\":2}\n")
+			So(c.ints(2), ShouldResemble, []int64{1, 2})
+
+			conn2, err := net.Dial("tcp", s.listener.Addr().String())
+			So(err, ShouldBeNil)
+			defer conn2.Close()
+			fmt.Fprint(conn2, "{\"i\":3}\n")
+
+			Convey("Then records should be emitted with the remote address", func() {
+				So(c.ints(3), ShouldResemble, []int64{1, 2, 3})
+				So(c.ts[0].Data["remote_addr"], ShouldEqual, data.String(conn1.LocalAddr().String()))
+				So(c.ts[2].Data["remote_addr"], ShouldEqual, data.String(conn2.LocalAddr().String()))
+			})
+
+			Convey("Then it should stop while connections are open", func() {
+				So(src.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+
+		Convey("When a client sends syslog messages", func() {
+			params["format"] = data.String("syslog")
+			params["timestamp_field"] = data.String("timestamp")
+			params["remote_addr_field"] = data.String("")
+			s, err := newTCPSource(&IOParams{}, params)
+			So(err, ShouldBeNil)
+			startSocketSource(ctx, core.ImplementSourceStop(s), c)
+
+			conn, err := net.Dial("tcp", s.listener.Addr().String())
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			fmt.Fprint(conn, "<34>1 2003-10-11T22:14:15.003Z host app - - - hello\n")
+
+			Convey("Then the message should be emitted with its timestamp", func() {
+				So(c.ints(1), ShouldHaveLength, 1)
+				So(c.ts[0].Data["message"], ShouldEqual, data.String("hello"))
+				So(c.ts[0].Data, ShouldNotContainKey, "remote_addr")
+				So(c.ts[0].Timestamp, ShouldResemble, time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC))
+			})
+		})
+
+		Convey("When creating the source with invalid parameters", func() {
+			Convey("Then missing address should result in an error", func() {
+				delete(params, "address")
+				_, err := createTCPSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid address should result in an error", func() {
+				params["address"] = data.String("127.0.0.1:port")
+				_, err := createTCPSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the json format should be accepted", func() {
+				params["format"] = data.String("json")
+				s, err := newTCPSource(&IOParams{}, params)
+				So(err, ShouldBeNil)
+				So(s.listener.Close(), ShouldBeNil)
+			})
+
+			Convey("Then an unknown format should result in an error", func() {
+				params["format"] = data.String("no_such_format")
+				_, err := createTCPSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then invalid format parameters should result in an error", func() {
+				params["format"] = data.String("influx")
+				params["precision"] = data.String("m")
+				_, err := createTCPSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUDPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a udp source", t, func() {
+		params := data.Map{
+			"address": data.String("127.0.0.1:0"),
+		}
+		c := &tupleCollector{}
+
+		Convey("When a client sends datagrams", func() {
+			params["format"] = data.String("influx")
+			s, err := newUDPSource(&IOParams{}, params)
+			So(err, ShouldBeNil)
+			src := core.ImplementSourceStop(s)
+			ch := startSocketSource(ctx, src, c)
+
+			conn, err := net.Dial("udp", s.conn.LocalAddr().String())
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			_, err = fmt.Fprint(conn, "m i=1i\nm i=2i")
+			So(err, ShouldBeNil)
+			_, err = fmt.Fprint(conn, "m i=3i")
+			So(err, ShouldBeNil)
+
+			Convey("Then each record in the datagrams should be emitted", func() {
+				So(c.ints(3), ShouldHaveLength, 3)
+				for i, t := range c.ts {
+					So(t.Data["fields"], ShouldResemble, data.Map{"i": data.Int(i + 1)})
+					So(t.Data["remote_addr"], ShouldEqual, data.String(conn.LocalAddr().String()))
+				}
+			})
+
+			Convey("Then it should stop", func() {
+				So(src.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+
+		Convey("When creating the source with an invalid address", func() {
+			params["address"] = data.String("127.0.0.1:port")
+			_, err := createUDPSource(ctx, &IOParams{}, params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,456 @@
+package bql
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// sqlDialect has differences of SQL syntax among databases.
+type sqlDialect struct {
+	// quote is the character quoting identifiers.
+	quote string
+
+	// numberedPlaceholders is true when placeholders are $1, $2, ...
+	// instead of ?.
+	numberedPlaceholders bool
+
+	// upsert returns the clause added to an INSERT statement to update
+	// columns of an existing row having the same key. It's nil when the
+	// dialect doesn't support upsert.
+	upsert func(d *sqlDialect, keys, columns []string) string
+}
+
+func (d *sqlDialect) quoteIdent(s string) string {
+	return d.quote + strings.Replace(s, d.quote, d.quote+d.quote, -1) + d.quote
+}
+
+// quoteTable quotes a table name which can be qualified by a schema name.
+func (d *sqlDialect) quoteTable(s string) string {
+	parts := strings.Split(s, ".")
+	for i, p := range parts {
+		parts[i] = d.quoteIdent(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+func (d *sqlDialect) placeholder(i int) string {
+	if d.numberedPlaceholders {
+		return fmt.Sprintf("$%v", i+1)
+	}
+	return "?"
+}
+
+func onConflictUpsert(d *sqlDialect, keys, columns []string) string {
+	qkeys := make([]string, len(keys))
+	for i, k := range keys {
+		qkeys[i] = d.quoteIdent(k)
+	}
+	var sets []string
+	for _, c := range columns {
+		if !containsString(keys, c) {
+			sets = append(sets, fmt.Sprintf("%v = excluded.%v", d.quoteIdent(c), d.quoteIdent(c)))
+		}
+	}
+	if len(sets) == 0 {
+		return fmt.Sprintf(" ON CONFLICT (%v) DO NOTHING", strings.Join(qkeys, ", "))
+	}
+	return fmt.Sprintf(" ON CONFLICT (%v) DO UPDATE SET %v", strings.Join(qkeys, ", "), strings.Join(sets, ", "))
+}
+
+func onDuplicateKeyUpsert(d *sqlDialect, keys, columns []string) string {
+	var sets []string
+	for _, c := range columns {
+		if !containsString(keys, c) {
+			sets = append(sets, fmt.Sprintf("%v = VALUES(%v)", d.quoteIdent(c), d.quoteIdent(c)))
+		}
+	}
+	if len(sets) == 0 {
+		// MySQL doesn't have DO NOTHING. Updating a key with its own value
+		// has the same effect.
+		k := d.quoteIdent(keys[0])
+		sets = append(sets, fmt.Sprintf("%v = %v", k, k))
+	}
+	return " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+}
+
+func containsString(a []string, s string) bool {
+	for _, e := range a {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+var sqlDialects = map[string]*sqlDialect{
+	"postgres": {quote: `"`, numberedPlaceholders: true, upsert: onConflictUpsert},
+	"mysql":    {quote: "`", upsert: onDuplicateKeyUpsert},
+	"sqlite":   {quote: `"`, upsert: onConflictUpsert},
+	"generic":  {quote: `"`},
+}
+
+// sqlDriverDialects has dialects of well-known drivers.
+var sqlDriverDialects = map[string]string{
+	"postgres": "postgres",
+	"pgx":      "postgres",
+	"mysql":    "mysql",
+	"sqlite3":  "sqlite",
+	"sqlite":   "sqlite",
+}
+
+// sqlColumn is a column in the table and the path of its value in a tuple.
+type sqlColumn struct {
+	name string
+	path data.Path
+}
+
+// sqlSinkMaxBufferedBatches is the number of batches which can be kept in
+// the buffer while the database is unavailable.
+const sqlSinkMaxBufferedBatches = 10
+
+// sqlSink inserts tuples into a table through database/sql. Rows are
+// inserted in batches having up to batchSize rows, and each batch is
+// inserted in a transaction. A batch which doesn't get full is inserted
+// after flushInterval.
+//
+// When the batch fails, the transaction is rolled back. If the database
+// cannot be connected, the error is returned as core.TemporaryError and the
+// rows are kept in the buffer so that they're inserted with the next batch.
+// The oldest rows are dropped once the buffer has more than
+// sqlSinkMaxBufferedBatches batches. Otherwise, the rows are discarded
+// because inserting them again would fail in the same way. Errors are
+// returned from the Write call which inserted the batch or from Close, and
+// they're logged when the batch is inserted after flushInterval.
+//
+// Rows are inserted without holding the lock of the buffer, so Write doesn't
+// block while another goroutine is inserting a batch.
+type sqlSink struct {
+	db        *sql.DB
+	table     string
+	columns   []sqlColumn
+	insertSQL string
+
+	batchSize     int
+	flushInterval time.Duration
+
+	ioParams *IOParams
+
+	m        sync.Mutex
+	rows     [][]interface{}
+	closed   bool
+	flushing bool
+	flushed  *sync.Cond
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func (s *sqlSink) Write(ctx *core.Context, t *core.Tuple) error {
+	row := make([]interface{}, len(s.columns))
+	for i, c := range s.columns {
+		v, err := t.Data.Get(c.path)
+		if err != nil {
+			// A missing value is inserted as NULL.
+			continue
+		}
+		row[i] = sqlValue(v)
+	}
+
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return errors.New("the sink is already closed")
+	}
+	s.rows = append(s.rows, row)
+	s.dropOverflow(ctx)
+	s.m.Unlock()
+	return s.flush(ctx, false)
+}
+
+// sqlValue converts a value to a type which database/sql drivers accept.
+// Arrays and maps are converted to JSON strings.
+func sqlValue(v data.Value) interface{} {
+	switch v.Type() {
+	case data.TypeNull:
+		return nil
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		return b
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return i
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return f
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return s
+	case data.TypeBlob:
+		b, _ := data.AsBlob(v)
+		return b
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		return t
+	default:
+		return v.String()
+	}
+}
+
+// flushPeriodically inserts batches which don't get full until they're
+// inserted by Write.
+func (s *sqlSink) flushPeriodically(ctx *core.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.flush(ctx, true); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("table", s.table).Error("Cannot insert tuples")
+		}
+	}
+}
+
+// flush inserts buffered rows in batches. Only full batches are inserted
+// unless force is true. When another goroutine is inserting rows, flush
+// returns immediately if force is false and waits for it otherwise. Rows
+// which couldn't be inserted due to a temporary error are put back to the
+// head of the buffer. The caller must not hold the lock.
+func (s *sqlSink) flush(ctx *core.Context, force bool) error {
+	s.m.Lock()
+	for force && s.flushing {
+		s.flushed.Wait()
+	}
+	if s.flushing || len(s.rows) == 0 || (!force && len(s.rows) < s.batchSize) {
+		s.m.Unlock()
+		return nil
+	}
+	s.flushing = true
+	rows := s.rows
+	s.rows = nil
+	s.m.Unlock()
+
+	var err error
+	for len(rows) > 0 {
+		n := s.batchSize
+		if len(rows) < n {
+			if !force {
+				break
+			}
+			n = len(rows)
+		}
+		err = s.insert(rows[:n])
+		if core.IsTemporaryError(err) {
+			break
+		}
+		rows = rows[n:]
+		if err != nil {
+			break
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.rows = append(rows, s.rows...)
+	s.dropOverflow(ctx)
+	s.flushing = false
+	s.flushed.Broadcast()
+	return err
+}
+
+// insert inserts rows in a transaction. It returns core.TemporaryError when
+// the database cannot be connected.
+func (s *sqlSink) insert(rows [][]interface{}) (err error) {
+	defer func() {
+		if err != nil && s.db.Ping() != nil {
+			err = core.TemporaryError(err)
+		}
+	}()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.Prepare(s.insertSQL)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// dropOverflow drops the oldest rows when the buffer has too many rows. The
+// caller must hold the lock.
+func (s *sqlSink) dropOverflow(ctx *core.Context) {
+	max := s.batchSize * sqlSinkMaxBufferedBatches
+	if len(s.rows) <= max {
+		return
+	}
+	n := len(s.rows) - max
+	s.rows = append([][]interface{}{}, s.rows[n:]...)
+	ctx.Log().WithField("node_name", s.ioParams.Name).WithField("table", s.table).
+		WithField("num_dropped", n).Warning("Dropped tuples because too many tuples are buffered")
+}
+
+func (s *sqlSink) Close(ctx *core.Context) error {
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.m.Unlock()
+
+	close(s.stopCh)
+	s.wg.Wait()
+
+	err := s.flush(ctx, true)
+	if e := s.db.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// buildSQLInsert returns an INSERT statement for the columns. When keys are
+// given, the statement updates the existing row having the same keys.
+func buildSQLInsert(d *sqlDialect, table string, columns []sqlColumn, keys []string) string {
+	names := make([]string, len(columns))
+	qnames := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+		qnames[i] = d.quoteIdent(c.name)
+		placeholders[i] = d.placeholder(i)
+	}
+
+	b := bytes.NewBuffer(nil)
+	fmt.Fprintf(b, "INSERT INTO %v (%v) VALUES (%v)", d.quoteTable(table),
+		strings.Join(qnames, ", "), strings.Join(placeholders, ", "))
+	if len(keys) > 0 {
+		b.WriteString(d.upsert(d, keys, names))
+	}
+	return b.String()
+}
+
+func createSQLSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	v := &struct {
+		Driver        string            `bql:",required"`
+		DSN           string            `bql:"dsn,required"`
+		Table         string            `bql:",required"`
+		Columns       map[string]string `bql:",required"`
+		Dialect       string
+		BatchSize     int
+		FlushInterval time.Duration
+	}{
+		BatchSize:     100,
+		FlushInterval: time.Second,
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	if v.Dialect == "" {
+		v.Dialect = sqlDriverDialects[v.Driver]
+		if v.Dialect == "" {
+			v.Dialect = "generic"
+		}
+	}
+	d, ok := sqlDialects[v.Dialect]
+	if !ok {
+		return nil, fmt.Errorf("'dialect' parameter must be postgres, mysql, sqlite, or generic: %v", v.Dialect)
+	}
+	if v.BatchSize <= 0 {
+		return nil, fmt.Errorf("'batch_size' parameter must be positive: %v", v.BatchSize)
+	}
+	if v.BatchSize > 1 && v.FlushInterval <= 0 {
+		return nil, fmt.Errorf("'flush_interval' parameter must be positive: %v", v.FlushInterval)
+	}
+
+	if len(v.Columns) == 0 {
+		return nil, errors.New("'columns' parameter must not be empty")
+	}
+	columns := make([]sqlColumn, 0, len(v.Columns))
+	for name, path := range v.Columns {
+		p, err := data.CompilePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("column '%v' doesn't have a valid path: %v", name, err)
+		}
+		columns = append(columns, sqlColumn{name: name, path: p})
+	}
+	// The order of columns is fixed so that the statement is always the same.
+	sort.Sort(sqlColumnsByName(columns))
+
+	keys, err := stringsParam(params, "upsert_key")
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) > 0 && d.upsert == nil {
+		return nil, fmt.Errorf("the %v dialect doesn't support 'upsert_key' parameter", v.Dialect)
+	}
+	for _, k := range keys {
+		if _, ok := v.Columns[k]; !ok {
+			return nil, fmt.Errorf("'upsert_key' parameter has a column which isn't in 'columns': %v", k)
+		}
+	}
+
+	db, err := sql.Open(v.Driver, v.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot connect to the database: %v", err)
+	}
+
+	s := &sqlSink{
+		db:            db,
+		table:         v.Table,
+		columns:       columns,
+		insertSQL:     buildSQLInsert(d, v.Table, columns, keys),
+		batchSize:     v.BatchSize,
+		flushInterval: v.FlushInterval,
+		ioParams:      ioParams,
+		stopCh:        make(chan struct{}),
+	}
+	s.flushed = sync.NewCond(&s.m)
+	if s.batchSize > 1 {
+		s.wg.Add(1)
+		go s.flushPeriodically(ctx)
+	}
+	return s, nil
+}
+
+type sqlColumnsByName []sqlColumn
+
+func (c sqlColumnsByName) Len() int           { return len(c) }
+func (c sqlColumnsByName) Less(i, j int) bool { return c[i].name < c[j].name }
+func (c sqlColumnsByName) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+func init() {
+	MustRegisterGlobalSinkCreator("sql", SinkCreatorFunc(createSQLSink))
+}
@@ -0,0 +1,350 @@
+package bql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestBuildSQLInsert(t *testing.T) {
+	Convey("Given columns", t, func() {
+		columns := []sqlColumn{{name: "id"}, {name: "v"}}
+
+		Convey("When building an INSERT statement for postgres", func() {
+			s := buildSQLInsert(sqlDialects["postgres"], "public.t", columns, []string{"id"})
+
+			Convey("Then it should have numbered placeholders and ON CONFLICT", func() {
+				So(s, ShouldEqual, `INSERT INTO "public"."t" ("id", "v") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "v" = excluded."v"`)
+			})
+		})
+
+		Convey("When building an INSERT statement for mysql", func() {
+			s := buildSQLInsert(sqlDialects["mysql"], "t", columns, []string{"id"})
+
+			Convey("Then it should have ON DUPLICATE KEY UPDATE", func() {
+				So(s, ShouldEqual, "INSERT INTO `t` (`id`, `v`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `v` = VALUES(`v`)")
+			})
+		})
+
+		Convey("When building an INSERT statement whose columns are all keys", func() {
+			s := buildSQLInsert(sqlDialects["sqlite"], `a"b`, columns, []string{"id", "v"})
+
+			Convey("Then it should do nothing on conflicts", func() {
+				So(s, ShouldEqual, `INSERT INTO "a""b" ("id", "v") VALUES (?, ?) ON CONFLICT ("id", "v") DO NOTHING`)
+			})
+		})
+	})
+}
+
+// testFlakySQLDriver is a SQLite driver whose connections can be made
+// unavailable to simulate a database down.
+type testFlakySQLDriver struct {
+	sqlite3.SQLiteDriver
+	down int32
+}
+
+func (d *testFlakySQLDriver) isDown() bool {
+	return atomic.LoadInt32(&d.down) != 0
+}
+
+func (d *testFlakySQLDriver) setDown(down bool) {
+	v := int32(0)
+	if down {
+		v = 1
+	}
+	atomic.StoreInt32(&d.down, v)
+}
+
+func (d *testFlakySQLDriver) Open(dsn string) (driver.Conn, error) {
+	if d.isDown() {
+		return nil, errors.New("connection refused")
+	}
+	c, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &testFlakySQLConn{Conn: c, d: d}, nil
+}
+
+type testFlakySQLConn struct {
+	driver.Conn
+	d *testFlakySQLDriver
+}
+
+func (c *testFlakySQLConn) Prepare(query string) (driver.Stmt, error) {
+	if c.d.isDown() {
+		return nil, driver.ErrBadConn
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *testFlakySQLConn) Begin() (driver.Tx, error) {
+	if c.d.isDown() {
+		return nil, driver.ErrBadConn
+	}
+	return c.Conn.Begin()
+}
+
+func (c *testFlakySQLConn) Ping(ctx context.Context) error {
+	if c.d.isDown() {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+var testFlakySQLite = &testFlakySQLDriver{}
+
+func init() {
+	sql.Register("sqlite3_flaky", testFlakySQLite)
+}
+
+func TestSQLSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a SQLite database", t, func() {
+		dir, err := ioutil.TempDir("", "sql_sink_test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		dsn := filepath.Join(dir, "test.db")
+		db, err := sql.Open("sqlite3", dsn)
+		So(err, ShouldBeNil)
+		Reset(func() {
+			db.Close()
+		})
+		_, err = db.Exec(`CREATE TABLE t (id INTEGER PRIMARY KEY, name TEXT, v REAL, tags TEXT)`)
+		So(err, ShouldBeNil)
+
+		params := data.Map{
+			"driver": data.String("sqlite3"),
+			"dsn":    data.String(dsn),
+			"table":  data.String("t"),
+			"columns": data.Map{
+				"id":   data.String("id"),
+				"name": data.String("info.name"),
+				"v":    data.String("v"),
+				"tags": data.String("tags"),
+			},
+		}
+		tuple := func(id int, name string, v float64) *core.Tuple {
+			return core.NewTuple(data.Map{
+				"id":   data.Int(id),
+				"info": data.Map{"name": data.String(name)},
+				"v":    data.Float(v),
+			})
+		}
+		type row struct {
+			id   int64
+			name sql.NullString
+			v    sql.NullFloat64
+			tags sql.NullString
+		}
+		selectAll := func() []row {
+			rs, err := db.Query(`SELECT id, name, v, tags FROM t ORDER BY id`)
+			So(err, ShouldBeNil)
+			defer rs.Close()
+			res := []row{}
+			for rs.Next() {
+				var r row
+				So(rs.Scan(&r.id, &r.name, &r.v, &r.tags), ShouldBeNil)
+				res = append(res, r)
+			}
+			return res
+		}
+		create := func() core.Sink {
+			s, err := createSQLSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			return s
+		}
+
+		Convey("When creating the sink by a BQL statement", func() {
+			tb, err := NewTopologyBuilder(newTestTopology())
+			So(err, ShouldBeNil)
+			Reset(func() {
+				tb.Topology().Stop()
+			})
+			err = addBQLToTopology(tb, fmt.Sprintf(
+				`CREATE SINK snk TYPE sql WITH driver="sqlite3", dsn=%q, table="t", columns={"id": "id"}`, dsn))
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When writing tuples with default parameters", func() {
+			s := create()
+			So(s.Write(ctx, tuple(1, "a", 0.5)), ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{
+				"id":   data.Int(2),
+				"tags": data.Array{data.String("x")},
+			})), ShouldBeNil)
+
+			Convey("Then they should be buffered until the sink is closed", func() {
+				So(selectAll(), ShouldBeEmpty)
+				So(s.Close(ctx), ShouldBeNil)
+				So(selectAll(), ShouldResemble, []row{
+					{1, sql.NullString{"a", true}, sql.NullFloat64{0.5, true}, sql.NullString{}},
+					{2, sql.NullString{}, sql.NullFloat64{}, sql.NullString{`["x"]`, true}},
+				})
+			})
+		})
+
+		Convey("When writing tuples in batches", func() {
+			params["batch_size"] = data.Int(2)
+			params["flush_interval"] = data.Float(0.05)
+			s := create()
+			for i := 1; i <= 3; i++ {
+				So(s.Write(ctx, tuple(i, "a", 1)), ShouldBeNil)
+			}
+
+			Convey("Then a full batch should be inserted immediately", func() {
+				So(len(selectAll()), ShouldBeGreaterThanOrEqualTo, 2)
+			})
+
+			Convey("Then the rest should be inserted after the flush interval", func() {
+				deadline := time.Now().Add(time.Second)
+				for len(selectAll()) < 3 && time.Now().Before(deadline) {
+					time.Sleep(10 * time.Millisecond)
+				}
+				So(selectAll(), ShouldHaveLength, 3)
+			})
+		})
+
+		Convey("When a batch fails", func() {
+			params["batch_size"] = data.Int(2)
+			s := create()
+			So(s.Write(ctx, tuple(1, "a", 1)), ShouldBeNil)
+			err := s.Write(ctx, tuple(1, "b", 2))
+
+			Convey("Then the whole batch should be rolled back", func() {
+				So(err, ShouldNotBeNil)
+				So(selectAll(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the database is down", func() {
+			params["driver"] = data.String("sqlite3_flaky")
+			params["batch_size"] = data.Int(2)
+			params["flush_interval"] = data.Float(10)
+			s := create()
+			Reset(func() {
+				testFlakySQLite.setDown(false)
+			})
+			So(s.Write(ctx, tuple(1, "a", 1)), ShouldBeNil)
+			testFlakySQLite.setDown(true)
+			err := s.Write(ctx, tuple(2, "a", 1))
+
+			Convey("Then it should return a temporary error and keep the batch", func() {
+				So(core.IsTemporaryError(err), ShouldBeTrue)
+				So(selectAll(), ShouldBeEmpty)
+
+				testFlakySQLite.setDown(false)
+				So(s.Write(ctx, tuple(3, "a", 1)), ShouldBeNil)
+				So(selectAll(), ShouldHaveLength, 2)
+				So(s.Close(ctx), ShouldBeNil)
+				So(selectAll(), ShouldHaveLength, 3)
+			})
+		})
+
+		Convey("When the database keeps being down for many tuples", func() {
+			params["driver"] = data.String("sqlite3_flaky")
+			params["batch_size"] = data.Int(1)
+			s := create()
+			Reset(func() {
+				testFlakySQLite.setDown(false)
+			})
+			testFlakySQLite.setDown(true)
+			for i := 1; i <= 15; i++ {
+				So(core.IsTemporaryError(s.Write(ctx, tuple(i, "a", 1))), ShouldBeTrue)
+			}
+			testFlakySQLite.setDown(false)
+			So(s.Close(ctx), ShouldBeNil)
+
+			Convey("Then only the latest tuples should be inserted", func() {
+				rows := selectAll()
+				So(rows, ShouldHaveLength, 10)
+				So(rows[0].id, ShouldEqual, 6)
+			})
+		})
+
+		Convey("When writing tuples having the same upsert key", func() {
+			params["batch_size"] = data.Int(1)
+			params["upsert_key"] = data.String("id")
+			s := create()
+			So(s.Write(ctx, tuple(1, "a", 1)), ShouldBeNil)
+			So(s.Write(ctx, tuple(1, "b", 2)), ShouldBeNil)
+
+			Convey("Then the existing row should be updated", func() {
+				So(selectAll(), ShouldResemble, []row{
+					{1, sql.NullString{"b", true}, sql.NullFloat64{2, true}, sql.NullString{}},
+				})
+			})
+		})
+
+		Convey("When writing a tuple after the sink is closed", func() {
+			s := create()
+			So(s.Close(ctx), ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(s.Write(ctx, tuple(1, "a", 1)), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating the sink with invalid parameters", func() {
+			cases := []struct {
+				title string
+				key   string
+				value data.Value
+			}{
+				{"unknown driver", "driver", data.String("no_such_driver")},
+				{"unknown dialect", "dialect", data.String("oracle")},
+				{"empty columns", "columns", data.Map{}},
+				{"invalid column path", "columns", data.Map{"id": data.String("/this/isnt/a/xpath")}},
+				{"upsert_key not in columns", "upsert_key", data.String("no_such_column")},
+				{"non-string upsert_key", "upsert_key", data.Int(1)},
+				{"zero batch_size", "batch_size", data.Int(0)},
+			}
+			for _, c := range cases {
+				c := c
+				Convey("Then "+c.title+" should result in an error", func() {
+					params[c.key] = c.value
+					_, err := createSQLSink(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+
+			Convey("Then upsert_key with the generic dialect should result in an error", func() {
+				params["dialect"] = data.String("generic")
+				params["upsert_key"] = data.String("id")
+				_, err := createSQLSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			for _, k := range []string{"driver", "dsn", "table", "columns"} {
+				k := k
+				Convey("Then missing "+k+" should result in an error", func() {
+					delete(params, k)
+					_, err := createSQLSink(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,270 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// syslogMaxFrameSize is the maximum size of a message framed by octet
+// counting. It prevents a broken length from allocating a huge buffer.
+const syslogMaxFrameSize = 1024 * 1024
+
+// syslogFormat reads RFC 5424 syslog messages. Messages are separated by
+// newlines or framed by octet counting described in RFC 6587. A message is
+// decoded into a record having following fields:
+//
+//	facility: the facility as an int
+//	severity: the severity as an int
+//	version: the version as an int
+//	timestamp: the timestamp
+//	hostname, app_name, proc_id, msg_id: strings in the header
+//	structured_data: a map from an SD-ID to a map of its parameters
+//	message: the message
+//
+// Fields having the nil value "-" in the message are omitted. The format
+// doesn't support encoding.
+type syslogFormat struct{}
+
+type syslogDecoder struct {
+	lineReader
+}
+
+func (d *syslogDecoder) Decode() (data.Map, error) {
+	for {
+		msg, err := d.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if len(msg) == 0 {
+			continue
+		}
+		m, err := parseSyslogMessage(msg)
+		if err != nil {
+			return nil, &RecordError{Err: err, Record: string(msg)}
+		}
+		return m, nil
+	}
+}
+
+// readFrame reads a message framed by octet counting when the input starts
+// with a digit, and reads a line otherwise.
+func (d *syslogDecoder) readFrame() ([]byte, error) {
+	b, err := d.r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if b[0] < '1' || b[0] > '9' {
+		line, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		return bytes.TrimSpace(line), nil
+	}
+
+	// Because the stream can't be resynchronized after a broken frame, errors
+	// in framing are fatal.
+	l, err := d.r.ReadString(' ')
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	n, err := strconv.Atoi(l[:len(l)-1])
+	if err != nil || n > syslogMaxFrameSize {
+		return nil, fmt.Errorf("invalid length of a syslog frame: %v", l)
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(d.r, msg); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+// syslogParser parses a syslog message.
+type syslogParser struct {
+	b   []byte
+	pos int
+}
+
+func parseSyslogMessage(b []byte) (data.Map, error) {
+	p := &syslogParser{b: b}
+	m := data.Map{}
+
+	pri, err := p.parsePriority()
+	if err != nil {
+		return nil, err
+	}
+	m["facility"] = data.Int(pri / 8)
+	m["severity"] = data.Int(pri % 8)
+
+	version, err := strconv.Atoi(p.header())
+	if err != nil || version <= 0 {
+		return nil, errors.New("invalid version")
+	}
+	m["version"] = data.Int(version)
+
+	if s := p.header(); s != "-" {
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %v", s)
+		}
+		m["timestamp"] = data.Timestamp(ts)
+	}
+	for _, f := range []string{"hostname", "app_name", "proc_id", "msg_id"} {
+		s := p.header()
+		if s == "" {
+			return nil, fmt.Errorf("%v is missing", f)
+		}
+		if s != "-" {
+			m[f] = data.String(s)
+		}
+	}
+
+	sd, err := p.parseStructuredData()
+	if err != nil {
+		return nil, err
+	}
+	if sd != nil {
+		m["structured_data"] = sd
+	}
+
+	if p.pos < len(p.b) {
+		if p.b[p.pos] != ' ' {
+			return nil, errors.New("structured data must be followed by a space")
+		}
+		msg := bytes.TrimPrefix(p.b[p.pos+1:], []byte("\xef\xbb\xbf"))
+		m["message"] = data.String(msg)
+	}
+	return m, nil
+}
+
+func (p *syslogParser) parsePriority() (int, error) {
+	end := bytes.IndexByte(p.b, '>')
+	if len(p.b) == 0 || p.b[0] != '<' || end < 2 || end > 4 {
+		return 0, errors.New("invalid priority")
+	}
+	pri, err := strconv.Atoi(string(p.b[1:end]))
+	if err != nil || pri < 0 || pri > 191 {
+		return 0, errors.New("invalid priority")
+	}
+	p.pos = end + 1
+	return pri, nil
+}
+
+// header returns the next field separated by a space. It returns an empty
+// string when there's no more field.
+func (p *syslogParser) header() string {
+	rest := p.b[p.pos:]
+	i := bytes.IndexByte(rest, ' ')
+	if i < 0 {
+		p.pos = len(p.b)
+		return string(rest)
+	}
+	p.pos += i + 1
+	return string(rest[:i])
+}
+
+// parseStructuredData parses structured data. It returns nil when the
+// message doesn't have it.
+func (p *syslogParser) parseStructuredData() (data.Map, error) {
+	if p.pos >= len(p.b) {
+		return nil, errors.New("structured data is missing")
+	}
+	if p.b[p.pos] == '-' {
+		p.pos++
+		return nil, nil
+	}
+
+	sd := data.Map{}
+	for p.pos < len(p.b) && p.b[p.pos] == '[' {
+		p.pos++
+		id := p.name(" ]")
+		if id == "" {
+			return nil, errors.New("SD-ID is missing")
+		}
+		params := data.Map{}
+		for {
+			if p.pos >= len(p.b) {
+				return nil, errors.New("structured data isn't closed")
+			}
+			if p.b[p.pos] == ']' {
+				p.pos++
+				break
+			}
+			if p.b[p.pos] != ' ' {
+				return nil, errors.New("invalid structured data")
+			}
+			p.pos++
+			name := p.name("=")
+			if name == "" || p.pos+1 >= len(p.b) || p.b[p.pos] != '=' || p.b[p.pos+1] != '"' {
+				return nil, fmt.Errorf("invalid parameter in '%v'", id)
+			}
+			p.pos += 2
+			v, err := p.paramValue()
+			if err != nil {
+				return nil, err
+			}
+			params[name] = data.String(v)
+		}
+		sd[id] = params
+	}
+	if len(sd) == 0 {
+		return nil, errors.New("invalid structured data")
+	}
+	return sd, nil
+}
+
+// name returns bytes until one of the delimiters.
+func (p *syslogParser) name(delims string) string {
+	start := p.pos
+	for p.pos < len(p.b) && bytes.IndexByte([]byte(delims), p.b[p.pos]) < 0 {
+		p.pos++
+	}
+	return string(p.b[start:p.pos])
+}
+
+// paramValue returns an unescaped parameter value and skips the closing
+// quote.
+func (p *syslogParser) paramValue() (string, error) {
+	var buf []byte
+	for p.pos < len(p.b) {
+		c := p.b[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return string(buf), nil
+		case '\\':
+			if p.pos < len(p.b) {
+				if n := p.b[p.pos]; n == '"' || n == '\\' || n == ']' {
+					c = n
+					p.pos++
+				}
+			}
+		}
+		buf = append(buf, c)
+	}
+	return "", errors.New("a parameter value isn't closed")
+}
+
+func (syslogFormat) NewDecoder(r io.Reader, params data.Map) (RecordDecoder, error) {
+	return &syslogDecoder{lineReader{bufio.NewReader(r)}}, nil
+}
+
+func (syslogFormat) NewEncoder(w io.Writer, params data.Map) (RecordEncoder, error) {
+	return nil, errors.New("the syslog format doesn't support encoding")
+}
+
+func init() {
+	MustRegisterGlobalRecordFormat("syslog", syslogFormat{})
+}
@@ -0,0 +1,108 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestSyslogFormat(t *testing.T) {
+	Convey("Given the syslog format", t, func() {
+		f, err := LookupGlobalRecordFormat("syslog")
+		So(err, ShouldBeNil)
+
+		Convey("When decoding a message having all fields", func() {
+			ms, errs, err := decodeAllRecords(f,
+				`<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog 1234 ID47 `+
+					`[exampleSDID@32473 iut="3" eventSource="App\"lication"][examplePriority@32473 class="high"] `+
+					"\xef\xbb\xbfAn application event log entry...\n", nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then it should be decoded", func() {
+				So(ms, ShouldResemble, []data.Map{{
+					"facility":  data.Int(20),
+					"severity":  data.Int(5),
+					"version":   data.Int(1),
+					"timestamp": data.Timestamp(time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC)),
+					"hostname":  data.String("mymachine.example.com"),
+					"app_name":  data.String("evntslog"),
+					"proc_id":   data.String("1234"),
+					"msg_id":    data.String("ID47"),
+					"structured_data": data.Map{
+						"exampleSDID@32473": data.Map{
+							"iut":         data.String("3"),
+							"eventSource": data.String(`App"lication`),
+						},
+						"examplePriority@32473": data.Map{
+							"class": data.String("high"),
+						},
+					},
+					"message": data.String("An application event log entry..."),
+				}})
+			})
+		})
+
+		Convey("When decoding a message having nil values", func() {
+			ms, errs, err := decodeAllRecords(f, "<34>1 - - - - - -\n", nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then they should be omitted", func() {
+				So(ms, ShouldResemble, []data.Map{{
+					"facility": data.Int(4),
+					"severity": data.Int(2),
+					"version":  data.Int(1),
+				}})
+			})
+		})
+
+		Convey("When decoding messages framed by octet counting", func() {
+			ms, errs, err := decodeAllRecords(f, "21 <34>1 - h - - - - a\nb19 <34>1 - h - - - - c", nil)
+			So(err, ShouldBeNil)
+			So(errs, ShouldBeEmpty)
+
+			Convey("Then messages can have newlines", func() {
+				So(ms, ShouldHaveLength, 2)
+				So(ms[0]["message"], ShouldEqual, data.String("a\nb"))
+				So(ms[1]["message"], ShouldEqual, data.String("c"))
+			})
+		})
+
+		Convey("When decoding a broken frame", func() {
+			_, _, err := decodeAllRecords(f, "30 <34>1 - h - - - - a", nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decoding malformed messages", func() {
+			ms, errs, err := decodeAllRecords(f, "<34>1 - - - - - - ok\n"+
+				"no priority\n"+
+				"<192>1 - - - - - -\n"+
+				"<34>0 - - - - - -\n"+
+				"<34>1 yesterday - - - - -\n"+
+				"<34>1 - - - -\n"+
+				"<34>1 - - - - - [id a=\"b\"\n"+
+				"<34>1 - - - - - [id a=b]\n"+
+				"<34>1 - - - - - -x\n", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should be reported and skipped", func() {
+				So(ms, ShouldHaveLength, 1)
+				So(errs, ShouldHaveLength, 8)
+			})
+		})
+
+		Convey("When creating an encoder", func() {
+			_, err := f.NewEncoder(nil, nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
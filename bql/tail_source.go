@@ -0,0 +1,548 @@
+package bql
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// tailFingerprintSize is the maximum number of bytes at the head of a file
+// used to identify the file in a checkpoint.
+const tailFingerprintSize = 256
+
+// completeLineReader reads complete lines from a file which is being
+// appended. It returns io.EOF when the file doesn't have a complete line to
+// read yet, and it can continue reading after more data is appended.
+//
+// Read returns at most one line at a time so that a decoder doesn't buffer
+// lines which haven't been decoded. This keeps offset at the end of the
+// last decoded record.
+type completeLineReader struct {
+	f *os.File
+
+	// buf has complete lines which have been read from f but haven't been
+	// returned from Read yet.
+	buf []byte
+
+	// partial is the last line which doesn't end with a newline yet.
+	partial []byte
+
+	// offset is the offset of the data returned from Read.
+	offset int64
+}
+
+func (r *completeLineReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if err := r.fill(); err != nil {
+			return 0, err
+		}
+	}
+	line := r.buf
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i+1]
+	}
+	n := copy(p, line)
+	r.buf = r.buf[n:]
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *completeLineReader) fill() error {
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := r.f.Read(chunk)
+		r.partial = append(r.partial, chunk[:n]...)
+		if i := bytes.LastIndexByte(r.partial, '\n'); i >= 0 {
+			r.buf = r.partial[:i+1]
+			r.partial = append([]byte{}, r.partial[i+1:]...)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return io.EOF
+		}
+	}
+}
+
+// flushPartial makes the last line without a newline readable. It's called
+// when the file won't be appended anymore.
+func (r *completeLineReader) flushPartial() {
+	if len(r.partial) == 0 {
+		return
+	}
+	r.buf = append(r.buf, r.partial...)
+	r.buf = append(r.buf, '\n')
+	r.partial = nil
+}
+
+// readOffset returns the offset of the data read from the file.
+func (r *completeLineReader) readOffset() int64 {
+	return r.offset + int64(len(r.buf)+len(r.partial))
+}
+
+// tailedFile is a file followed by tailSource.
+type tailedFile struct {
+	path string
+	f    *os.File
+	info os.FileInfo
+	r    *completeLineReader
+	dec  RecordDecoder
+
+	// committed is the offset of the end of the records which have been
+	// written. It's saved in a checkpoint.
+	committed int64
+
+	// recordNumber is the number of records read from the file. It's only
+	// used for log messages.
+	recordNumber int64
+}
+
+// fingerprint computes the hash of the head of the file which has already
+// been written.
+func (tf *tailedFile) fingerprint() (int, string, error) {
+	size := tf.committed
+	if size > tailFingerprintSize {
+		size = tailFingerprintSize
+	}
+	b := make([]byte, size)
+	if _, err := tf.f.ReadAt(b, 0); err != nil {
+		return 0, "", err
+	}
+	h := sha1.Sum(b)
+	return int(size), hex.EncodeToString(h[:]), nil
+}
+
+// tailCheckpoint has the offsets of files read by tailSource. A file is
+// identified by the hash of its head because its path can be changed by
+// rotation.
+type tailCheckpoint struct {
+	Files []*tailCheckpointEntry `json:"files"`
+}
+
+type tailCheckpointEntry struct {
+	Path            string `json:"path"`
+	Offset          int64  `json:"offset"`
+	FingerprintSize int    `json:"fingerprint_size"`
+	Fingerprint     string `json:"fingerprint"`
+}
+
+// match returns true when the head of the file has the same fingerprint as
+// the entry.
+func (e *tailCheckpointEntry) match(f *os.File, info os.FileInfo) bool {
+	if info.Size() < e.Offset {
+		return false
+	}
+	b := make([]byte, e.FingerprintSize)
+	if _, err := f.ReadAt(b, 0); err != nil {
+		return false
+	}
+	h := sha1.Sum(b)
+	return hex.EncodeToString(h[:]) == e.Fingerprint
+}
+
+// tailSource follows files matching a path or a glob pattern like
+// `tail -F`. It polls files periodically and handles rotation, which renames
+// or removes a file and creates a new one, and truncation. It reads only
+// complete lines, so the format must be line-oriented such as jsonl, raw,
+// csv, or tsv.
+//
+// When the checkpoint parameter is given, the offsets of files are saved to
+// the checkpoint file after every poll and when the source stops. The source
+// resumes reading files from the offsets after restart. Note that formats
+// having a header like csv don't read the header again when resumed.
+type tailSource struct {
+	pattern  string
+	tsField  data.Path
+	ioParams *IOParams
+
+	formatName string
+	format     RecordFormat
+	params     data.Map
+
+	pollInterval   time.Duration
+	fromBeginning  bool
+	checkpointPath string
+
+	files map[string]*tailedFile
+
+	// checkpoint has entries loaded from the checkpoint file which haven't
+	// been matched with files yet.
+	checkpoint []*tailCheckpointEntry
+
+	stopCh chan struct{}
+}
+
+func (s *tailSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	s.files = map[string]*tailedFile{}
+	defer s.closeFiles(ctx)
+
+	hasCheckpoint, err := s.loadCheckpoint()
+	if err != nil {
+		return err
+	}
+
+	// Files existing before the source starts are read from the end unless
+	// from_beginning is true or the source resumes from a checkpoint.
+	first := !s.fromBeginning && !hasCheckpoint
+	for {
+		if err := s.poll(ctx, w, first); err != nil {
+			if err == core.ErrSourceStopped {
+				if err := s.saveCheckpoint(); err != nil {
+					return err
+				}
+			}
+			return err
+		}
+		first = false
+		if err := s.saveCheckpoint(); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("checkpoint", s.checkpointPath).
+				Warning("Cannot save the checkpoint")
+		}
+
+		select {
+		case <-s.stopCh:
+			return core.ErrSourceStopped
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s *tailSource) poll(ctx *core.Context, w core.Writer, first bool) error {
+	paths, err := filepath.Glob(s.pattern)
+	if err != nil {
+		return err
+	}
+	infos := map[string]os.FileInfo{}
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil || info.IsDir() {
+			// The file might have been removed after Glob.
+			continue
+		}
+		infos[p] = info
+	}
+
+	// Follow files by their identities rather than paths so that renamed
+	// files aren't read again from the beginning.
+	files := map[string]*tailedFile{}
+	var removed []*tailedFile
+	for _, tf := range s.files {
+		found := false
+		for p, info := range infos {
+			if _, ok := files[p]; !ok && os.SameFile(tf.info, info) {
+				tf.path = p
+				files[p] = tf
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, tf)
+		}
+	}
+
+	s.files = files
+
+	// Remaining data in removed or rotated files is read before new files.
+	for _, tf := range removed {
+		tf.r.flushPartial()
+		err := s.readFile(ctx, w, tf)
+		s.closeFile(ctx, tf)
+		if err != nil {
+			return err
+		}
+	}
+
+	for p, info := range infos {
+		if tf, ok := files[p]; ok {
+			if info.Size() < tf.r.readOffset() {
+				// The file was truncated.
+				if err := s.reset(tf, 0); err != nil {
+					return err
+				}
+			}
+			tf.info = info
+			continue
+		}
+		if err := s.openFile(ctx, p, info, first); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("path", p).Warning("Cannot open the file")
+		}
+	}
+
+	// Read older files first.
+	sorted := make([]*tailedFile, 0, len(s.files))
+	for _, tf := range s.files {
+		sorted = append(sorted, tf)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if mi, mj := sorted[i].info.ModTime(), sorted[j].info.ModTime(); !mi.Equal(mj) {
+			return mi.Before(mj)
+		}
+		return sorted[i].path < sorted[j].path
+	})
+	for _, tf := range sorted {
+		if err := s.readFile(ctx, w, tf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *tailSource) openFile(ctx *core.Context, path string, info os.FileInfo, first bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	tf := &tailedFile{
+		path: path,
+		f:    f,
+		info: info,
+	}
+
+	var offset int64
+	if e := s.takeCheckpointEntry(path, f, info); e != nil {
+		offset = e.Offset
+	} else if first {
+		offset = info.Size()
+	}
+	if err := s.reset(tf, offset); err != nil {
+		f.Close()
+		return err
+	}
+	s.files[path] = tf
+	return nil
+}
+
+// takeCheckpointEntry returns a checkpoint entry of the file and removes it
+// from the source. An entry having the same path is preferred.
+func (s *tailSource) takeCheckpointEntry(path string, f *os.File, info os.FileInfo) *tailCheckpointEntry {
+	idx := -1
+	for i, e := range s.checkpoint {
+		if !e.match(f, info) {
+			continue
+		}
+		if idx < 0 || e.Path == path {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+	e := s.checkpoint[idx]
+	s.checkpoint = append(s.checkpoint[:idx], s.checkpoint[idx+1:]...)
+	return e
+}
+
+// reset starts reading the file from the offset with a new decoder.
+func (s *tailSource) reset(tf *tailedFile, offset int64) error {
+	if _, err := tf.f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	tf.r = &completeLineReader{
+		f:      tf.f,
+		offset: offset,
+	}
+	tf.committed = offset
+	dec, err := s.format.NewDecoder(tf.r, s.params)
+	if err != nil {
+		return err
+	}
+	tf.dec = dec
+	return nil
+}
+
+// readFile writes all records that can be read from the file.
+func (s *tailSource) readFile(ctx *core.Context, w core.Writer, tf *tailedFile) error {
+	for {
+		m, err := tf.dec.Decode()
+		if err != nil {
+			if err == io.EOF {
+				tf.committed = tf.r.offset
+				return nil
+			}
+			if e, ok := err.(*RecordError); ok {
+				tf.committed = tf.r.offset
+				ctx.ErrLog(e.Err).WithField("node_name", s.ioParams.Name).
+					WithField("format", s.formatName).
+					WithField("path", tf.path).
+					WithField("record_number", tf.recordNumber).
+					WithField("body", e.Record).Warning("Ignoring the record due to a parse error")
+				tf.recordNumber++
+				continue
+			}
+			return err
+		}
+
+		t := core.NewTuple(m)
+		if s.tsField != nil {
+			if v, err := t.Data.Get(s.tsField); err == nil {
+				if ts, err := data.ToTimestamp(v); err != nil {
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						WithField("path", tf.path).
+						WithField("record_number", tf.recordNumber).
+						WithField("timestamp_field", s.tsField).
+						WithField("timestamp_field_value", v).
+						Warning("Cannot convert a value in timestamp_field to a timestamp")
+				} else {
+					t.Timestamp = ts
+				}
+			}
+		}
+		tf.recordNumber++
+
+		if err := w.Write(ctx, t); err != nil {
+			return err
+		}
+		tf.committed = tf.r.offset
+	}
+}
+
+func (s *tailSource) closeFile(ctx *core.Context, tf *tailedFile) {
+	if err := tf.f.Close(); err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("path", tf.path).Warning("Cannot close the file")
+	}
+}
+
+func (s *tailSource) closeFiles(ctx *core.Context) {
+	for _, tf := range s.files {
+		s.closeFile(ctx, tf)
+	}
+	s.files = nil
+}
+
+// loadCheckpoint loads the checkpoint file. It returns false when the
+// checkpoint file doesn't exist.
+func (s *tailSource) loadCheckpoint() (bool, error) {
+	s.checkpoint = nil
+	if s.checkpointPath == "" {
+		return false, nil
+	}
+	b, err := ioutil.ReadFile(s.checkpointPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	c := &tailCheckpoint{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return false, fmt.Errorf("the checkpoint file is broken: %v", err)
+	}
+	s.checkpoint = c.Files
+	return true, nil
+}
+
+// saveCheckpoint writes the offsets of files to the checkpoint file. The
+// offset of a file is the end of the records which have been written.
+func (s *tailSource) saveCheckpoint() error {
+	if s.checkpointPath == "" {
+		return nil
+	}
+	c := &tailCheckpoint{
+		Files: []*tailCheckpointEntry{},
+	}
+	for _, tf := range s.files {
+		size, fp, err := tf.fingerprint()
+		if err != nil {
+			return err
+		}
+		c.Files = append(c.Files, &tailCheckpointEntry{
+			Path:            tf.path,
+			Offset:          tf.committed,
+			FingerprintSize: size,
+			Fingerprint:     fp,
+		})
+	}
+	sort.Slice(c.Files, func(i, j int) bool {
+		return c.Files[i].Path < c.Files[j].Path
+	})
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	// Write the checkpoint to a temporary file and rename it so that the
+	// checkpoint file doesn't get broken when the process crashes.
+	tmp := s.checkpointPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.checkpointPath)
+}
+
+func (s *tailSource) Stop(ctx *core.Context) error {
+	close(s.stopCh)
+	return nil
+}
+
+func createTailSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		Path           string `bql:",required"`
+		TimestampField string
+		PollInterval   time.Duration
+		FromBeginning  bool
+		Checkpoint     string
+	}{
+		PollInterval: time.Second,
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+	if _, err := filepath.Match(v.Path, ""); err != nil {
+		return nil, fmt.Errorf("'path' parameter has an invalid pattern: %v", err)
+	}
+	if v.PollInterval <= 0 {
+		return nil, fmt.Errorf("'poll_interval' parameter must be positive: %v", v.PollInterval)
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		var err error
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+
+	formatName, format, err := lookupRecordFormatParam(params)
+	if err != nil {
+		return nil, err
+	}
+	// validate format parameters before the source starts
+	if _, err := format.NewDecoder(bytes.NewReader(nil), params); err != nil {
+		return nil, err
+	}
+
+	return core.ImplementSourceStop(&tailSource{
+		pattern:        v.Path,
+		tsField:        tsField,
+		ioParams:       ioParams,
+		formatName:     formatName,
+		format:         format,
+		params:         params,
+		pollInterval:   v.PollInterval,
+		fromBeginning:  v.FromBeginning,
+		checkpointPath: v.Checkpoint,
+		stopCh:         make(chan struct{}),
+	}), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("tail", SourceCreatorFunc(createTailSource))
+}
@@ -0,0 +1,208 @@
+package bql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+type tupleCollector struct {
+	m  sync.Mutex
+	ts []*core.Tuple
+}
+
+func (c *tupleCollector) Write(ctx *core.Context, t *core.Tuple) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.ts = append(c.ts, t)
+	return nil
+}
+
+// ints returns the values of the field "i" of collected tuples after waiting
+// until the collector has n tuples or one second passes.
+func (c *tupleCollector) ints(n int) []int64 {
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.m.Lock()
+		l := len(c.ts)
+		c.m.Unlock()
+		if l >= n || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	res := []int64{}
+	for _, t := range c.ts {
+		i, _ := data.AsInt(t.Data["i"])
+		res = append(res, i)
+	}
+	return res
+}
+
+func appendToFile(path string, format string, args ...interface{}) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	So(err, ShouldBeNil)
+	defer f.Close()
+	_, err = fmt.Fprintf(f, format, args...)
+	So(err, ShouldBeNil)
+}
+
+func TestTailSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a temp directory having a log file", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_tail_source")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+		fn := filepath.Join(tdir, "app.log")
+		appendToFile(fn, "{\"i\":1}\n{\"i\":2}\n")
+
+		params := data.Map{
+			"path":          data.String(fn),
+			"poll_interval": data.Float(0.01),
+		}
+		c := &tupleCollector{}
+		start := func() func() {
+			s, err := createTailSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, c)
+			}()
+			return func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			}
+		}
+
+		Convey("When tailing the file", func() {
+			stop := start()
+			Reset(stop)
+			// wait until the source opens the file
+			time.Sleep(50 * time.Millisecond)
+
+			Convey("Then it should only emit appended records", func() {
+				appendToFile(fn, "{\"i\":3}\n")
+				So(c.ints(1), ShouldResemble, []int64{3})
+			})
+
+			Convey("Then it should wait until a line is completed", func() {
+				appendToFile(fn, "{\"i\":")
+				time.Sleep(50 * time.Millisecond)
+				So(c.ints(0), ShouldBeEmpty)
+				appendToFile(fn, "3}\n")
+				So(c.ints(1), ShouldResemble, []int64{3})
+			})
+
+			Convey("Then it should follow the rotated file", func() {
+				appendToFile(fn, "{\"i\":3}\n")
+				So(c.ints(1), ShouldResemble, []int64{3})
+
+				So(os.Rename(fn, fn+".1"), ShouldBeNil)
+				appendToFile(fn+".1", "{\"i\":4}\n")
+				appendToFile(fn, "{\"i\":5}\n")
+				So(c.ints(3), ShouldResemble, []int64{3, 4, 5})
+			})
+
+			Convey("Then it should read the truncated file from the beginning", func() {
+				So(os.Truncate(fn, 0), ShouldBeNil)
+				appendToFile(fn, "{\"i\":3}\n")
+				So(c.ints(1), ShouldResemble, []int64{3})
+			})
+		})
+
+		Convey("When tailing the file from the beginning", func() {
+			params["from_beginning"] = data.True
+			stop := start()
+			Reset(stop)
+
+			Convey("Then it should emit existing records", func() {
+				So(c.ints(2), ShouldResemble, []int64{1, 2})
+			})
+		})
+
+		Convey("When tailing files matching a glob pattern", func() {
+			params["path"] = data.String(filepath.Join(tdir, "*.log"))
+			stop := start()
+			Reset(stop)
+			time.Sleep(50 * time.Millisecond)
+
+			Convey("Then it should read a new file from the beginning", func() {
+				appendToFile(filepath.Join(tdir, "other.log"), "{\"i\":3}\n{\"i\":4}\n")
+				So(c.ints(2), ShouldResemble, []int64{3, 4})
+			})
+
+			Convey("Then it should ignore files not matching the pattern", func() {
+				appendToFile(filepath.Join(tdir, "other.txt"), "{\"i\":3}\n")
+				appendToFile(fn, "{\"i\":4}\n")
+				So(c.ints(2), ShouldResemble, []int64{4})
+			})
+		})
+
+		Convey("When tailing the file with a checkpoint", func() {
+			params["checkpoint"] = data.String(filepath.Join(tdir, "checkpoint.json"))
+			params["from_beginning"] = data.True
+			stop := start()
+			So(c.ints(2), ShouldResemble, []int64{1, 2})
+			stop()
+
+			Convey("Then it should resume from the checkpoint after restart", func() {
+				appendToFile(fn, "{\"i\":3}\n")
+				stop := start()
+				Reset(stop)
+				So(c.ints(3), ShouldResemble, []int64{1, 2, 3})
+			})
+
+			Convey("Then it should read a file rotated during the stop", func() {
+				appendToFile(fn, "{\"i\":3}\n")
+				So(os.Rename(fn, fn+".1"), ShouldBeNil)
+				// files are read in the order of their modification times
+				time.Sleep(10 * time.Millisecond)
+				appendToFile(fn, "{\"i\":4}\n")
+				params["path"] = data.String(fn + "*")
+				stop := start()
+				Reset(stop)
+				So(c.ints(4), ShouldResemble, []int64{1, 2, 3, 4})
+			})
+		})
+
+		Convey("When creating a tail source with invalid parameters", func() {
+			Convey("Then missing path parameter should result in an error", func() {
+				delete(params, "path")
+				_, err := createTailSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid glob pattern should result in an error", func() {
+				params["path"] = data.String("[")
+				_, err := createTailSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then a non-positive poll_interval should result in an error", func() {
+				params["poll_interval"] = data.Int(0)
+				_, err := createTailSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then unknown format should result in an error", func() {
+				params["format"] = data.String("no_such_format")
+				_, err := createTailSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
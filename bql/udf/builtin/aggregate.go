@@ -560,3 +560,600 @@ var sumFunc udf.UDF = &singleParamAggFunc{
 }
 
 // skipping xmlagg here since we have no XML data type
+
+// collectFloats returns the non-null numeric values of the given
+// array as float64 values. Non-numeric values lead to an error.
+func collectFloats(arr []data.Value) ([]float64, error) {
+	floatVals := make([]float64, 0, len(arr))
+	for _, item := range arr {
+		if item.Type() == data.TypeInt {
+			i, _ := data.AsInt(item)
+			floatVals = append(floatVals, float64(i))
+		} else if item.Type() == data.TypeFloat {
+			f, _ := data.AsFloat(item)
+			floatVals = append(floatVals, f)
+		} else if item.Type() == data.TypeNull {
+			continue
+		} else {
+			return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				item, item)
+		}
+	}
+	return floatVals, nil
+}
+
+// collectFloatPairs returns the pairs of numeric values from the
+// given arrays where neither value is null. Non-numeric values and
+// arrays of different length lead to an error.
+func collectFloatPairs(ys []data.Value, xs []data.Value) ([]float64, []float64, error) {
+	if len(ys) != len(xs) {
+		return nil, nil, fmt.Errorf("inputs must have same length (%d != %d)",
+			len(ys), len(xs))
+	}
+	yVals := make([]float64, 0, len(ys))
+	xVals := make([]float64, 0, len(xs))
+	for idx, y := range ys {
+		x := xs[idx]
+		if y.Type() == data.TypeNull || x.Type() == data.TypeNull {
+			continue
+		}
+		yf, err := collectFloats([]data.Value{y})
+		if err != nil {
+			return nil, nil, err
+		}
+		xf, err := collectFloats([]data.Value{x})
+		if err != nil {
+			return nil, nil, err
+		}
+		yVals = append(yVals, yf[0])
+		xVals = append(xVals, xf[0])
+	}
+	return yVals, xVals, nil
+}
+
+// meanAndSumOfSquares computes the mean of the given values and the
+// sum of the squared differences from that mean.
+func meanAndSumOfSquares(vals []float64) (float64, float64) {
+	mean := float64(0.0)
+	for _, v := range vals {
+		mean += v
+	}
+	mean /= float64(len(vals))
+	sq := float64(0.0)
+	for _, v := range vals {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, sq
+}
+
+// varianceAggFunc returns an aggregate function that computes the
+// variance (or its square root, the standard deviation) of all input
+// values. If sample is true, the sample variance (divided by n-1)
+// is computed, otherwise the population variance (divided by n).
+func varianceAggFunc(sample bool, stddev bool) udf.UDF {
+	return &singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
+			}
+			floatVals, err := collectFloats(arr)
+			if err != nil {
+				return nil, err
+			}
+			n := len(floatVals)
+			if sample {
+				n--
+			}
+			if n <= 0 {
+				// only null inputs (or a single input for the sample case)
+				return data.Null{}, nil
+			}
+			_, sq := meanAndSumOfSquares(floatVals)
+			result := sq / float64(n)
+			if stddev {
+				result = math.Sqrt(result)
+			}
+			return data.Float(result), nil
+		},
+	}
+}
+
+// stddevPopFunc is an aggregate function that computes the population
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var stddevPopFunc = varianceAggFunc(false, true)
+
+// stddevSampFunc is an aggregate function that computes the sample
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_samp`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input values)
+var stddevSampFunc = varianceAggFunc(true, true)
+
+// varPopFunc is an aggregate function that computes the population
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var varPopFunc = varianceAggFunc(false, false)
+
+// varSampFunc is an aggregate function that computes the sample
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_samp`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input values)
+var varSampFunc = varianceAggFunc(true, false)
+
+// modeFunc is an aggregate function that returns the most frequent
+// input value. Null values are ignored. If there are multiple values
+// with the same frequency, the one appearing first in the input is
+// returned, so `mode(x ORDER BY x)` returns the smallest one.
+//
+// It can be used in BQL as `mode`.
+//
+//  Input: any (aggregated)
+//  Return Type: same as the most frequent input value (Null on empty input)
+var modeFunc udf.UDF = &singleParamAggFunc{
+	aggFun: func(arr []data.Value) (data.Value, error) {
+		type entry struct {
+			value data.Value
+			count int
+			first int
+		}
+		entries := map[data.HashValue][]*entry{}
+		var best *entry
+		for i, item := range arr {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			h := data.Hash(item)
+			var e *entry
+			for _, c := range entries[h] {
+				if data.Equal(c.value, item) {
+					e = c
+					break
+				}
+			}
+			if e == nil {
+				e = &entry{item, 0, i}
+				entries[h] = append(entries[h], e)
+			}
+			e.count++
+			if best == nil || e.count > best.count ||
+				(e.count == best.count && e.first < best.first) {
+				best = e
+			}
+		}
+		if best == nil {
+			return data.Null{}, nil
+		}
+		return best.value, nil
+	},
+}
+
+type percentileAggFuncTmpl struct {
+	continuous bool
+}
+
+func (f *percentileAggFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *percentileAggFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *percentileAggFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	fracVals, isArray, err := parseFractions(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the non-null values
+	values := make([]data.Value, 0, len(arr))
+	for _, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		if f.continuous && item.Type() != data.TypeInt && item.Type() != data.TypeFloat {
+			return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				item, item)
+		}
+		values = append(values, item)
+	}
+	// the input is expected to be sorted using ORDER BY, we do not
+	// sort it here so that `ORDER BY x DESC` can be used to compute
+	// percentiles from the top
+	if err := checkSorted(values); err != nil {
+		return nil, err
+	}
+
+	results := make(data.Array, len(fracVals))
+	for i, frac := range fracVals {
+		if len(values) == 0 {
+			results[i] = data.Null{}
+		} else if f.continuous {
+			// interpolate linearly between the two closest values
+			pos := frac * float64(len(values)-1)
+			lower, upper := int(math.Floor(pos)), int(math.Ceil(pos))
+			lowerVal, _ := data.ToFloat(values[lower])
+			upperVal, _ := data.ToFloat(values[upper])
+			results[i] = data.Float(lowerVal + (pos-float64(lower))*(upperVal-lowerVal))
+		} else {
+			// take the first value whose position in the input is
+			// greater than or equal to the given fraction
+			idx := int(math.Ceil(frac*float64(len(values)))) - 1
+			if idx < 0 {
+				idx = 0
+			}
+			results[i] = values[idx]
+		}
+	}
+	if isArray {
+		return results, nil
+	}
+	return results[0], nil
+}
+
+// parseFractions converts a fraction argument, which can be a single
+// number or an array of numbers, to a slice of float64 values. The
+// returned boolean is true if the argument was an array.
+func parseFractions(v data.Value) ([]float64, bool, error) {
+	fractions := []data.Value{v}
+	fracArr, isArray := v.(data.Array)
+	if isArray {
+		fractions = fracArr
+	}
+	fracVals := make([]float64, len(fractions))
+	for i, frac := range fractions {
+		if frac.Type() != data.TypeInt && frac.Type() != data.TypeFloat {
+			return nil, false, fmt.Errorf("cannot interpret %s (%T) as a fraction",
+				frac, frac)
+		}
+		fracVals[i], _ = data.ToFloat(frac)
+		if fracVals[i] < 0 || fracVals[i] > 1 || math.IsNaN(fracVals[i]) {
+			return nil, false, fmt.Errorf("fraction %v is not between 0 and 1", fracVals[i])
+		}
+	}
+	return fracVals, isArray, nil
+}
+
+// checkSorted returns an error if the given values are neither in
+// ascending nor in descending order.
+func checkSorted(values []data.Value) error {
+	asc, desc := true, true
+	for i := 1; i < len(values); i++ {
+		if data.Less(values[i], values[i-1]) {
+			asc = false
+		}
+		if data.Less(values[i-1], values[i]) {
+			desc = false
+		}
+	}
+	if !asc && !desc {
+		return fmt.Errorf("input must be sorted, use ORDER BY")
+	}
+	return nil
+}
+
+// percentileContFunc(expr, fraction ORDER BY expr) is an aggregate
+// function that computes the value at the given fraction of the
+// sorted input, interpolating between adjacent values if needed.
+// If fraction is an array, an array with one result per fraction
+// is returned. Null values are ignored, non-numeric values lead to
+// an error. The input must be sorted using ORDER BY.
+//
+// It can be used in BQL as `percentile_cont`.
+//
+//  Input: Int or Float (aggregated), Float or Array of Floats
+//  Return Type: Float or Array of Floats (Null on empty input)
+var percentileContFunc udf.UDF = &percentileAggFuncTmpl{continuous: true}
+
+// percentileDiscFunc(expr, fraction ORDER BY expr) is an aggregate
+// function that returns the first input value whose position in the
+// sorted input is at or after the given fraction. If fraction is an
+// array, an array with one result per fraction is returned. Null
+// values are ignored. The input must be sorted using ORDER BY.
+//
+// It can be used in BQL as `percentile_disc`.
+//
+//  Input: any (aggregated), Float or Array of Floats
+//  Return Type: same as input or Array (Null on empty input)
+var percentileDiscFunc udf.UDF = &percentileAggFuncTmpl{continuous: false}
+
+// corrFunc(y, x) is an aggregate function that computes the
+// correlation coefficient of the input pairs. Pairs where either
+// value is null are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `corr`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if the input has no variance)
+var corrFunc udf.UDF = &twoParamAggFunc{
+	aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+		yVals, xVals, err := collectFloatPairs(ys, xs)
+		if err != nil {
+			return nil, err
+		}
+		if len(yVals) == 0 {
+			return data.Null{}, nil
+		}
+		_, sxx := meanAndSumOfSquares(xVals)
+		_, syy := meanAndSumOfSquares(yVals)
+		if sxx == 0 || syy == 0 {
+			return data.Null{}, nil
+		}
+		return data.Float(sumOfProducts(yVals, xVals) / math.Sqrt(sxx*syy)), nil
+	},
+}
+
+// sumOfProducts computes the sum of the products of the differences
+// of the given values from their respective means.
+func sumOfProducts(yVals []float64, xVals []float64) float64 {
+	meanY, _ := meanAndSumOfSquares(yVals)
+	meanX, _ := meanAndSumOfSquares(xVals)
+	sxy := float64(0.0)
+	for i := range yVals {
+		sxy += (yVals[i] - meanY) * (xVals[i] - meanX)
+	}
+	return sxy
+}
+
+// covarianceAggFunc returns an aggregate function that computes the
+// sample (divided by n-1) or population (divided by n) covariance
+// of the input pairs.
+func covarianceAggFunc(sample bool) udf.UDF {
+	return &twoParamAggFunc{
+		aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+			yVals, xVals, err := collectFloatPairs(ys, xs)
+			if err != nil {
+				return nil, err
+			}
+			n := len(yVals)
+			if sample {
+				n--
+			}
+			if n <= 0 {
+				return data.Null{}, nil
+			}
+			return data.Float(sumOfProducts(yVals, xVals) / float64(n)), nil
+		},
+	}
+}
+
+// covarPopFunc(y, x) is an aggregate function that computes the
+// population covariance of the input pairs. Pairs where either
+// value is null are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_pop`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var covarPopFunc = covarianceAggFunc(false)
+
+// covarSampFunc(y, x) is an aggregate function that computes the
+// sample covariance of the input pairs. Pairs where either value
+// is null are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_samp`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input pairs)
+var covarSampFunc = covarianceAggFunc(true)
+
+// regrSlopeFunc(y, x) is an aggregate function that computes the
+// slope of the least-squares-fit linear equation determined by the
+// input pairs. Pairs where either value is null are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `regr_slope`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if x has no variance)
+var regrSlopeFunc udf.UDF = &twoParamAggFunc{
+	aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+		yVals, xVals, err := collectFloatPairs(ys, xs)
+		if err != nil {
+			return nil, err
+		}
+		if len(yVals) == 0 {
+			return data.Null{}, nil
+		}
+		_, sxx := meanAndSumOfSquares(xVals)
+		if sxx == 0 {
+			return data.Null{}, nil
+		}
+		return data.Float(sumOfProducts(yVals, xVals) / sxx), nil
+	},
+}
+
+// regrInterceptFunc(y, x) is an aggregate function that computes the
+// y-intercept of the least-squares-fit linear equation determined
+// by the input pairs. Pairs where either value is null are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `regr_intercept`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if x has no variance)
+var regrInterceptFunc udf.UDF = &twoParamAggFunc{
+	aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+		yVals, xVals, err := collectFloatPairs(ys, xs)
+		if err != nil {
+			return nil, err
+		}
+		if len(yVals) == 0 {
+			return data.Null{}, nil
+		}
+		meanX, sxx := meanAndSumOfSquares(xVals)
+		if sxx == 0 {
+			return data.Null{}, nil
+		}
+		meanY, _ := meanAndSumOfSquares(yVals)
+		slope := sumOfProducts(yVals, xVals) / sxx
+		return data.Float(meanY - slope*meanX), nil
+	},
+}
+
+// approxCountDistinctFunc is an aggregate function that estimates
+// the number of distinct non-null input values using a HyperLogLog
+// sketch with a standard error of about 0.8%.
+//
+// It can be used in BQL as `approx_count_distinct`.
+//
+//  Input: anything (aggregated)
+//  Return Type: Int
+var approxCountDistinctFunc udf.UDF = &singleParamAggFunc{
+	aggFun: func(arr []data.Value) (data.Value, error) {
+		h, err := newHyperLogLog(hllDefaultPrecision)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range arr {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			if err := h.add(item); err != nil {
+				return nil, err
+			}
+		}
+		return data.Int(h.count()), nil
+	},
+}
+
+type approxPercentileFuncTmpl struct {
+}
+
+func (f *approxPercentileFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *approxPercentileFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *approxPercentileFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	fracVals, isArray, err := parseFractions(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := newTDigest(tDigestDefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		if err := t.add(item); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make(data.Array, len(fracVals))
+	for i, frac := range fracVals {
+		if q := t.quantile(frac); math.IsNaN(q) {
+			results[i] = data.Null{}
+		} else {
+			results[i] = data.Float(q)
+		}
+	}
+	if isArray {
+		return results, nil
+	}
+	return results[0], nil
+}
+
+// approxPercentileFunc(expr, fraction) is an aggregate function that
+// estimates the value at the given fraction (or array of fractions)
+// of the distribution of the input values using a t-digest. Unlike
+// percentile_cont, it does not require the input to be sorted. Null
+// values are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `approx_percentile`.
+//
+//  Input: Int or Float (aggregated), Float or Array of Floats
+//  Return Type: Float or Array of Floats (Null on empty input)
+var approxPercentileFunc udf.UDF = &approxPercentileFuncTmpl{}
+
+type approxTopKFuncTmpl struct {
+}
+
+func (f *approxTopKFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *approxTopKFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *approxTopKFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	k, err := data.AsInt(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s (%T) as an integer", args[1], args[1])
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be positive: %v", k)
+	}
+
+	c, err := newCountMinSketch(cmsDefaultWidth, cmsDefaultDepth, int(k))
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		if err := c.add(item); err != nil {
+			return nil, err
+		}
+	}
+	return c.topK(int(k)), nil
+}
+
+// approxTopKFunc(expr, k) is an aggregate function that estimates the
+// k most frequent non-null input values using a count-min sketch. The
+// result is an array of maps having "value" and "count" keys sorted by
+// the estimated count in descending order.
+//
+// It can be used in BQL as `approx_top_k`.
+//
+//  Input: anything (aggregated), Int
+//  Return Type: Array of Maps
+var approxTopKFunc udf.UDF = &approxTopKFuncTmpl{}
@@ -206,6 +206,87 @@ func TestUnaryAggregateFuncs(t *testing.T) {
 			// incompatible data
 			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
 		}},
+		{"stddev_pop", stddevPopFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(7)}, data.Float(0.0)},
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Float(5), data.Int(5), data.Null{}, data.Int(7), data.Int(9)}, data.Float(2.0)},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"stddev_samp", stddevSampFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// a single value has no sample deviation
+			{data.Array{data.Int(7)}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Float(5), data.Int(5), data.Null{}, data.Int(7), data.Int(9)},
+				data.Float(math.Sqrt(32.0 / 7))},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"var_pop", varPopFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(7)}, data.Float(0.0)},
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Float(5), data.Int(5), data.Null{}, data.Int(7), data.Int(9)}, data.Float(4.0)},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"var_samp", varSampFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// a single value has no sample variance
+			{data.Array{data.Int(7)}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Float(5), data.Int(5), data.Null{}, data.Int(7), data.Int(9)},
+				data.Float(32.0 / 7)},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"mode", modeFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}, data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(7)}, data.Int(7)},
+			{data.Array{data.Int(1), data.Int(3), data.Null{}, data.Int(3)}, data.Int(3)},
+			{data.Array{data.String("a"), data.String("b"), data.String("b")}, data.String("b")},
+			{data.Array{data.Map{"a": data.Int(1)}, data.Int(1), data.Map{"a": data.Int(1)}},
+				data.Map{"a": data.Int(1)}},
+			// Float and Int values can be equal
+			{data.Array{data.Int(2), data.Float(2.0), data.Float(3.0)}, data.Int(2)},
+			// ties are broken by input order
+			{data.Array{data.Int(1), data.Int(2), data.Int(2), data.Int(1)}, data.Int(1)},
+			{data.Array{data.Int(2), data.Int(1), data.Int(2), data.Int(1)}, data.Int(2)},
+		}},
+		{"approx_count_distinct", approxCountDistinctFunc, []udfUnaryTestCaseInput{
+			// empty array: 0
+			{data.Array{}, data.Int(0)},
+			// array with only Null
+			{data.Array{data.Null{}, data.Null{}}, data.Int(0)},
+			// normal inputs (small cardinalities are exact in practice)
+			{data.Array{data.Int(7)}, data.Int(1)},
+			{data.Array{data.Int(1), data.Int(3), data.Null{}, data.Int(3)}, data.Int(2)},
+			{data.Array{data.String("a"), data.Map{"a": data.Int(1)}, data.String("a")}, data.Int(2)},
+			// Float and Int values can be equal
+			{data.Array{data.Int(2), data.Float(2.0), data.Float(3.0)}, data.Int(2)},
+		}},
 	}
 
 	for _, testCase := range udfUnaryTestCases {
@@ -326,6 +407,153 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 			// array contains non-string
 			{data.Array{data.String("foo"), data.Int(7)}, data.String(", "), nil},
 		}},
+		{"percentile_cont", percentileContFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Float(0.5), data.Null{}},
+			{data.Array{data.Null{}}, data.Float(0.5), data.Null{}},
+			// normal cases
+			{data.Array{data.Int(7)}, data.Float(0.5), data.Float(7)},
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(3), data.Int(4)},
+				data.Float(0.5), data.Float(2.5)},
+			{data.Array{data.Int(1), data.Float(2), data.Int(3), data.Int(4)},
+				data.Int(1), data.Float(4)},
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(0), data.Float(0.25), data.Float(1)},
+				data.Array{data.Float(1), data.Float(1.75), data.Float(4)}},
+			{data.Array{}, data.Array{data.Float(0.5)}, data.Array{data.Null{}}},
+			// descending input
+			{data.Array{data.Int(4), data.Int(3), data.Int(2), data.Int(1)},
+				data.Float(0.25), data.Float(3.25)},
+			/// fail cases
+			// unsorted input
+			{data.Array{data.Int(3), data.Int(1), data.Int(2)}, data.Float(0.5), nil},
+			// bad fractions
+			{data.Array{data.Int(1), data.Int(2)}, data.Float(1.5), nil},
+			{data.Array{data.Int(1), data.Int(2)}, data.Float(-0.5), nil},
+			{data.Array{data.Int(1), data.Int(2)}, data.String("0.5"), nil},
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Float(0.5), data.Null{}}, nil},
+			// array contains non-number
+			{data.Array{data.String("a"), data.String("b")}, data.Float(0.5), nil},
+		}},
+		{"percentile_disc", percentileDiscFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Float(0.5), data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(3), data.Int(4)},
+				data.Float(0.5), data.Int(2)},
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(0), data.Float(0.75), data.Float(0.76), data.Int(1)},
+				data.Array{data.Int(1), data.Int(3), data.Int(4), data.Int(4)}},
+			{data.Array{data.String("a"), data.String("b"), data.String("c")},
+				data.Float(0.5), data.String("b")},
+			// descending input
+			{data.Array{data.Int(4), data.Int(3), data.Int(2), data.Int(1)},
+				data.Float(0.25), data.Int(4)},
+			/// fail cases
+			// unsorted input
+			{data.Array{data.Int(3), data.Int(1), data.Int(2)}, data.Float(0.5), nil},
+			// bad fraction
+			{data.Array{data.Int(1), data.Int(2)}, data.Float(1.5), nil},
+		}},
+		{"approx_percentile", approxPercentileFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Float(0.5), data.Null{}},
+			{data.Array{data.Null{}}, data.Float(0.5), data.Null{}},
+			// normal cases
+			{data.Array{data.Int(7)}, data.Float(0.5), data.Float(7)},
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(3), data.Int(4), data.Float(5)},
+				data.Float(0.5), data.Float(3)},
+			// unsorted input
+			{data.Array{data.Int(4), data.Int(1), data.Int(5), data.Int(3), data.Int(2)},
+				data.Array{data.Int(0), data.Float(0.5), data.Int(1)},
+				data.Array{data.Float(1), data.Float(3), data.Float(5)}},
+			{data.Array{}, data.Array{data.Float(0.5)}, data.Array{data.Null{}}},
+			/// fail cases
+			// bad fractions
+			{data.Array{data.Int(1), data.Int(2)}, data.Float(1.5), nil},
+			{data.Array{data.Int(1), data.Int(2)}, data.String("0.5"), nil},
+			// array contains non-number
+			{data.Array{data.String("a"), data.String("b")}, data.Float(0.5), nil},
+		}},
+		{"approx_top_k", approxTopKFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Int(2), data.Array{}},
+			// normal cases
+			{data.Array{data.String("a"), data.String("b"), data.Null{}, data.String("c"),
+				data.String("b"), data.String("c"), data.String("c")}, data.Int(2),
+				data.Array{
+					data.Map{"value": data.String("c"), "count": data.Int(3)},
+					data.Map{"value": data.String("b"), "count": data.Int(2)},
+				}},
+			{data.Array{data.Int(2), data.Int(1), data.Float(2.0)}, data.Int(5),
+				data.Array{
+					data.Map{"value": data.Int(2), "count": data.Int(2)},
+					data.Map{"value": data.Int(1), "count": data.Int(1)},
+				}},
+			/// fail cases
+			// bad k
+			{data.Array{data.Int(1)}, data.Int(0), nil},
+			{data.Array{data.Int(1)}, data.String("a"), nil},
+		}},
+		{"corr", corrFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Int(3)},
+				data.Array{data.Int(2), data.Int(4), data.Int(6)}, data.Float(1)},
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Float(2)},
+				data.Array{data.Int(6), data.Int(5), data.Int(2), data.Int(4)}, data.Float(-1)},
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(1), data.Int(3), data.Int(2), data.Int(4)}, data.Float(0.8)},
+			// no variance
+			{data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+			/// fail cases
+			// different length
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+			// non-numeric
+			{data.Array{data.Int(1), data.String("a")},
+				data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"covar_pop", covarPopFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1)}, data.Array{data.Int(2)}, data.Float(0)},
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(3)},
+				data.Array{data.Int(2), data.Int(4), data.Int(5), data.Int(6)}, data.Float(4.0 / 3)},
+			/// fail cases
+			// different length
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"covar_samp", covarSampFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1)}, data.Array{data.Int(2)}, data.Null{}},
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(3)},
+				data.Array{data.Int(2), data.Int(4), data.Int(5), data.Int(6)}, data.Float(2)},
+			/// fail cases
+			// different length
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"regr_slope", regrSlopeFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(3), data.Int(5), data.Null{}, data.Int(7)},
+				data.Array{data.Int(1), data.Int(2), data.Int(5), data.Float(3)}, data.Float(2)},
+			// no variance
+			{data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+			/// fail cases
+			{data.Array{data.Int(1), data.Bool(true)},
+				data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"regr_intercept", regrInterceptFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(3), data.Int(5), data.Null{}, data.Int(7)},
+				data.Array{data.Int(1), data.Int(2), data.Int(5), data.Float(3)}, data.Float(1)},
+			// no variance
+			{data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+			/// fail cases
+			{data.Array{data.Int(1), data.Bool(true)},
+				data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
 	}
 
 	for _, testCase := range udfBinaryTestCases {
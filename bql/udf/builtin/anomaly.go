@@ -0,0 +1,326 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+)
+
+// zscoreStats has the online statistics of a key. When alpha is 0, mean and
+// m2 are updated by Welford's algorithm and m2 is the sum of squares of
+// differences from the mean. Otherwise, they're exponentially weighted and
+// m2 is the variance itself.
+type zscoreStats struct {
+	key   data.Value
+	count int64
+	mean  float64
+	m2    float64
+}
+
+func (s *zscoreStats) add(x float64, alpha float64) {
+	s.count++
+	if s.count == 1 {
+		s.mean, s.m2 = x, 0
+		return
+	}
+	diff := x - s.mean
+	if alpha == 0 {
+		s.mean += diff / float64(s.count)
+		s.m2 += diff * (x - s.mean)
+		return
+	}
+	incr := alpha * diff
+	s.mean += incr
+	s.m2 = (1 - alpha) * (s.m2 + diff*incr)
+}
+
+func (s *zscoreStats) stddev(alpha float64) float64 {
+	if alpha == 0 {
+		return math.Sqrt(s.m2 / float64(s.count-1))
+	}
+	return math.Sqrt(s.m2)
+}
+
+// zscoreDetector is a UDS keeping the online mean and variance of values
+// for each key. Tuples written to the state via the uds sink update the
+// statistics, and anomaly_score computes the z-score of a value with them.
+type zscoreDetector struct {
+	m       sync.RWMutex
+	params  zscoreDetectorParams
+	keyPath data.Path
+	field   data.Path
+	stats   map[string]*zscoreStats
+}
+
+type zscoreDetectorParams struct {
+	Key      string
+	Field    string `bql:",required"`
+	Alpha    float64
+	MinCount int64
+}
+
+var (
+	_ core.LoadableSharedState = &zscoreDetector{}
+	_ core.Writer              = &zscoreDetector{}
+)
+
+func newZScoreDetector(params data.Map) (*zscoreDetector, error) {
+	p := zscoreDetectorParams{
+		MinCount: 2,
+	}
+	if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Alpha < 0 || p.Alpha >= 1 {
+		return nil, fmt.Errorf("alpha must be in [0, 1): %v", p.Alpha)
+	}
+	if p.MinCount < 2 {
+		return nil, fmt.Errorf("min_count must be at least 2: %v", p.MinCount)
+	}
+
+	d := &zscoreDetector{
+		params: p,
+		stats:  map[string]*zscoreStats{},
+	}
+	var err error
+	if d.field, err = data.CompilePath(p.Field); err != nil {
+		return nil, err
+	}
+	if p.Key != "" {
+		if d.keyPath, err = data.CompilePath(p.Key); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *zscoreDetector) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (d *zscoreDetector) Write(ctx *core.Context, t *core.Tuple) error {
+	// The lock is held from the beginning because Load replaces paths.
+	d.m.Lock()
+	defer d.m.Unlock()
+	var key data.Value = data.Null{}
+	if d.keyPath != nil {
+		k, err := t.Data.Get(d.keyPath)
+		if err != nil {
+			return err
+		}
+		key = k
+	}
+	v, err := t.Data.Get(d.field)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	fs, err := collectFloats([]data.Value{v})
+	if err != nil {
+		return err
+	}
+
+	k := key.String()
+	s, ok := d.stats[k]
+	if !ok {
+		s = &zscoreStats{key: key}
+		d.stats[k] = s
+	}
+	s.add(fs[0], d.params.Alpha)
+	return nil
+}
+
+// score returns the z-score of x. The second return value is false when
+// the statistics of the key aren't available yet.
+func (d *zscoreDetector) score(key data.Value, x float64) (float64, bool) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	s, ok := d.stats[key.String()]
+	if !ok || s.count < d.params.MinCount {
+		return 0, false
+	}
+	sd := s.stddev(d.params.Alpha)
+	if sd == 0 {
+		if x == s.mean {
+			return 0, true
+		}
+		return 0, false
+	}
+	return (x - s.mean) / sd, true
+}
+
+func (d *zscoreDetector) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	d.m.RLock()
+	stats := make(data.Array, 0, len(d.stats))
+	for _, s := range d.stats {
+		stats = append(stats, data.Map{
+			"key":   s.key,
+			"count": data.Int(s.count),
+			"mean":  data.Float(s.mean),
+			"m2":    data.Float(s.m2),
+		})
+	}
+	p := d.params
+	d.m.RUnlock()
+
+	b, err := data.MarshalMsgpack(data.Map{
+		"type": data.String("zscore_detector"),
+		"params": data.Map{
+			"key":       data.String(p.Key),
+			"field":     data.String(p.Field),
+			"alpha":     data.Float(p.Alpha),
+			"min_count": data.Int(p.MinCount),
+		},
+		"stats": stats,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func (d *zscoreDetector) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	l, err := loadZScoreDetector(r)
+	if err != nil {
+		return err
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.params, d.keyPath, d.field, d.stats = l.params, l.keyPath, l.field, l.stats
+	return nil
+}
+
+func loadZScoreDetector(r io.Reader) (*zscoreDetector, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved struct {
+		Type   string   `bql:",required"`
+		Params data.Map `bql:",required"`
+		Stats  []struct {
+			Key   data.Value
+			Count int64   `bql:",required"`
+			Mean  float64 `bql:",required"`
+			M2    float64 `bql:"m2,required"`
+		} `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &saved); err != nil {
+		return nil, err
+	}
+	if saved.Type != "zscore_detector" {
+		return nil, fmt.Errorf("the saved state has a different type: %v", saved.Type)
+	}
+	d, err := newZScoreDetector(saved.Params)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range saved.Stats {
+		key := s.Key
+		if key == nil {
+			key = data.Null{}
+		}
+		d.stats[key.String()] = &zscoreStats{
+			key:   key,
+			count: s.Count,
+			mean:  s.Mean,
+			m2:    s.M2,
+		}
+	}
+	return d, nil
+}
+
+type zscoreDetectorCreator struct{}
+
+var _ udf.UDSLoader = &zscoreDetectorCreator{}
+
+// zscoreDetectorStateCreator creates a UDS detecting anomalies of numeric
+// values by their z-scores. Values written to the state via the uds sink
+// update the mean and the variance of the values of each key.
+//
+// It can be used in BQL as `zscore_detector`.
+//
+// Parameters:
+//
+//	field: the path to the value (required)
+//	key: the path to the key by which statistics are separated. All values
+//	     share the same statistics when it's omitted.
+//	alpha: the weight of a new value, at least 0 and less than 1. When it's
+//	       0 (default), all values are weighted equally. Otherwise, the
+//	       statistics are exponentially weighted so that they follow drifts.
+//	min_count: the number of values required before anomaly_score returns
+//	           a score, at least 2 (default: 2)
+var zscoreDetectorStateCreator udf.UDSCreator = &zscoreDetectorCreator{}
+
+func (c *zscoreDetectorCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	return newZScoreDetector(params)
+}
+
+func (c *zscoreDetectorCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	return loadZScoreDetector(r)
+}
+
+// lookupZScoreDetector returns the zscore_detector state having the name.
+func lookupZScoreDetector(ctx *core.Context, name string) (*zscoreDetector, error) {
+	st, err := ctx.SharedStates.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := st.(*zscoreDetector)
+	if !ok {
+		return nil, fmt.Errorf("state '%v' is not a zscore_detector", name)
+	}
+	return d, nil
+}
+
+func anomalyScore(ctx *core.Context, name string, key, value data.Value) (data.Value, error) {
+	d, err := lookupZScoreDetector(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if value.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	fs, err := collectFloats([]data.Value{value})
+	if err != nil {
+		return nil, err
+	}
+	z, ok := d.score(key, fs[0])
+	if !ok {
+		return data.Null{}, nil
+	}
+	return data.Float(z), nil
+}
+
+// anomalyScoreFunc(state, [key], value) returns the z-score of the value,
+// that is how many standard deviations the value is away from the mean of
+// the values of the key in a zscore_detector state. The key can be omitted
+// when the state doesn't have the key parameter. It returns Null when the
+// state doesn't have enough values of the key, or when the values have no
+// variance and the value differs from them. The score is negative when the
+// value is less than the mean; use abs for two-sided detection.
+//
+// It can be used in BQL as `anomaly_score`.
+//
+//  Input: String, [Any], Int or Float
+//  Return Type: Float
+var anomalyScoreFunc udf.UDF = &arityDispatcher{
+	binary: udf.MustConvertGeneric(func(ctx *core.Context, name string, value data.Value) (data.Value, error) {
+		return anomalyScore(ctx, name, data.Null{}, value)
+	}),
+	ternary: udf.MustConvertGeneric(anomalyScore),
+}
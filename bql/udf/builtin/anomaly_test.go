@@ -0,0 +1,214 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestZScoreDetector(t *testing.T) {
+	Convey("Given a context having a zscore_detector state", t, func() {
+		ctx := core.NewContext(nil)
+		reg, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		c, err := reg.Lookup("zscore_detector")
+		So(err, ShouldBeNil)
+		s, err := c.CreateState(ctx, data.Map{
+			"key":   data.String("device"),
+			"field": data.String("temp"),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("det", "zscore_detector", s), ShouldBeNil)
+
+		Convey("When writing tuples to it", func() {
+			for _, v := range []struct {
+				key   string
+				value data.Value
+			}{
+				{"a", data.Int(1)}, {"a", data.Float(2)}, {"a", data.Null{}},
+				{"a", data.Int(3)}, {"a", data.Int(4)}, {"a", data.Int(5)},
+				{"b", data.Int(10)}, {"b", data.Int(10)},
+				{"c", data.Int(1)},
+			} {
+				So(s.(core.Writer).Write(ctx, core.NewTuple(data.Map{
+					"device": data.String(v.key),
+					"temp":   v.value,
+				})), ShouldBeNil)
+			}
+
+			score := func(key string, v data.Value) data.Value {
+				res, err := anomalyScoreFunc.Call(ctx, data.String("det"), data.String(key), v)
+				So(err, ShouldBeNil)
+				return res
+			}
+
+			Convey("Then anomaly_score should return z-scores", func() {
+				sd := math.Sqrt(2.5)
+				So(score("a", data.Int(6)), ShouldAlmostEqual, data.Float(3/sd))
+				So(score("a", data.Float(1.5)), ShouldAlmostEqual, data.Float(-1.5/sd))
+				So(score("a", data.Int(3)), ShouldResemble, data.Float(0))
+			})
+
+			Convey("Then anomaly_score should handle constant values", func() {
+				So(score("b", data.Int(10)), ShouldResemble, data.Float(0))
+				So(score("b", data.Int(11)), ShouldResemble, data.Null{})
+			})
+
+			Convey("Then anomaly_score should return null without enough values", func() {
+				So(score("c", data.Int(1)), ShouldResemble, data.Null{})
+				So(score("d", data.Int(1)), ShouldResemble, data.Null{})
+				So(score("a", data.Null{}), ShouldResemble, data.Null{})
+			})
+
+			Convey("Then anomaly_score should fail with invalid arguments", func() {
+				_, err := anomalyScoreFunc.Call(ctx, data.String("det"), data.String("a"), data.String("x"))
+				So(err, ShouldNotBeNil)
+				_, err = anomalyScoreFunc.Call(ctx, data.String("no_such_state"), data.String("a"), data.Int(1))
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then it should be saved and loaded", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				saved := buf.Bytes()
+
+				l, err := c.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+				So(err, ShouldBeNil)
+				So(l.(*zscoreDetector).params, ShouldResemble, s.(*zscoreDetector).params)
+				So(l.(*zscoreDetector).stats, ShouldResemble, s.(*zscoreDetector).stats)
+
+				// Load overwrites the existing state
+				s2, err := c.CreateState(ctx, data.Map{"field": data.String("x")})
+				So(err, ShouldBeNil)
+				So(s2.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+				So(s2.(*zscoreDetector).params, ShouldResemble, s.(*zscoreDetector).params)
+				So(s2.(*zscoreDetector).stats, ShouldResemble, s.(*zscoreDetector).stats)
+			})
+
+			Convey("Then loading data of another type should fail", func() {
+				ts, err := tDigestStateCreator.CreateState(ctx, data.Map{"field": data.String("v")})
+				So(err, ShouldBeNil)
+				buf := bytes.NewBuffer(nil)
+				So(ts.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				So(s.(core.LoadableSharedState).Load(ctx, buf, data.Map{}), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When saving, loading, and writing it concurrently", func() {
+			buf := bytes.NewBuffer(nil)
+			So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+			saved := buf.Bytes()
+
+			var wg sync.WaitGroup
+			errs := make([]error, 3)
+			for i, f := range []func() error{
+				func() error {
+					return s.(core.SavableSharedState).Save(ctx, ioutil.Discard, data.Map{})
+				},
+				func() error {
+					return s.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{})
+				},
+				func() error {
+					return s.(core.Writer).Write(ctx, core.NewTuple(data.Map{
+						"device": data.String("a"),
+						"temp":   data.Int(1),
+					}))
+				},
+			} {
+				i, f := i, f
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 100; j++ {
+						if err := f(); err != nil {
+							errs[i] = err
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then all of them should succeed", func() {
+				So(errs, ShouldResemble, make([]error, 3))
+			})
+		})
+
+		Convey("When writing a non-numeric value", func() {
+			err := s.(core.Writer).Write(ctx, core.NewTuple(data.Map{
+				"device": data.String("a"),
+				"temp":   data.String("hot"),
+			}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a zscore_detector state without a key", t, func() {
+		ctx := core.NewContext(nil)
+		s, err := zscoreDetectorStateCreator.CreateState(ctx, data.Map{
+			"field": data.String("v"),
+			"alpha": data.Float(0.5),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("det", "zscore_detector", s), ShouldBeNil)
+
+		Convey("When writing tuples to it", func() {
+			for _, v := range []int64{0, 2, 2} {
+				So(s.(core.Writer).Write(ctx, core.NewTuple(data.Map{"v": data.Int(v)})), ShouldBeNil)
+			}
+
+			Convey("Then anomaly_score should use exponentially weighted statistics", func() {
+				// mean: 0 -> 1 -> 1.5, variance: 0 -> 1 -> 0.75
+				v, err := anomalyScoreFunc.Call(ctx, data.String("det"), data.Float(3))
+				So(err, ShouldBeNil)
+				So(v, ShouldAlmostEqual, data.Float(1.5/math.Sqrt(0.75)))
+			})
+
+			Convey("Then it should be saved and loaded with a null key", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := zscoreDetectorStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				So(l.(*zscoreDetector).stats, ShouldResemble, s.(*zscoreDetector).stats)
+			})
+		})
+	})
+
+	Convey("Given the zscore_detector creator", t, func() {
+		ctx := core.NewContext(nil)
+
+		Convey("When creating a state with invalid parameters", func() {
+			Convey("Then it should fail", func() {
+				for _, params := range []data.Map{
+					{},
+					{"field": data.String("v"), "alpha": data.Float(1)},
+					{"field": data.String("v"), "alpha": data.Float(-0.1)},
+					{"field": data.String("v"), "min_count": data.Int(1)},
+					{"field": data.String("v["), "key": data.String("k")},
+				} {
+					_, err := zscoreDetectorStateCreator.CreateState(ctx, params)
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+
+	Convey("Given the anomaly_score function", t, func() {
+		Convey("Then it should be registered with arity 2 and 3", func() {
+			for _, arity := range []int{2, 3} {
+				f, err := udf.CopyGlobalUDFRegistry(nil).Lookup("anomaly_score", arity)
+				So(err, ShouldBeNil)
+				So(f, ShouldHaveSameTypeAs, anomalyScoreFunc)
+			}
+		})
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
 )
 
 // arrayLengthFunc returns the length of the given array.
@@ -23,3 +25,463 @@ var arrayLengthFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Val
 	}
 	return nil, fmt.Errorf("%v is not an array", arg)
 })
+
+// arrayArg converts an argument to an array. The second return value is
+// false if the argument is Null.
+func arrayArg(v data.Value) (data.Array, bool, error) {
+	if v.Type() == data.TypeNull {
+		return nil, false, nil
+	} else if v.Type() == data.TypeArray {
+		a, _ := data.AsArray(v)
+		return a, true, nil
+	}
+	return nil, false, fmt.Errorf("%v is not an array", v)
+}
+
+// indexArg converts an argument to an index into an array of the given
+// length. A negative index counts from the end of the array. The result
+// is clamped to [0, length].
+func indexArg(v data.Value, length int) (int, error) {
+	if v.Type() != data.TypeInt {
+		return 0, fmt.Errorf("cannot interpret %s as integer", v)
+	}
+	i, _ := data.AsInt(v)
+	if i < 0 {
+		i += int64(length)
+	}
+	if i < 0 {
+		return 0, nil
+	} else if i > int64(length) {
+		return length, nil
+	}
+	return int(i), nil
+}
+
+// arrayAppendFunc(arr, elem) returns a new array having elem at the end
+// of arr. elem can be Null.
+//
+// It can be used in BQL as `array_append`.
+//
+//  Input: Array, Any
+//  Return Type: Array
+var arrayAppendFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, elem data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, len(a), len(a)+1)
+	copy(res, a)
+	return append(res, elem), nil
+})
+
+// arrayCatFunc concatenates all arrays given as input arguments.
+// Null values are ignored, non-array arguments lead to an error.
+//
+// It can be used in BQL as `array_cat`.
+//
+//  Input: n * Array
+//  Return Type: Array
+var arrayCatFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Array{}
+		for _, arg := range args {
+			a, ok, err := arrayArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				res = append(res, a...)
+			}
+		}
+		return res, nil
+	},
+}
+
+// arraySliceFunc(arr, from, [to]) returns the elements of arr from
+// the index `from` (inclusive, 0-based) to the index `to` (exclusive).
+// Negative indexes count from the end of the array. If `to` is not
+// given, everything until the end of arr is returned. Out-of-range
+// indexes are clamped.
+//
+// It can be used in BQL as `array_slice`.
+//
+//  Input: Array, Int, [Int]
+//  Return Type: Array
+var arraySliceFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 3 {
+			return nil, fmt.Errorf("function takes two or three arguments")
+		}
+		for _, arg := range args {
+			if arg.Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+		}
+		a, _, err := arrayArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		from, err := indexArg(args[1], len(a))
+		if err != nil {
+			return nil, err
+		}
+		to := len(a)
+		if len(args) == 3 {
+			if to, err = indexArg(args[2], len(a)); err != nil {
+				return nil, err
+			}
+		}
+		if to < from {
+			to = from
+		}
+		res := make(data.Array, to-from)
+		copy(res, a[from:to])
+		return res, nil
+	},
+}
+
+// arrayPosition returns the index of the first element equal to v
+// or -1 if it is not found.
+func arrayPosition(a data.Array, v data.Value) int {
+	for i, elem := range a {
+		if data.Equal(elem, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// arrayContainsFunc(arr, elem) returns true if arr has an element equal
+// to elem.
+//
+// It can be used in BQL as `array_contains`.
+//
+//  Input: Array, Any
+//  Return Type: Bool
+var arrayContainsFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, elem data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	return data.Bool(arrayPosition(a, elem) >= 0), nil
+})
+
+// arrayPositionFunc(arr, elem) returns the index of the first occurrence
+// of elem in arr (0-based) or -1 if it is not found.
+//
+// It can be used in BQL as `array_position`.
+//
+//  Input: Array, Any
+//  Return Type: Int
+var arrayPositionFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, elem data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	return data.Int(arrayPosition(a, elem)), nil
+})
+
+// arrayRemoveFunc(arr, elem) returns a new array having all elements of
+// arr except the ones equal to elem.
+//
+// It can be used in BQL as `array_remove`.
+//
+//  Input: Array, Any
+//  Return Type: Array
+var arrayRemoveFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, elem data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, 0, len(a))
+	for _, v := range a {
+		if !data.Equal(v, elem) {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+})
+
+// arraySortFunc(arr, [descending]) returns a new array having the elements
+// of arr sorted in ascending order, or in descending order if descending
+// is true. Elements of different types are ordered in the same way as
+// ORDER BY.
+//
+// It can be used in BQL as `array_sort`.
+//
+//  Input: Array, [Bool]
+//  Return Type: Array
+var arraySortFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 2 {
+			return nil, fmt.Errorf("function takes one or two arguments")
+		}
+		a, ok, err := arrayArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		desc := false
+		if len(args) == 2 {
+			if args[1].Type() == data.TypeNull {
+				return data.Null{}, nil
+			} else if args[1].Type() != data.TypeBool {
+				return nil, fmt.Errorf("cannot interpret %s as bool", args[1])
+			}
+			b, _ := data.AsBool(args[1])
+			desc = b
+		}
+		res := make(data.Array, len(a))
+		copy(res, a)
+		sort.Stable(&valueSorter{res, desc})
+		return res, nil
+	},
+}
+
+type valueSorter struct {
+	values data.Array
+	desc   bool
+}
+
+func (s *valueSorter) Len() int { return len(s.values) }
+func (s *valueSorter) Less(i, j int) bool {
+	if s.desc {
+		return data.Less(s.values[j], s.values[i])
+	}
+	return data.Less(s.values[i], s.values[j])
+}
+func (s *valueSorter) Swap(i, j int) { s.values[i], s.values[j] = s.values[j], s.values[i] }
+
+// arrayDistinctFunc returns a new array having the elements of the given
+// array without duplicates. The first occurrence of each value is kept.
+//
+// It can be used in BQL as `array_distinct`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayDistinctFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, 0, len(a))
+	seen := map[data.HashValue][]data.Value{}
+	for _, v := range a {
+		h := data.Hash(v)
+		dup := false
+		for _, s := range seen[h] {
+			if data.Equal(s, v) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			seen[h] = append(seen[h], v)
+			res = append(res, v)
+		}
+	}
+	return res, nil
+})
+
+// arrayReverseFunc returns a new array having the elements of the given
+// array in reverse order.
+//
+// It can be used in BQL as `array_reverse`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayReverseFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, len(a))
+	for i, v := range a {
+		res[len(a)-1-i] = v
+	}
+	return res, nil
+})
+
+// arrayAggregateFunc creates a function applying the aggregate function
+// agg to the elements of a single array.
+func arrayAggregateFunc(agg udf.UDF) udf.UDF {
+	return udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+		a, ok, err := arrayArg(arr)
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		return agg.Call(ctx, a)
+	})
+}
+
+// arraySumFunc computes the sum of the elements of an array in the same
+// way as the sum aggregate function.
+//
+// It can be used in BQL as `array_sum`.
+//
+//  Input: Array of Ints or Floats
+//  Return Type: Int or Float (Null on empty input)
+var arraySumFunc = arrayAggregateFunc(sumFunc)
+
+// arrayAvgFunc computes the average of the elements of an array in the
+// same way as the avg aggregate function.
+//
+// It can be used in BQL as `array_avg`.
+//
+//  Input: Array of Ints or Floats
+//  Return Type: Float (Null on empty input)
+var arrayAvgFunc = arrayAggregateFunc(avgFunc)
+
+// arrayMinFunc computes the minimum of the elements of an array in the
+// same way as the min aggregate function.
+//
+// It can be used in BQL as `array_min`.
+//
+//  Input: Array of Ints or Floats
+//  Return Type: same as the elements (Null on empty input)
+var arrayMinFunc = arrayAggregateFunc(minFunc)
+
+// arrayMaxFunc computes the maximum of the elements of an array in the
+// same way as the max aggregate function.
+//
+// It can be used in BQL as `array_max`.
+//
+//  Input: Array of Ints or Floats
+//  Return Type: same as the elements (Null on empty input)
+var arrayMaxFunc = arrayAggregateFunc(maxFunc)
+
+// arrayZipFunc(arr1, arr2, ...) returns an array of arrays whose i-th
+// element is an array of the i-th elements of the input arrays. Shorter
+// arrays are padded with Null.
+//
+// It can be used in BQL as `array_zip`.
+//
+//  Input: n * Array
+//  Return Type: Array of Arrays
+var arrayZipFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		arrays := make([]data.Array, len(args))
+		maxLen := 0
+		for i, arg := range args {
+			a, ok, err := arrayArg(arg)
+			if err != nil || !ok {
+				return data.Null{}, err
+			}
+			arrays[i] = a
+			if len(a) > maxLen {
+				maxLen = len(a)
+			}
+		}
+		res := make(data.Array, maxLen)
+		for i := range res {
+			tuple := make(data.Array, len(arrays))
+			for j, a := range arrays {
+				if i < len(a) {
+					tuple[j] = a[i]
+				} else {
+					tuple[j] = data.Null{}
+				}
+			}
+			res[i] = tuple
+		}
+		return res, nil
+	},
+}
+
+// maxSeriesLength is the maximum number of elements generate_series can
+// return.
+const maxSeriesLength = 1000000
+
+// generateSeriesFunc(start, stop, [step]) returns an array of numbers
+// from start to stop (inclusive) with the given step, which defaults
+// to 1. The elements are Floats if any of the arguments is a Float,
+// Ints otherwise. It returns an error when the array would have more
+// than maxSeriesLength elements.
+// See also: PostgreSQL's `generate_series`.
+//
+// It can be used in BQL as `generate_series`.
+//
+//  Input: Int or Float, Int or Float, [Int or Float]
+//  Return Type: Array of Ints or Floats
+var generateSeriesFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 3 {
+			return nil, fmt.Errorf("function takes two or three arguments")
+		}
+		isFloat := false
+		for _, arg := range args {
+			switch arg.Type() {
+			case data.TypeNull:
+				return data.Null{}, nil
+			case data.TypeInt:
+			case data.TypeFloat:
+				isFloat = true
+			default:
+				return nil, fmt.Errorf("cannot interpret %s as a number", arg)
+			}
+		}
+
+		if !isFloat {
+			start, _ := data.AsInt(args[0])
+			stop, _ := data.AsInt(args[1])
+			step := int64(1)
+			if len(args) == 3 {
+				step, _ = data.AsInt(args[2])
+			}
+			if step == 0 {
+				return nil, fmt.Errorf("step must not be zero")
+			}
+			if (step > 0 && start > stop) || (step < 0 && start < stop) {
+				return data.Array{}, nil
+			}
+			// The differences are computed in uint64 so that they don't
+			// overflow.
+			var n uint64
+			if step > 0 {
+				n = uint64(stop-start) / uint64(step)
+			} else {
+				n = uint64(start-stop) / uint64(-step)
+			}
+			if n >= maxSeriesLength { // n+1 can overflow
+				return nil, fmt.Errorf("the series must not have more than %v elements", maxSeriesLength)
+			}
+			res := make(data.Array, n+1)
+			for i := range res {
+				res[i] = data.Int(start + int64(i)*step)
+			}
+			return res, nil
+		}
+
+		start, _ := data.ToFloat(args[0])
+		stop, _ := data.ToFloat(args[1])
+		step := 1.0
+		if len(args) == 3 {
+			step, _ = data.ToFloat(args[2])
+		}
+		if step == 0 || math.IsNaN(step) || math.IsInf(step, 0) {
+			return nil, fmt.Errorf("step must be a finite non-zero number")
+		}
+		if math.IsInf(start, 0) || math.IsInf(stop, 0) {
+			return nil, fmt.Errorf("start and stop must be finite")
+		}
+		n := math.Floor((stop-start)/step) + 1
+		if n > maxSeriesLength {
+			return nil, fmt.Errorf("the series must not have more than %v elements", maxSeriesLength)
+		}
+		res := data.Array{}
+		// compute each element from the index to avoid accumulating
+		// rounding errors
+		for i := 0; ; i++ {
+			v := start + float64(i)*step
+			if (step > 0 && v > stop) || (step < 0 && v < stop) || math.IsNaN(v) {
+				break
+			}
+			res = append(res, data.Float(v))
+		}
+		return res, nil
+	},
+}
@@ -33,6 +33,50 @@ func TestUnaryArrayFuncs(t *testing.T) {
 			{data.Array{data.Null{}}, data.Int(1)},
 			{data.Array{data.Int(2), data.Float(3)}, data.Int(2)},
 		}},
+		{"array_distinct", arrayDistinctFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(2), data.Float(2), data.Null{}, data.String("a"), data.Null{},
+				data.Map{"a": data.Int(1)}, data.String("a"), data.Map{"a": data.Int(1)}},
+				data.Array{data.Int(2), data.Null{}, data.String("a"), data.Map{"a": data.Int(1)}}},
+		}},
+		{"array_reverse", arrayReverseFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(1), data.Null{}, data.String("a")},
+				data.Array{data.String("a"), data.Null{}, data.Int(1)}},
+		}},
+		{"array_sort", arraySortFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(3), data.Float(1.5), data.Null{}, data.Int(2)},
+				data.Array{data.Null{}, data.Float(1.5), data.Int(2), data.Int(3)}},
+			{data.Array{data.String("b"), data.String("a"), data.Int(1)},
+				data.Array{data.Int(1), data.String("a"), data.String("b")}},
+		}},
+		{"array_sum", arraySumFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(1), data.Null{}, data.Int(2)}, data.Int(3)},
+			{data.Array{data.Int(1), data.Float(2.5)}, data.Float(3.5)},
+			{data.Array{data.Int(1), data.String("a")}, nil},
+		}},
+		{"array_avg", arrayAvgFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(1), data.Null{}, data.Int(2)}, data.Float(1.5)},
+			{data.Array{data.Int(1), data.String("a")}, nil},
+		}},
+		{"array_min", arrayMinFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(3), data.Null{}, data.Float(1.5)}, data.Float(1.5)},
+			{data.Array{data.String("b"), data.String("a")}, nil},
+		}},
+		{"array_max", arrayMaxFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(3), data.Null{}, data.Float(1.5)}, data.Int(3)},
+			{data.Array{data.String("b"), data.String("a")}, nil},
+		}},
+		{"array_zip", arrayZipFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.Array{data.Int(1)}, data.Array{data.Int(2)}}},
+		}},
 	}
 
 	for _, testCase := range udfUnaryTestCases {
@@ -80,3 +124,184 @@ func TestUnaryArrayFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryArrayFuncs(t *testing.T) {
+	invalidInputs := []udfBinaryTestCaseInput{
+		{data.Blob{}, data.Array{}, nil},
+		{data.Map{}, data.Array{}, nil},
+		{data.String("hoge"), data.Array{}, nil},
+	}
+
+	arr := data.Array{data.Int(1), data.String("a"), data.Null{}, data.Float(1), data.Int(2)}
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"array_append", arrayAppendFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Array{}, data.Int(1), data.Array{data.Int(1)}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Array{data.Int(1), data.Null{}}},
+			{data.Array{data.Int(1)}, data.Array{data.Int(2)},
+				data.Array{data.Int(1), data.Array{data.Int(2)}}},
+		}},
+		{"array_cat", arrayCatFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Null{}, data.Array{}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Array{data.Int(1)}},
+			{data.Array{data.Int(1)}, data.Array{data.Int(2), data.String("a")},
+				data.Array{data.Int(1), data.Int(2), data.String("a")}},
+			{data.Array{data.Int(1)}, data.Int(2), nil},
+		}},
+		{"array_slice", arraySliceFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{arr, data.Null{}, data.Null{}},
+			{arr, data.Int(0), arr},
+			{arr, data.Int(3), data.Array{data.Float(1), data.Int(2)}},
+			{arr, data.Int(-2), data.Array{data.Float(1), data.Int(2)}},
+			{arr, data.Int(-10), arr},
+			{arr, data.Int(10), data.Array{}},
+			{arr, data.Float(1), nil},
+		}},
+		{"array_contains", arrayContainsFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Array{}, data.Int(1), data.Bool(false)},
+			{arr, data.Int(2), data.Bool(true)},
+			{arr, data.Float(2), data.Bool(true)},
+			{arr, data.Null{}, data.Bool(true)},
+			{arr, data.String("b"), data.Bool(false)},
+		}},
+		{"array_position", arrayPositionFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Array{}, data.Int(1), data.Int(-1)},
+			{arr, data.Int(1), data.Int(0)},
+			{arr, data.String("a"), data.Int(1)},
+			{arr, data.Null{}, data.Int(2)},
+			{arr, data.String("b"), data.Int(-1)},
+		}},
+		{"array_remove", arrayRemoveFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Array{}, data.Int(1), data.Array{}},
+			{arr, data.Int(1), data.Array{data.String("a"), data.Null{}, data.Int(2)}},
+			{arr, data.Null{}, data.Array{data.Int(1), data.String("a"), data.Float(1), data.Int(2)}},
+		}},
+		{"array_sort", arraySortFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Bool(true), data.Null{}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Null{}},
+			{data.Array{data.Int(1), data.Int(3), data.Int(2)}, data.Bool(false),
+				data.Array{data.Int(1), data.Int(2), data.Int(3)}},
+			{data.Array{data.Int(1), data.Int(3), data.Int(2)}, data.Bool(true),
+				data.Array{data.Int(3), data.Int(2), data.Int(1)}},
+			{data.Array{data.Int(1)}, data.String("desc"), nil},
+		}},
+		{"array_zip", arrayZipFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Array{}, data.Null{}},
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.String("a")},
+				data.Array{
+					data.Array{data.Int(1), data.String("a")},
+					data.Array{data.Int(2), data.Null{}},
+				}},
+		}},
+		{"generate_series", generateSeriesFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Int(1), data.Int(3), data.Array{data.Int(1), data.Int(2), data.Int(3)}},
+			{data.Int(3), data.Int(1), data.Array{}},
+			{data.Float(0.5), data.Int(2), data.Array{data.Float(0.5), data.Float(1.5)}},
+			{data.Int(math.MaxInt64 - 1), data.Int(math.MaxInt64),
+				data.Array{data.Int(math.MaxInt64 - 1), data.Int(math.MaxInt64)}},
+			{data.Int(1), data.String("3"), nil},
+			{data.Float(math.Inf(-1)), data.Int(3), nil},
+			{data.Int(0), data.Int(math.MaxInt64), nil},
+			{data.Int(math.MinInt64), data.Int(math.MaxInt64), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+		allInputs := append(testCase.inputs, invalidInputs...)
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func Test3aryArrayFuncs(t *testing.T) {
+	arr := data.Array{data.Int(1), data.String("a"), data.Null{}, data.Float(1), data.Int(2)}
+	udf3aryTestCases := []udf3aryTestCase{
+		{"array_slice", arraySliceFunc, []udf3aryTestCaseInput{
+			{data.Null{}, data.Int(0), data.Int(1), data.Null{}},
+			{arr, data.Int(0), data.Null{}, data.Null{}},
+			{arr, data.Int(1), data.Int(3), data.Array{data.String("a"), data.Null{}}},
+			{arr, data.Int(1), data.Int(-1), data.Array{data.String("a"), data.Null{}, data.Float(1)}},
+			{arr, data.Int(3), data.Int(1), data.Array{}},
+			{arr, data.Int(0), data.Int(100), arr},
+			{arr, data.Int(0), data.String("1"), nil},
+		}},
+		{"generate_series", generateSeriesFunc, []udf3aryTestCaseInput{
+			{data.Int(0), data.Int(10), data.Null{}, data.Null{}},
+			{data.Int(0), data.Int(10), data.Int(4), data.Array{data.Int(0), data.Int(4), data.Int(8)}},
+			{data.Int(5), data.Int(1), data.Int(-2), data.Array{data.Int(5), data.Int(3), data.Int(1)}},
+			{data.Int(0), data.Int(1), data.Float(0.25), data.Array{data.Float(0), data.Float(0.25),
+				data.Float(0.5), data.Float(0.75), data.Float(1)}},
+			{data.Float(1), data.Float(0), data.Float(-0.5), data.Array{data.Float(1), data.Float(0.5), data.Float(0)}},
+			{data.Int(math.MinInt64 + 1), data.Int(math.MinInt64), data.Int(-2),
+				data.Array{data.Int(math.MinInt64 + 1)}},
+			{data.Int(0), data.Int(10), data.Int(0), nil},
+			{data.Int(0), data.Int(10), data.Float(0), nil},
+			{data.Int(math.MaxInt64), data.Int(math.MinInt64), data.Int(-1), nil},
+			{data.Int(0), data.Int(1), data.Float(1e-9), nil},
+		}},
+	}
+
+	for _, testCase := range udf3aryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %#v", i,
+					[]data.Value{tc.input1, tc.input2, tc.input3}), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2, tc.input3)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package builtin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// unpackField is a field of a binary layout.
+type unpackField struct {
+	name  string
+	kind  string // int, uint, float, bool, string, bytes, or pad
+	size  int
+	order binary.ByteOrder
+}
+
+// maxUnpackSize is the maximum number of bytes a format of the unpack
+// function can have.
+const maxUnpackSize = 1024 * 1024
+
+// unpackLayout is a compiled format of the unpack function.
+type unpackLayout struct {
+	fields []unpackField
+	size   int
+}
+
+// compileUnpackLayout compiles a format of the unpack function. The format
+// consists of an optional byte order mark followed by whitespace-separated
+// fields written as "name:type":
+//
+//	> id:uint16 temp:int16le flags:uint8 _:pad2 name:string8
+//
+// The byte order mark is ">" or "!" for big-endian and "<" for
+// little-endian. It's big-endian when omitted. Supported types are:
+//
+//	int8, int16, int32, int64, uint8, uint16, uint32, uint64,
+//	float32, float64: numbers, can be suffixed with "le" or "be" to
+//	                  override the byte order of the field
+//	bool: a byte, true if it isn't 0
+//	stringN: an N-byte string, trailing NUL bytes are removed
+//	bytesN: an N-byte blob
+//	padN: N bytes to be skipped, the name is ignored
+//
+// The total size of the fields must not exceed maxUnpackSize bytes.
+func compileUnpackLayout(format string) (*unpackLayout, error) {
+	format = strings.TrimSpace(format)
+	var order binary.ByteOrder = binary.BigEndian
+	if len(format) > 0 {
+		switch format[0] {
+		case '>', '!':
+			format = format[1:]
+		case '<':
+			order = binary.LittleEndian
+			format = format[1:]
+		}
+	}
+
+	l := &unpackLayout{}
+	names := map[string]bool{}
+	for _, spec := range strings.Fields(format) {
+		i := strings.LastIndex(spec, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("field must be written as name:type: %v", spec)
+		}
+		f, err := parseUnpackType(spec[i+1:], order)
+		if err != nil {
+			return nil, err
+		}
+		f.name = spec[:i]
+		if f.kind != "pad" {
+			if f.name == "" {
+				return nil, fmt.Errorf("field name is empty: %v", spec)
+			} else if names[f.name] {
+				return nil, fmt.Errorf("field name is duplicated: %v", f.name)
+			}
+			names[f.name] = true
+		}
+		l.fields = append(l.fields, f)
+		// Each size is at most maxUnpackSize, so the sum doesn't overflow
+		// before it's checked.
+		l.size += f.size
+		if l.size > maxUnpackSize {
+			return nil, fmt.Errorf("format must not be larger than %v bytes", maxUnpackSize)
+		}
+	}
+	if len(l.fields) == 0 {
+		return nil, fmt.Errorf("format has no field")
+	}
+	return l, nil
+}
+
+func parseUnpackType(t string, order binary.ByteOrder) (unpackField, error) {
+	f := unpackField{order: order}
+	lower := strings.ToLower(t)
+	if strings.HasSuffix(lower, "le") {
+		f.order = binary.LittleEndian
+		lower = lower[:len(lower)-2]
+	} else if strings.HasSuffix(lower, "be") {
+		f.order = binary.BigEndian
+		lower = lower[:len(lower)-2]
+	}
+
+	for _, k := range []string{"uint", "int", "float", "bool", "string", "bytes", "pad"} {
+		if !strings.HasPrefix(lower, k) {
+			continue
+		}
+		f.kind = k
+		n := lower[len(k):]
+		switch k {
+		case "bool":
+			f.size = 1
+			if n == "" {
+				return f, nil
+			}
+		case "int", "uint":
+			bits, err := strconv.Atoi(n)
+			if err == nil && (bits == 8 || bits == 16 || bits == 32 || bits == 64) {
+				f.size = bits / 8
+				return f, nil
+			}
+		case "float":
+			bits, err := strconv.Atoi(n)
+			if err == nil && (bits == 32 || bits == 64) {
+				f.size = bits / 8
+				return f, nil
+			}
+		default:
+			size, err := strconv.Atoi(n)
+			if err == nil && size > 0 && size <= maxUnpackSize {
+				f.size = size
+				return f, nil
+			}
+		}
+		return f, fmt.Errorf("invalid size of type %v: %v", k, t)
+	}
+	return f, fmt.Errorf("unsupported type: %v", t)
+}
+
+func (l *unpackLayout) unpack(b []byte) (data.Map, error) {
+	if len(b) < l.size {
+		return nil, fmt.Errorf("blob is shorter than the format: %v < %v bytes", len(b), l.size)
+	}
+	res := data.Map{}
+	for _, f := range l.fields {
+		v := b[:f.size]
+		b = b[f.size:]
+
+		switch f.kind {
+		case "int", "uint":
+			var u uint64
+			switch f.size {
+			case 1:
+				u = uint64(v[0])
+			case 2:
+				u = uint64(f.order.Uint16(v))
+			case 4:
+				u = uint64(f.order.Uint32(v))
+			case 8:
+				u = f.order.Uint64(v)
+			}
+			if f.kind == "uint" {
+				if u > math.MaxInt64 {
+					return nil, fmt.Errorf("%v cannot be represented as an Int: %v", f.name, u)
+				}
+				res[f.name] = data.Int(u)
+				continue
+			}
+			// sign extension
+			shift := uint(64 - 8*f.size)
+			res[f.name] = data.Int(int64(u<<shift) >> shift)
+		case "float":
+			if f.size == 4 {
+				res[f.name] = data.Float(math.Float32frombits(f.order.Uint32(v)))
+			} else {
+				res[f.name] = data.Float(math.Float64frombits(f.order.Uint64(v)))
+			}
+		case "bool":
+			res[f.name] = data.Bool(v[0] != 0)
+		case "string":
+			res[f.name] = data.String(strings.TrimRight(string(v), "\x00"))
+		case "bytes":
+			res[f.name] = data.Blob(append([]byte(nil), v...))
+		}
+	}
+	return res, nil
+}
+
+const maxCachedUnpackLayouts = 1024
+
+var (
+	unpackLayoutCacheMutex sync.RWMutex
+	unpackLayoutCache      = map[string]*unpackLayout{}
+)
+
+// compileUnpackLayoutCached compiles a format of the unpack function and
+// caches the result because unpack is usually called with the same format
+// for every tuple.
+func compileUnpackLayoutCached(format string) (*unpackLayout, error) {
+	unpackLayoutCacheMutex.RLock()
+	l, ok := unpackLayoutCache[format]
+	unpackLayoutCacheMutex.RUnlock()
+	if ok {
+		return l, nil
+	}
+
+	l, err := compileUnpackLayout(format)
+	if err != nil {
+		return nil, err
+	}
+
+	unpackLayoutCacheMutex.Lock()
+	defer unpackLayoutCacheMutex.Unlock()
+	if len(unpackLayoutCache) >= maxCachedUnpackLayouts {
+		unpackLayoutCache = map[string]*unpackLayout{}
+	}
+	unpackLayoutCache[format] = l
+	return l, nil
+}
+
+// unpackFunc(blob, format, [offset]) decodes a fixed-layout binary frame
+// into a map in a way similar to Python's struct.unpack, except that each
+// field has a name. Decoding starts at offset, which is 0 by default.
+// Bytes after the last field are ignored. For example,
+//
+//	unpack(payload, "< id:uint16 temp:int16 flags:uint8 _:pad1 name:string8")
+//
+// returns a map having "id", "temp", "flags", and "name" keys. The byte
+// order is big-endian unless the format starts with "<". See
+// compileUnpackLayout for the details of the format.
+//
+// It can be used in BQL as `unpack`.
+//
+//  Input: Blob, String, [Int]
+//  Return Type: Map
+var unpackFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 3 {
+			return nil, fmt.Errorf("function takes two or three arguments")
+		}
+		for _, a := range args {
+			if a.Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+		}
+		if args[0].Type() != data.TypeBlob {
+			return nil, fmt.Errorf("cannot interpret %s as a blob", args[0])
+		}
+		b, _ := data.AsBlob(args[0])
+		if args[1].Type() != data.TypeString {
+			return nil, fmt.Errorf("cannot interpret %s as a format", args[1])
+		}
+		format, _ := data.AsString(args[1])
+		if len(args) == 3 {
+			if args[2].Type() != data.TypeInt {
+				return nil, fmt.Errorf("cannot interpret %s as an offset", args[2])
+			}
+			offset, _ := data.AsInt(args[2])
+			if offset < 0 || offset > int64(len(b)) {
+				return nil, fmt.Errorf("offset is out of range: %v", offset)
+			}
+			b = b[offset:]
+		}
+
+		l, err := compileUnpackLayoutCached(format)
+		if err != nil {
+			return nil, err
+		}
+		return l.unpack(b)
+	},
+}
+
+// bitPositionArg converts an argument to a bit position between 0 and 63.
+func bitPositionArg(v data.Value) (uint, error) {
+	if v.Type() != data.TypeInt {
+		return 0, fmt.Errorf("cannot interpret %s as a bit position", v)
+	}
+	i, _ := data.AsInt(v)
+	if i < 0 || i > 63 {
+		return 0, fmt.Errorf("bit position must be between 0 and 63: %v", i)
+	}
+	return uint(i), nil
+}
+
+// bitGetFunc(x, n) returns true if the n-th bit of x is set. The least
+// significant bit is 0th. Negative values are treated as 64-bit two's
+// complement integers.
+//
+// It can be used in BQL as `bit_get`.
+//
+//  Input: Int, Int
+//  Return Type: Bool
+var bitGetFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, x, n data.Value) (data.Value, error) {
+	if x.Type() == data.TypeNull || n.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if x.Type() != data.TypeInt {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", x)
+	}
+	pos, err := bitPositionArg(n)
+	if err != nil {
+		return nil, err
+	}
+	i, _ := data.AsInt(x)
+	return data.Bool(uint64(i)>>pos&1 == 1), nil
+})
+
+// bitSliceFunc(x, from, length) returns the length bits of x starting from
+// the from-th bit as an unsigned integer. The least significant bit is 0th.
+// Negative values are treated as 64-bit two's complement integers. length
+// must be between 1 and 63 and from + length must not exceed 64.
+//
+// It can be used in BQL as `bit_slice`.
+//
+//  Input: Int, Int, Int
+//  Return Type: Int
+var bitSliceFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, x, from, length data.Value) (data.Value, error) {
+	if x.Type() == data.TypeNull || from.Type() == data.TypeNull || length.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if x.Type() != data.TypeInt {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", x)
+	}
+	pos, err := bitPositionArg(from)
+	if err != nil {
+		return nil, err
+	}
+	if length.Type() != data.TypeInt {
+		return nil, fmt.Errorf("cannot interpret %s as a length", length)
+	}
+	l, _ := data.AsInt(length)
+	if l < 1 || l > 63 || int64(pos)+l > 64 {
+		return nil, fmt.Errorf("invalid bit range: from %v, length %v", pos, l)
+	}
+	i, _ := data.AsInt(x)
+	return data.Int(uint64(i) >> pos & (1<<uint(l) - 1)), nil
+})
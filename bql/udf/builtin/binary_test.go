@@ -0,0 +1,220 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestUnpack(t *testing.T) {
+	frame := data.Blob{
+		0x01, 0x02, // uint16
+		0xff, 0xfe, // int16
+		0x80,                   // uint8
+		0x00,                   // padding
+		0x00, 0x00, 0xc0, 0x3f, // float32 little-endian (1.5)
+		'a', 'b', 0x00, 0x00, // string4
+		0x01, // bool
+	}
+
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"unpack", unpackFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("a:int8"), data.Null{}},
+			{frame, data.Null{}, data.Null{}},
+			{frame, data.String("a:uint16 b:int16 c:uint8 _:pad1 d:float32le e:string4 f:bool"), data.Map{
+				"a": data.Int(0x0102),
+				"b": data.Int(-2),
+				"c": data.Int(0x80),
+				"d": data.Float(1.5),
+				"e": data.String("ab"),
+				"f": data.Bool(true),
+			}},
+			{frame, data.String("< a:uint16 b:int16be c:int8"), data.Map{
+				"a": data.Int(0x0201),
+				"b": data.Int(-2),
+				"c": data.Int(-128),
+			}},
+			{frame, data.String("!a:uint32 b:bytes2"), data.Map{
+				"a": data.Int(0x0102fffe),
+				"b": data.Blob{0x80, 0x00},
+			}},
+			{data.Blob{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, data.String("a:int64"),
+				data.Map{"a": data.Int(-2)}},
+			{data.Blob{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, data.String("a:uint64"),
+				data.Map{"a": data.Int(math.MaxInt64)}},
+			{data.Blob{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, data.String("a:float64"),
+				data.Map{"a": data.Float(1.5)}},
+			{data.Blob{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, data.String("a:uint64"), nil},
+			{frame, data.String("a:bytes16"), nil},
+			{frame, data.String(""), nil},
+			{frame, data.String("a"), nil},
+			{frame, data.String("a:int12"), nil},
+			{frame, data.String("a:float16"), nil},
+			{frame, data.String("a:string0"), nil},
+			{frame, data.String("a:string9223372036854775807 b:int8"), nil},
+			{frame, data.String("a:bytes1048576 _:pad1"), nil},
+			{frame, data.String("a:char"), nil},
+			{frame, data.String(":int8"), nil},
+			{frame, data.String("a:int8 a:int8"), nil},
+			{data.String("AQI="), data.String("a:int8"), nil},
+			{frame, data.Int(1), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %s (%T) and %s (%T)", i,
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given the unpack function with an offset", t, func() {
+		Convey("When the offset is in the blob", func() {
+			v, err := unpackFunc.Call(nil, frame, data.String("a:uint8"), data.Int(4))
+
+			Convey("Then it should decode from the offset", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Map{"a": data.Int(0x80)})
+			})
+		})
+
+		Convey("When the offset is out of range", func() {
+			_, err1 := unpackFunc.Call(nil, frame, data.String("a:uint8"), data.Int(-1))
+			_, err2 := unpackFunc.Call(nil, frame, data.String("a:uint8"), data.Int(100))
+			_, err3 := unpackFunc.Call(nil, frame, data.String("a:uint8"), data.Int(len(frame)))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestBitFuncs(t *testing.T) {
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"bit_get", bitGetFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(0), data.Null{}},
+			{data.Int(5), data.Null{}, data.Null{}},
+			{data.Int(5), data.Int(0), data.Bool(true)},
+			{data.Int(5), data.Int(1), data.Bool(false)},
+			{data.Int(5), data.Int(2), data.Bool(true)},
+			{data.Int(-1), data.Int(63), data.Bool(true)},
+			{data.Int(5), data.Int(64), nil},
+			{data.Int(5), data.Int(-1), nil},
+			{data.Float(5), data.Int(0), nil},
+			{data.Int(5), data.Float(0), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %s (%T) and %s (%T)", i,
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	udf3aryTestCases := []udf3aryTestCase{
+		{"bit_slice", bitSliceFunc, []udf3aryTestCaseInput{
+			{data.Null{}, data.Int(0), data.Int(1), data.Null{}},
+			{data.Int(0xabcd), data.Null{}, data.Int(1), data.Null{}},
+			{data.Int(0xabcd), data.Int(0), data.Null{}, data.Null{}},
+			{data.Int(0xabcd), data.Int(0), data.Int(4), data.Int(0xd)},
+			{data.Int(0xabcd), data.Int(4), data.Int(8), data.Int(0xbc)},
+			{data.Int(0xabcd), data.Int(12), data.Int(8), data.Int(0xa)},
+			{data.Int(-1), data.Int(60), data.Int(4), data.Int(0xf)},
+			{data.Int(-1), data.Int(1), data.Int(63), data.Int(math.MaxInt64)},
+			{data.Int(1), data.Int(0), data.Int(0), nil},
+			{data.Int(1), data.Int(0), data.Int(64), nil},
+			{data.Int(1), data.Int(60), data.Int(5), nil},
+			{data.Int(1), data.Int(0), data.Float(1), nil},
+			{data.String("1"), data.Int(0), data.Int(1), nil},
+		}},
+	}
+
+	for _, testCase := range udf3aryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %#v", i,
+					[]data.Value{tc.input1, tc.input2, tc.input3}), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2, tc.input3)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
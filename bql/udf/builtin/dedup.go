@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"container/heap"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sync"
+	"time"
+)
+
+type dedupEntry struct {
+	key     string
+	expires time.Time
+	index   int // the index in dedupQueue
+}
+
+// dedupQueue is a priority queue of entries ordered by their expiration
+// times. It implements heap.Interface.
+type dedupQueue []*dedupEntry
+
+func (q dedupQueue) Len() int           { return len(q) }
+func (q dedupQueue) Less(i, j int) bool { return q[i].expires.Before(q[j].expires) }
+
+func (q dedupQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *dedupQueue) Push(x interface{}) {
+	e := x.(*dedupEntry)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *dedupQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return e
+}
+
+type dedupUDSF struct {
+	keyPath data.Path
+	horizon time.Duration
+
+	m    sync.Mutex
+	keys map[string]*dedupEntry
+
+	// order has entries in the order they expire. Because tuples can arrive
+	// out of order, it isn't the order in which they were added.
+	order dedupQueue
+
+	// latest is the latest timestamp of the input tuples. Entries expired
+	// at the time are evicted.
+	latest time.Time
+}
+
+func (d *dedupUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	k, err := t.Data.Get(d.keyPath)
+	if err != nil {
+		return err
+	}
+	key := k.String()
+
+	d.m.Lock()
+	if t.Timestamp.After(d.latest) {
+		d.latest = t.Timestamp
+		d.evict()
+	}
+	if e, ok := d.keys[key]; ok {
+		if t.Timestamp.Before(e.expires) {
+			d.m.Unlock()
+			return nil
+		}
+		e.expires = t.Timestamp.Add(d.horizon)
+		heap.Fix(&d.order, e.index)
+	} else {
+		e := &dedupEntry{
+			key:     key,
+			expires: t.Timestamp.Add(d.horizon),
+		}
+		heap.Push(&d.order, e)
+		d.keys[key] = e
+	}
+	d.m.Unlock()
+
+	return w.Write(ctx, t)
+}
+
+// evict removes expired entries. The caller must hold the lock.
+func (d *dedupUDSF) evict() {
+	for len(d.order) > 0 {
+		e := d.order[0]
+		if d.latest.Before(e.expires) {
+			return
+		}
+		heap.Pop(&d.order)
+		delete(d.keys, e.key)
+	}
+}
+
+func (d *dedupUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+// createDedupUDSF creates a UDSF forwarding only the first tuple of each key
+// within the horizon. The key is the value at the path keyPath, and the
+// horizon is the number of seconds measured by timestamps of tuples. A
+// tuple having the same key as a forwarded tuple is dropped unless its
+// timestamp is horizon seconds or more later than the forwarded one. Keys
+// are forgotten once their horizon has passed the latest timestamp, so the
+// memory usage is bounded by the number of distinct keys in a horizon.
+//
+// It can be used in BQL as `dedup`.
+//
+//	SELECT RSTREAM * FROM dedup("messages", "message_id", 60) [RANGE 1 TUPLES];
+func createDedupUDSF(decl udf.UDSFDeclarer, stream, keyPath string, horizon float64) (udf.UDSF, error) {
+	if !(horizon > 0) {
+		return nil, fmt.Errorf("horizon must be positive: %v", horizon)
+	}
+	// A larger horizon overflows time.Duration.
+	if max := float64(math.MaxInt64 / int64(time.Second)); horizon > max {
+		return nil, fmt.Errorf("horizon must not be greater than %v: %v", max, horizon)
+	}
+	p, err := data.CompilePath(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	return &dedupUDSF{
+		keyPath: p,
+		horizon: time.Duration(horizon * float64(time.Second)),
+		keys:    map[string]*dedupEntry{},
+	}, nil
+}
@@ -0,0 +1,96 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestDedupUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a dedup UDSF", t, func() {
+		f, decl, err := createSignalUDSF(ctx, "dedup", data.String("s"), data.String("id"), data.Int(10))
+		So(err, ShouldBeNil)
+		So(decl.ListInputs(), ShouldContainKey, "s")
+
+		Convey("When processing tuples", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(0, data.Map{"id": data.String("a"), "n": data.Int(0)}),
+				signalTuple(1, data.Map{"id": data.String("b"), "n": data.Int(1)}),
+				signalTuple(2, data.Map{"id": data.String("a"), "n": data.Int(2)}),
+				signalTuple(9.5, data.Map{"id": data.String("a"), "n": data.Int(3)}),
+				signalTuple(10, data.Map{"id": data.String("a"), "n": data.Int(4)}),
+				signalTuple(11, data.Map{"id": data.String("b"), "n": data.Int(5)}),
+				signalTuple(12, data.Map{"id": data.String("a"), "n": data.Int(6)}),
+				signalTuple(12, data.Map{"id": data.Int(1), "n": data.Int(7)}),
+				signalTuple(30, data.Map{"id": data.String("c"), "n": data.Int(8)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should forward the first tuple of each key in the horizon", func() {
+				ns := []data.Value{}
+				for _, t := range res {
+					ns = append(ns, t.Data["n"])
+				}
+				So(ns, ShouldResemble, []data.Value{data.Int(0), data.Int(1), data.Int(4), data.Int(5), data.Int(7), data.Int(8)})
+			})
+
+			Convey("Then it should forget expired keys", func() {
+				d := f.(*dedupUDSF)
+				So(len(d.keys), ShouldEqual, 1)
+				So(d.keys, ShouldContainKey, `"c"`)
+				So(len(d.order), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When processing tuples out of order", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(5, data.Map{"id": data.String("a"), "n": data.Int(0)}),
+				signalTuple(0, data.Map{"id": data.String("b"), "n": data.Int(1)}),
+				signalTuple(12, data.Map{"id": data.String("c"), "n": data.Int(2)}),
+				signalTuple(13, data.Map{"id": data.String("a"), "n": data.Int(3)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should forward the first tuple of each key in the horizon", func() {
+				ns := []data.Value{}
+				for _, t := range res {
+					ns = append(ns, t.Data["n"])
+				}
+				So(ns, ShouldResemble, []data.Value{data.Int(0), data.Int(1), data.Int(2)})
+			})
+
+			Convey("Then it should forget keys expired earlier than others", func() {
+				d := f.(*dedupUDSF)
+				So(len(d.keys), ShouldEqual, 2)
+				So(d.keys, ShouldContainKey, `"a"`)
+				So(d.keys, ShouldContainKey, `"c"`)
+				So(len(d.order), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When processing a tuple without the key", func() {
+			_, err := processSignal(ctx, f, signalTuple(0, data.Map{"n": data.Int(0)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid arguments", t, func() {
+		Convey("Then dedup should fail", func() {
+			_, _, err := createSignalUDSF(ctx, "dedup", data.String("s"), data.String("id"), data.Int(0))
+			So(err, ShouldNotBeNil)
+			_, _, err = createSignalUDSF(ctx, "dedup", data.String("s"), data.String("id["), data.Int(10))
+			So(err, ShouldNotBeNil)
+			_, _, err = createSignalUDSF(ctx, "dedup", data.String("s"), data.String("id"), data.Float(1e10))
+			So(err, ShouldNotBeNil)
+			_, _, err = createSignalUDSF(ctx, "dedup", data.String("s"), data.String("id"), data.Float(math.NaN()))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
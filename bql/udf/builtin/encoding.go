@@ -0,0 +1,329 @@
+package builtin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+)
+
+// bytesArg converts an argument to a byte slice. Unlike data.ToBlob, a
+// String is converted to its raw UTF-8 bytes instead of being decoded as
+// base64. The second return value is false if the argument is Null.
+func bytesArg(v data.Value) ([]byte, bool, error) {
+	switch v.Type() {
+	case data.TypeNull:
+		return nil, false, nil
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return []byte(s), true, nil
+	case data.TypeBlob:
+		b, _ := data.AsBlob(v)
+		return b, true, nil
+	}
+	return nil, false, fmt.Errorf("%v is neither a string nor a blob", v)
+}
+
+// bytesFunc returns a UDF applying f to a String or a Blob. It returns
+// Null when the argument is Null.
+func bytesFunc(f func(b []byte) (data.Value, error)) udf.UDF {
+	return udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+		b, ok, err := bytesArg(arg)
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		return f(b)
+	})
+}
+
+// base64EncodeFunc encodes a string or a blob in standard base64 with
+// padding.
+//
+// It can be used in BQL as `base64_encode`.
+//
+//  Input: String or Blob
+//  Return Type: String
+var base64EncodeFunc = bytesFunc(func(b []byte) (data.Value, error) {
+	return data.String(base64.StdEncoding.EncodeToString(b)), nil
+})
+
+// base64DecodeFunc decodes a base64 string into a blob. Both the standard
+// and the URL-safe alphabets are accepted, with or without padding.
+// Whitespace and newlines are ignored.
+//
+// It can be used in BQL as `base64_decode`.
+//
+//  Input: String or Blob
+//  Return Type: Blob
+var base64DecodeFunc = bytesFunc(func(b []byte) (data.Value, error) {
+	s := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		case '-':
+			return '+'
+		case '_':
+			return '/'
+		}
+		return r
+	}, string(b))
+	res, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return data.Blob(res), nil
+})
+
+// hexEncodeFunc encodes a string or a blob in lower-case hexadecimal.
+//
+// It can be used in BQL as `hex_encode`.
+//
+//  Input: String or Blob
+//  Return Type: String
+var hexEncodeFunc = bytesFunc(func(b []byte) (data.Value, error) {
+	return data.String(hex.EncodeToString(b)), nil
+})
+
+// hexDecodeFunc decodes a hexadecimal string into a blob. Both upper and
+// lower case letters are accepted.
+//
+// It can be used in BQL as `hex_decode`.
+//
+//  Input: String or Blob
+//  Return Type: Blob
+var hexDecodeFunc = bytesFunc(func(b []byte) (data.Value, error) {
+	res := make([]byte, hex.DecodedLen(len(b)))
+	if _, err := hex.Decode(res, b); err != nil {
+		return nil, err
+	}
+	return data.Blob(res), nil
+})
+
+// urlEncodeFunc escapes a string so that it can be safely placed in a URL
+// query. Spaces are converted to "+".
+// See also: net/url.QueryEscape
+//
+// It can be used in BQL as `url_encode`.
+//
+//  Input: String
+//  Return Type: String
+var urlEncodeFunc udf.UDF = &singleParamStringFunc{
+	strFun: func(s string) data.Value {
+		return data.String(url.QueryEscape(s))
+	},
+}
+
+// urlDecodeFunc converts a string escaped by url_encode back to the
+// original string. It returns an error when the string has a malformed
+// escape sequence.
+// See also: net/url.QueryUnescape
+//
+// It can be used in BQL as `url_decode`.
+//
+//  Input: String
+//  Return Type: String
+var urlDecodeFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if arg.Type() != data.TypeString {
+		return nil, fmt.Errorf("cannot interpret %s as a string", arg)
+	}
+	s, _ := data.AsString(arg)
+	res, err := url.QueryUnescape(s)
+	if err != nil {
+		return nil, err
+	}
+	return data.String(res), nil
+})
+
+// crc32Func computes the CRC-32 checksum of a string or a blob with the
+// IEEE polynomial.
+// See also: hash/crc32.ChecksumIEEE
+//
+// It can be used in BQL as `crc32`.
+//
+//  Input: String or Blob
+//  Return Type: Int
+var crc32Func = bytesFunc(func(b []byte) (data.Value, error) {
+	return data.Int(crc32.ChecksumIEEE(b)), nil
+})
+
+var hmacHashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// hmacFunc(algo, key, msg) computes the HMAC of msg with key in hexadecimal
+// format. algo must be one of "md5", "sha1", "sha256", "sha384", and
+// "sha512".
+// See also: crypto/hmac
+//
+// It can be used in BQL as `hmac`.
+//
+//  Input: String, String or Blob, String or Blob
+//  Return Type: String
+var hmacFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, algo, key, msg data.Value) (data.Value, error) {
+	if algo.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if algo.Type() != data.TypeString {
+		return nil, fmt.Errorf("cannot interpret %s as a hash algorithm", algo)
+	}
+	a, _ := data.AsString(algo)
+	h, ok := hmacHashes[strings.ToLower(a)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hash algorithm: %v", a)
+	}
+	k, ok, err := bytesArg(key)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	m, ok, err := bytesArg(msg)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+
+	mac := hmac.New(h, k)
+	mac.Write(m)
+	return data.String(hex.EncodeToString(mac.Sum(nil))), nil
+})
+
+// uuidV4Func returns a random UUID (version 4) in the canonical textual
+// representation.
+//
+// It can be used in BQL as `uuid_v4`.
+//
+//  Input: None
+//  Return Type: String
+var uuidV4Func = udf.MustConvertGeneric(func() (string, error) {
+	var u [16]byte
+	if _, err := io.ReadFull(rand.Reader, u[:]); err != nil {
+		return "", err
+	}
+	u[6] = u[6]&0x0f | 0x40 // version 4
+	u[8] = u[8]&0x3f | 0x80 // variant RFC 4122
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:]), nil
+})
+
+// compressionFormatArg returns the compression format given as the second
+// argument of compress and decompress. It's "gzip" when omitted.
+func compressionFormatArg(args []data.Value) (string, bool, error) {
+	if len(args) < 2 {
+		return "gzip", true, nil
+	} else if len(args) > 2 {
+		return "", false, fmt.Errorf("function takes one or two arguments")
+	}
+	if args[1].Type() == data.TypeNull {
+		return "", false, nil
+	} else if args[1].Type() != data.TypeString {
+		return "", false, fmt.Errorf("cannot interpret %s as a compression format", args[1])
+	}
+	f, _ := data.AsString(args[1])
+	switch f = strings.ToLower(f); f {
+	case "gzip", "zlib":
+		return f, true, nil
+	}
+	return "", false, fmt.Errorf("unsupported compression format: %v", f)
+}
+
+// compressFunc(data, [format]) compresses a string or a blob. format can be
+// "gzip" or "zlib" and is "gzip" by default.
+//
+// It can be used in BQL as `compress`.
+//
+//  Input: String or Blob, [String]
+//  Return Type: Blob
+var compressFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		format, ok, err := compressionFormatArg(args)
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		b, ok, err := bytesArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+
+		buf := bytes.NewBuffer(nil)
+		var w io.WriteCloser
+		if format == "gzip" {
+			w = gzip.NewWriter(buf)
+		} else {
+			w = zlib.NewWriter(buf)
+		}
+		if _, err := w.Write(b); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return data.Blob(buf.Bytes()), nil
+	},
+}
+
+// maxDecompressedSize is the maximum size of a blob decompress returns.
+const maxDecompressedSize = 64 * 1024 * 1024
+
+// decompressFunc(data, [format]) decompresses a blob compressed in format,
+// which can be "gzip" or "zlib" and is "gzip" by default. Use
+// blob_to_raw_string to convert the result to a string. It returns an
+// error when the decompressed blob is larger than maxDecompressedSize
+// bytes.
+//
+// It can be used in BQL as `decompress`.
+//
+//  Input: Blob, [String]
+//  Return Type: Blob
+var decompressFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		format, ok, err := compressionFormatArg(args)
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		b, ok, err := bytesArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+
+		var r io.ReadCloser
+		if format == "gzip" {
+			r, err = gzip.NewReader(bytes.NewReader(b))
+		} else {
+			r, err = zlib.NewReader(bytes.NewReader(b))
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		// One more byte is read to detect that the data is too large.
+		res, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(res) > maxDecompressedSize {
+			return nil, fmt.Errorf("the decompressed data must not be larger than %v bytes", maxDecompressedSize)
+		}
+		return data.Blob(res), nil
+	},
+}
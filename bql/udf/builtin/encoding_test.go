@@ -0,0 +1,283 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestUnaryEncodingFuncs(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+
+	invalidInputs := []udfUnaryTestCaseInput{
+		// NULL input -> NULL output
+		{data.Null{}, data.Null{}},
+		// cannot process the following
+		{data.Array{}, nil},
+		{data.Bool(true), nil},
+		{data.Float(1.0), nil},
+		{data.Int(1), nil},
+		{data.Map{}, nil},
+		{data.Timestamp(someTime), nil},
+	}
+
+	payload := data.Blob{0x01, 0xff, 0x00, 0x7e}
+	udfUnaryTestCases := []udfUnaryTestCase{
+		{"base64_encode", base64EncodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.String("")},
+			{data.String("hello"), data.String("aGVsbG8=")},
+			{payload, data.String("Af8Afg==")},
+		}},
+		{"base64_decode", base64DecodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.Blob{}},
+			{data.String("aGVsbG8="), data.Blob("hello")},
+			{data.String("aGVsbG8"), data.Blob("hello")},
+			{data.String("aGVs\nbG8="), data.Blob("hello")},
+			{data.String("Af8Afg=="), payload},
+			{data.Blob("Af8Afg"), payload},
+			{data.String("-_8="), data.Blob{0xfb, 0xff}},
+			{data.String("+/8="), data.Blob{0xfb, 0xff}},
+			{data.String("a"), nil},
+			{data.String("!!!!"), nil},
+		}},
+		{"hex_encode", hexEncodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.String("")},
+			{data.String("hi"), data.String("6869")},
+			{payload, data.String("01ff007e")},
+		}},
+		{"hex_decode", hexDecodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.Blob{}},
+			{data.String("01ff007e"), payload},
+			{data.String("01FF007E"), payload},
+			{data.String("01f"), nil},
+			{data.String("zz"), nil},
+		}},
+		{"url_encode", urlEncodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.String("")},
+			{data.String("a b&c=d/é"), data.String("a+b%26c%3Dd%2F%C3%A9")},
+			{data.Blob("a"), nil},
+		}},
+		{"url_decode", urlDecodeFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.String("")},
+			{data.String("a+b%26c%3Dd%2F%C3%A9"), data.String("a b&c=d/é")},
+			{data.String("%zz"), nil},
+			{data.Blob("a"), nil},
+		}},
+		{"crc32", crc32Func, []udfUnaryTestCaseInput{
+			{data.String(""), data.Int(0)},
+			{data.String("123456789"), data.Int(0xcbf43926)},
+			{data.Blob("123456789"), data.Int(0xcbf43926)},
+		}},
+		{"decompress", decompressFunc, []udfUnaryTestCaseInput{
+			{data.Blob("not compressed"), nil},
+		}},
+	}
+
+	for _, testCase := range udfUnaryTestCases {
+		f := testCase.f
+		allInputs := append(testCase.inputs, invalidInputs...)
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %s (%T)", i, tc.input, tc.input), func() {
+					val, err := f.Call(nil, tc.input)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 1)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestHMAC(t *testing.T) {
+	msg := data.String("The quick brown fox jumps over the lazy dog")
+	udf3aryTestCases := []udf3aryTestCase{
+		{"hmac", hmacFunc, []udf3aryTestCaseInput{
+			{data.String("md5"), data.String("key"), msg,
+				data.String("80070713463e7749b90c2dc24911e275")},
+			{data.String("SHA1"), data.String("key"), msg,
+				data.String("de7c9b85b8b78aa6bc8a7a36f70a90701c9db4d9")},
+			{data.String("sha256"), data.Blob("key"), msg,
+				data.String("f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8")},
+			{data.Null{}, data.String("key"), msg, data.Null{}},
+			{data.String("sha256"), data.Null{}, msg, data.Null{}},
+			{data.String("sha256"), data.String("key"), data.Null{}, data.Null{}},
+			{data.String("crc32"), data.String("key"), msg, nil},
+			{data.Int(1), data.String("key"), msg, nil},
+			{data.String("sha256"), data.Int(1), msg, nil},
+			{data.String("sha256"), data.String("key"), data.Map{}, nil},
+		}},
+	}
+
+	for _, testCase := range udf3aryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %#v", i,
+					[]data.Value{tc.input1, tc.input2, tc.input3}), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2, tc.input3)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	Convey("Given a blob", t, func() {
+		b := data.Blob("sensor data sensor data sensor data sensor data")
+
+		for _, format := range []string{"gzip", "zlib"} {
+			format := format
+
+			Convey(fmt.Sprintf("When compressing it with %v", format), func() {
+				c, err := compressFunc.Call(nil, b, data.String(format))
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be decompressed to the original blob", func() {
+					d, err := decompressFunc.Call(nil, c, data.String(format))
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, b)
+				})
+			})
+		}
+
+		Convey("When compressing it with the default format", func() {
+			c, err := compressFunc.Call(nil, b)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be gzip", func() {
+				cb, _ := data.AsBlob(c)
+				So(cb[:2], ShouldResemble, []byte{0x1f, 0x8b})
+
+				d, err := decompressFunc.Call(nil, c)
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, b)
+			})
+
+			Convey("Then decompressing it as zlib should fail", func() {
+				_, err := decompressFunc.Call(nil, c, data.String("zlib"))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When compressing a string", func() {
+			c, err := compressFunc.Call(nil, data.String(string(b)))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be the same as compressing the blob", func() {
+				d, err := decompressFunc.Call(nil, c)
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, b)
+			})
+		})
+
+		Convey("When decompressing a blob larger than the limit", func() {
+			c, err := compressFunc.Call(nil, make(data.Blob, maxDecompressedSize+1))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				_, err := decompressFunc.Call(nil, c)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When passing Null", func() {
+			v1, err1 := compressFunc.Call(nil, data.Null{})
+			v2, err2 := compressFunc.Call(nil, b, data.Null{})
+
+			Convey("Then it should return Null", func() {
+				So(err1, ShouldBeNil)
+				So(v1, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(v2, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			_, err1 := compressFunc.Call(nil, b, data.String("lz4"))
+			_, err2 := compressFunc.Call(nil, data.Int(1))
+			_, err3 := decompressFunc.Call(nil, b, data.Int(1))
+			_, err4 := compressFunc.Call(nil, b, data.String("gzip"), data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then the functions should equal the ones in the default registry", func() {
+			for name, f := range map[string]udf.UDF{"compress": compressFunc, "decompress": decompressFunc} {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			}
+		})
+	})
+}
+
+func TestUUIDv4(t *testing.T) {
+	Convey("Given the uuid_v4 function", t, func() {
+		f, err := udf.CopyGlobalUDFRegistry(nil).Lookup("uuid_v4", 0)
+		So(err, ShouldBeNil)
+
+		Convey("When generating UUIDs", func() {
+			v1, err1 := f.Call(nil)
+			v2, err2 := f.Call(nil)
+			So(err1, ShouldBeNil)
+			So(err2, ShouldBeNil)
+
+			Convey("Then they should be version 4 UUIDs", func() {
+				re := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+				s, _ := data.AsString(v1)
+				So(re.MatchString(s), ShouldBeTrue)
+			})
+
+			Convey("Then they should be different", func() {
+				So(v1, ShouldNotResemble, v2)
+			})
+		})
+	})
+}
@@ -0,0 +1,356 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strings"
+)
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// geoFuncTmpl is a template for geospatial functions having a fixed
+// number of numeric parameters. It returns Null if any argument is Null.
+type geoFuncTmpl struct {
+	arity  int
+	geoFun func(args []float64) data.Value
+}
+
+func (f *geoFuncTmpl) Accept(arity int) bool {
+	return arity == f.arity
+}
+
+func (f *geoFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *geoFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != f.arity {
+		return nil, fmt.Errorf("function takes exactly %d arguments", f.arity)
+	}
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	vals, err := collectFloats(args)
+	if err != nil {
+		return nil, err
+	}
+	return f.geoFun(vals), nil
+}
+
+// geoDistanceFunc(lat1, lon1, lat2, lon2) computes the great-circle
+// distance in meters between two points given in degrees with the
+// haversine formula.
+//
+// It can be used in BQL as `geo_distance`.
+//
+//  Input: 4 * Int or Float
+//  Return Type: Float
+var geoDistanceFunc udf.UDF = &geoFuncTmpl{
+	arity: 4,
+	geoFun: func(args []float64) data.Value {
+		lat1, lat2 := toRadians(args[0]), toRadians(args[2])
+		dLat := lat2 - lat1
+		dLon := toRadians(args[3] - args[1])
+		a := math.Pow(math.Sin(dLat/2), 2) +
+			math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+		return data.Float(2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a))))
+	},
+}
+
+// geoBearingFunc(lat1, lon1, lat2, lon2) computes the initial bearing in
+// degrees from the first point to the second point. The result is in
+// [0, 360) where 0 means north and 90 means east.
+//
+// It can be used in BQL as `geo_bearing`.
+//
+//  Input: 4 * Int or Float
+//  Return Type: Float
+var geoBearingFunc udf.UDF = &geoFuncTmpl{
+	arity: 4,
+	geoFun: func(args []float64) data.Value {
+		lat1, lat2 := toRadians(args[0]), toRadians(args[2])
+		dLon := toRadians(args[3] - args[1])
+		y := math.Sin(dLon) * math.Cos(lat2)
+		x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+		b := math.Mod(toDegrees(math.Atan2(y, x))+360, 360)
+		return data.Float(b)
+	},
+}
+
+// geoBBoxContainsFunc(min_lat, min_lon, max_lat, max_lon, lat, lon) returns
+// true if the point is in the bounding box, including its boundary. When
+// min_lon is greater than max_lon, the box is regarded as crossing the
+// antimeridian.
+//
+// It can be used in BQL as `geo_bbox_contains`.
+//
+//  Input: 6 * Int or Float
+//  Return Type: Bool
+var geoBBoxContainsFunc udf.UDF = &geoFuncTmpl{
+	arity: 6,
+	geoFun: func(args []float64) data.Value {
+		minLat, minLon, maxLat, maxLon, lat, lon := args[0], args[1], args[2], args[3], args[4], args[5]
+		if lat < minLat || lat > maxLat {
+			return data.Bool(false)
+		}
+		if minLon <= maxLon {
+			return data.Bool(minLon <= lon && lon <= maxLon)
+		}
+		return data.Bool(lon >= minLon || lon <= maxLon)
+	},
+}
+
+const (
+	geohashAlphabet         = "0123456789bcdefghjkmnpqrstuvwxyz"
+	geohashDefaultPrecision = 12
+)
+
+func encodeGeohash(lat, lon float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+	res := make([]byte, precision)
+	even := true
+	for i := range res {
+		idx := 0
+		for bit := 0; bit < 5; bit++ {
+			r, v := &latRange, lat
+			if even {
+				r, v = &lonRange, lon
+			}
+			mid := (r[0] + r[1]) / 2
+			idx <<= 1
+			if v >= mid {
+				idx |= 1
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+		res[i] = geohashAlphabet[idx]
+	}
+	return string(res)
+}
+
+func decodeGeohash(hash string) (lat, lon float64, err error) {
+	if hash == "" {
+		return 0, 0, fmt.Errorf("geohash is empty")
+	}
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		idx := strings.IndexRune(geohashAlphabet, c)
+		if idx < 0 {
+			return 0, 0, fmt.Errorf("invalid geohash character: %q", c)
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &latRange
+			if even {
+				r = &lonRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if idx>>uint(bit)&1 == 1 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return (latRange[0] + latRange[1]) / 2, (lonRange[0] + lonRange[1]) / 2, nil
+}
+
+// geohashEncodeFunc(lat, lon, [precision]) encodes a point into a geohash
+// having precision characters. precision must be between 1 and 12 and is
+// 12 by default.
+//
+// It can be used in BQL as `geohash_encode`.
+//
+//  Input: Int or Float, Int or Float, [Int]
+//  Return Type: String
+var geohashEncodeFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 3 {
+			return nil, fmt.Errorf("function takes two or three arguments")
+		}
+		for _, a := range args {
+			if a.Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+		}
+		vals, err := collectFloats(args[:2])
+		if err != nil {
+			return nil, err
+		}
+		lat, lon := vals[0], vals[1]
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return nil, fmt.Errorf("invalid coordinates: (%v, %v)", lat, lon)
+		}
+		precision := int64(geohashDefaultPrecision)
+		if len(args) == 3 {
+			if args[2].Type() != data.TypeInt {
+				return nil, fmt.Errorf("cannot interpret %s as a precision", args[2])
+			}
+			precision, _ = data.AsInt(args[2])
+			if precision < 1 || precision > 12 {
+				return nil, fmt.Errorf("precision must be between 1 and 12: %v", precision)
+			}
+		}
+		return data.String(encodeGeohash(lat, lon, int(precision))), nil
+	},
+}
+
+// geohashDecodeFunc decodes a geohash into the center point of its cell
+// as a map having "lat" and "lon" keys.
+//
+// It can be used in BQL as `geohash_decode`.
+//
+//  Input: String
+//  Return Type: Map
+var geohashDecodeFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if arg.Type() != data.TypeString {
+		return nil, fmt.Errorf("cannot interpret %s as a geohash", arg)
+	}
+	s, _ := data.AsString(arg)
+	lat, lon, err := decodeGeohash(s)
+	if err != nil {
+		return nil, err
+	}
+	return data.Map{"lat": data.Float(lat), "lon": data.Float(lon)}, nil
+})
+
+// geoPointArg converts a point given as an array [lat, lon] or a map
+// {"lat": lat, "lon": lon} to a pair of floats.
+func geoPointArg(v data.Value) (lat, lon float64, err error) {
+	var vs []data.Value
+	switch v.Type() {
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		if len(a) != 2 {
+			return 0, 0, fmt.Errorf("point must be [lat, lon]: %v", v)
+		}
+		vs = a
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		la, ok1 := m["lat"]
+		lo, ok2 := m["lon"]
+		if !ok1 || !ok2 {
+			return 0, 0, fmt.Errorf("point must have lat and lon: %v", v)
+		}
+		vs = []data.Value{la, lo}
+	default:
+		return 0, 0, fmt.Errorf("cannot interpret %s as a point", v)
+	}
+	if vs[0].Type() == data.TypeNull || vs[1].Type() == data.TypeNull {
+		return 0, 0, fmt.Errorf("point must not have Null: %v", v)
+	}
+	fs, err := collectFloats(vs)
+	if err != nil {
+		return 0, 0, err
+	}
+	return fs[0], fs[1], nil
+}
+
+// geoWithinPolygonFunc(lat, lon, polygon) returns true if the point is
+// inside the polygon. The polygon is an array of at least three vertices,
+// each of which is an array [lat, lon] or a map {"lat": lat, "lon": lon}.
+// Note that the order of an array is lat, lon unlike GeoJSON. The polygon
+// doesn't have to be closed. Edges are regarded as straight lines in the
+// lat/lon plane, which is accurate enough for geofences of usual sizes.
+//
+// It can be used in BQL as `geo_within_polygon`.
+//
+//  Input: Int or Float, Int or Float, Array
+//  Return Type: Bool
+var geoWithinPolygonFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, latV, lonV, polygon data.Value) (data.Value, error) {
+	if latV.Type() == data.TypeNull || lonV.Type() == data.TypeNull || polygon.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	vals, err := collectFloats([]data.Value{latV, lonV})
+	if err != nil {
+		return nil, err
+	}
+	lat, lon := vals[0], vals[1]
+
+	vertices, ok, err := arrayArg(polygon)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	if len(vertices) < 3 {
+		return nil, fmt.Errorf("polygon must have at least three vertices")
+	}
+	lats := make([]float64, len(vertices))
+	lons := make([]float64, len(vertices))
+	for i, v := range vertices {
+		if lats[i], lons[i], err = geoPointArg(v); err != nil {
+			return nil, err
+		}
+	}
+
+	// ray casting
+	inside := false
+	for i, j := 0, len(vertices)-1; i < len(vertices); j, i = i, i+1 {
+		if (lats[i] > lat) != (lats[j] > lat) &&
+			lon < (lons[j]-lons[i])*(lat-lats[i])/(lats[j]-lats[i])+lons[i] {
+			inside = !inside
+		}
+	}
+	return data.Bool(inside), nil
+})
+
+// geoCentroidFunc(lat, lon) is an aggregate function that computes the
+// geographic center of the input points as a map having "lat" and "lon"
+// keys. Points are averaged as 3D vectors so that points around the
+// antimeridian are handled correctly. Pairs where either value is null
+// are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `geo_centroid`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Map (Null on empty input or if the center is undefined)
+var geoCentroidFunc udf.UDF = &twoParamAggFunc{
+	aggFun: func(lats []data.Value, lons []data.Value) (data.Value, error) {
+		latVals, lonVals, err := collectFloatPairs(lats, lons)
+		if err != nil {
+			return nil, err
+		}
+		if len(latVals) == 0 {
+			return data.Null{}, nil
+		}
+		var x, y, z float64
+		for i := range latVals {
+			lat, lon := toRadians(latVals[i]), toRadians(lonVals[i])
+			x += math.Cos(lat) * math.Cos(lon)
+			y += math.Cos(lat) * math.Sin(lon)
+			z += math.Sin(lat)
+		}
+		n := float64(len(latVals))
+		x, y, z = x/n, y/n, z/n
+		hyp := math.Sqrt(x*x + y*y)
+		if hyp < 1e-12 && math.Abs(z) < 1e-12 {
+			return data.Null{}, nil
+		}
+		return data.Map{
+			"lat": data.Float(toDegrees(math.Atan2(z, hyp))),
+			"lon": data.Float(toDegrees(math.Atan2(y, x))),
+		}, nil
+	},
+}
@@ -0,0 +1,354 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestGeoDistanceAndBearing(t *testing.T) {
+	type testCase struct {
+		args     []data.Value
+		distance float64
+		bearing  float64
+	}
+	pt := func(lat1, lon1, lat2, lon2 float64) []data.Value {
+		return []data.Value{data.Float(lat1), data.Float(lon1), data.Float(lat2), data.Float(lon2)}
+	}
+	degree := earthRadius * math.Pi / 180
+
+	cases := []testCase{
+		{pt(0, 0, 0, 1), degree, 90},
+		{pt(0, 0, 1, 0), degree, 0},
+		{pt(0, 0, 0, -1), degree, 270},
+		{pt(0, 0, -1, 0), degree, 180},
+		{pt(0, 179.5, 0, -179.5), degree, 90},
+		{pt(0, 0, 90, 0), 90 * degree, 0},
+		// Paris to London
+		{pt(48.8566, 2.3522, 51.5074, -0.1278), 343560, 330},
+		{[]data.Value{data.Int(0), data.Int(0), data.Int(0), data.Int(1)}, degree, 90},
+	}
+
+	Convey("Given the geo_distance and geo_bearing functions", t, func() {
+		for i, c := range cases {
+			c := c
+
+			Convey(fmt.Sprintf("[%d] When evaluating them on %v", i, c.args), func() {
+				d, err1 := geoDistanceFunc.Call(nil, c.args...)
+				b, err2 := geoBearingFunc.Call(nil, c.args...)
+
+				Convey("Then they should return the distance and the bearing", func() {
+					So(err1, ShouldBeNil)
+					So(err2, ShouldBeNil)
+					So(d, ShouldAlmostEqual, c.distance, 100)
+					So(b, ShouldAlmostEqual, c.bearing, 0.5)
+				})
+			})
+		}
+
+		Convey("When passing Null", func() {
+			d, err1 := geoDistanceFunc.Call(nil, data.Float(0), data.Null{}, data.Float(0), data.Float(0))
+			b, err2 := geoBearingFunc.Call(nil, data.Float(0), data.Float(0), data.Float(0), data.Null{})
+
+			Convey("Then they should return Null", func() {
+				So(err1, ShouldBeNil)
+				So(d, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(b, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			_, err1 := geoDistanceFunc.Call(nil, data.Float(0), data.String("0"), data.Float(0), data.Float(0))
+			_, err2 := geoBearingFunc.Call(nil, data.Float(0), data.Float(0), data.Float(0))
+
+			Convey("Then they should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then they should equal the ones in the default registry", func() {
+			for name, f := range map[string]udf.UDF{"geo_distance": geoDistanceFunc, "geo_bearing": geoBearingFunc} {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, 4)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			}
+		})
+	})
+}
+
+func TestGeoBBoxContains(t *testing.T) {
+	box := func(minLat, minLon, maxLat, maxLon, lat, lon float64) []data.Value {
+		return []data.Value{data.Float(minLat), data.Float(minLon), data.Float(maxLat),
+			data.Float(maxLon), data.Float(lat), data.Float(lon)}
+	}
+	cases := []struct {
+		args     []data.Value
+		expected data.Value
+	}{
+		{box(35, 139, 36, 140, 35.5, 139.5), data.Bool(true)},
+		{box(35, 139, 36, 140, 35, 140), data.Bool(true)},
+		{box(35, 139, 36, 140, 34.9, 139.5), data.Bool(false)},
+		{box(35, 139, 36, 140, 35.5, 140.1), data.Bool(false)},
+		// crossing the antimeridian
+		{box(-10, 170, 10, -170, 0, 175), data.Bool(true)},
+		{box(-10, 170, 10, -170, 0, -175), data.Bool(true)},
+		{box(-10, 170, 10, -170, 0, 0), data.Bool(false)},
+		{[]data.Value{data.Int(0), data.Int(0), data.Int(1), data.Int(1), data.Null{}, data.Int(0)}, data.Null{}},
+		{[]data.Value{data.Int(0), data.Int(0), data.Int(1), data.Int(1), data.Int(0), data.Bool(true)}, nil},
+	}
+
+	Convey("Given the geo_bbox_contains function", t, func() {
+		for i, c := range cases {
+			c := c
+
+			Convey(fmt.Sprintf("[%d] When evaluating it on %v", i, c.args), func() {
+				v, err := geoBBoxContainsFunc.Call(nil, c.args...)
+
+				if c.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", c.expected), func() {
+						So(err, ShouldBeNil)
+						So(v, ShouldResemble, c.expected)
+					})
+				}
+			})
+		}
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("geo_bbox_contains", 6)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, geoBBoxContainsFunc)
+		})
+	})
+}
+
+func TestGeohash(t *testing.T) {
+	Convey("Given the geohash_encode function", t, func() {
+		Convey("When encoding a point", func() {
+			v1, err1 := geohashEncodeFunc.Call(nil, data.Float(57.64911), data.Float(10.40744), data.Int(11))
+			v2, err2 := geohashEncodeFunc.Call(nil, data.Float(57.64911), data.Float(10.40744))
+			v3, err3 := geohashEncodeFunc.Call(nil, data.Int(-90), data.Int(-180), data.Int(1))
+
+			Convey("Then it should return the geohash", func() {
+				So(err1, ShouldBeNil)
+				So(v1, ShouldResemble, data.String("u4pruydqqvj"))
+				So(err2, ShouldBeNil)
+				So(v2, ShouldResemble, data.String("u4pruydqqvj8"))
+				So(err3, ShouldBeNil)
+				So(v3, ShouldResemble, data.String("0"))
+			})
+		})
+
+		Convey("When passing Null", func() {
+			v1, err1 := geohashEncodeFunc.Call(nil, data.Null{}, data.Float(0))
+			v2, err2 := geohashEncodeFunc.Call(nil, data.Float(0), data.Float(0), data.Null{})
+
+			Convey("Then it should return Null", func() {
+				So(err1, ShouldBeNil)
+				So(v1, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(v2, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			_, err1 := geohashEncodeFunc.Call(nil, data.Float(91), data.Float(0))
+			_, err2 := geohashEncodeFunc.Call(nil, data.Float(0), data.Float(-181))
+			_, err3 := geohashEncodeFunc.Call(nil, data.Float(0), data.Float(0), data.Int(13))
+			_, err4 := geohashEncodeFunc.Call(nil, data.Float(0), data.Float(0), data.Float(5))
+			_, err5 := geohashEncodeFunc.Call(nil, data.String("0"), data.Float(0))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+				So(err5, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given the geohash_decode function", t, func() {
+		Convey("When decoding a geohash", func() {
+			v, err := geohashDecodeFunc.Call(nil, data.String("EZS42"))
+
+			Convey("Then it should return the center of the cell", func() {
+				So(err, ShouldBeNil)
+				m, _ := data.AsMap(v)
+				So(m["lat"], ShouldAlmostEqual, 42.605, 0.001)
+				So(m["lon"], ShouldAlmostEqual, -5.603, 0.001)
+			})
+		})
+
+		Convey("When decoding an encoded point", func() {
+			h, err := geohashEncodeFunc.Call(nil, data.Float(35.681236), data.Float(139.767125))
+			So(err, ShouldBeNil)
+			v, err := geohashDecodeFunc.Call(nil, h)
+
+			Convey("Then it should return the original point", func() {
+				So(err, ShouldBeNil)
+				m, _ := data.AsMap(v)
+				So(m["lat"], ShouldAlmostEqual, 35.681236, 0.000001)
+				So(m["lon"], ShouldAlmostEqual, 139.767125, 0.000001)
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			v, err1 := geohashDecodeFunc.Call(nil, data.Null{})
+			_, err2 := geohashDecodeFunc.Call(nil, data.String(""))
+			_, err3 := geohashDecodeFunc.Call(nil, data.String("ezs4a"))
+			_, err4 := geohashDecodeFunc.Call(nil, data.Int(1))
+
+			Convey("Then it should return Null or fail", func() {
+				So(err1, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestGeoWithinPolygon(t *testing.T) {
+	// a concave polygon shaped like "L"
+	polygon := data.Array{
+		data.Array{data.Int(0), data.Int(0)},
+		data.Array{data.Int(0), data.Int(2)},
+		data.Array{data.Int(1), data.Int(2)},
+		data.Array{data.Int(1), data.Int(1)},
+		data.Array{data.Int(2), data.Int(1)},
+		data.Array{data.Int(2), data.Int(0)},
+	}
+	mapPolygon := data.Array{
+		data.Map{"lat": data.Float(0), "lon": data.Float(0)},
+		data.Map{"lat": data.Float(0), "lon": data.Float(1)},
+		data.Map{"lat": data.Float(1), "lon": data.Float(0)},
+	}
+
+	udf3aryTestCases := []udf3aryTestCase{
+		{"geo_within_polygon", geoWithinPolygonFunc, []udf3aryTestCaseInput{
+			{data.Float(0.5), data.Float(0.5), polygon, data.Bool(true)},
+			{data.Float(0.5), data.Float(1.5), polygon, data.Bool(true)},
+			{data.Float(1.5), data.Float(0.5), polygon, data.Bool(true)},
+			{data.Float(1.5), data.Float(1.5), polygon, data.Bool(false)},
+			{data.Float(-0.5), data.Float(0.5), polygon, data.Bool(false)},
+			{data.Float(0.2), data.Float(0.2), mapPolygon, data.Bool(true)},
+			{data.Float(0.6), data.Float(0.6), mapPolygon, data.Bool(false)},
+			{data.Null{}, data.Float(0.5), polygon, data.Null{}},
+			{data.Float(0.5), data.Float(0.5), data.Null{}, data.Null{}},
+			{data.Float(0.5), data.Float(0.5), polygon[:2], nil},
+			{data.Float(0.5), data.Float(0.5), data.Array{
+				data.Array{data.Int(0)}, data.Array{data.Int(0), data.Int(1)}, data.Array{data.Int(1), data.Int(0)},
+			}, nil},
+			{data.Float(0.5), data.Float(0.5), data.Array{
+				data.Map{"lat": data.Int(0)}, data.Array{data.Int(0), data.Int(1)}, data.Array{data.Int(1), data.Int(0)},
+			}, nil},
+			{data.Float(0.5), data.Float(0.5), data.Array{
+				data.Int(0), data.Array{data.Int(0), data.Int(1)}, data.Array{data.Int(1), data.Int(0)},
+			}, nil},
+			{data.Float(0.5), data.Float(0.5), data.Map{}, nil},
+			{data.String("0.5"), data.Float(0.5), polygon, nil},
+		}},
+	}
+
+	for _, testCase := range udf3aryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %#v", i,
+					[]data.Value{tc.input1, tc.input2, tc.input3}), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2, tc.input3)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestGeoCentroid(t *testing.T) {
+	Convey("Given the geo_centroid function", t, func() {
+		Convey("When computing the centroid of points", func() {
+			v, err := geoCentroidFunc.Call(nil,
+				data.Array{data.Float(10), data.Float(-10), data.Null{}, data.Int(0)},
+				data.Array{data.Float(20), data.Float(20), data.Float(1), data.Int(20)})
+
+			Convey("Then it should return the center", func() {
+				So(err, ShouldBeNil)
+				m, _ := data.AsMap(v)
+				So(m["lat"], ShouldAlmostEqual, 0, 1e-9)
+				So(m["lon"], ShouldAlmostEqual, 20, 1e-9)
+			})
+		})
+
+		Convey("When computing the centroid of points around the antimeridian", func() {
+			v, err := geoCentroidFunc.Call(nil,
+				data.Array{data.Float(0), data.Float(0)},
+				data.Array{data.Float(179), data.Float(-179)})
+
+			Convey("Then it should be on the antimeridian", func() {
+				So(err, ShouldBeNil)
+				m, _ := data.AsMap(v)
+				lon, _ := data.AsFloat(m["lon"])
+				So(math.Abs(lon), ShouldAlmostEqual, 180, 1e-9)
+			})
+		})
+
+		Convey("When computing the centroid of empty or antipodal points", func() {
+			v1, err1 := geoCentroidFunc.Call(nil, data.Array{data.Null{}}, data.Array{data.Float(0)})
+			v2, err2 := geoCentroidFunc.Call(nil,
+				data.Array{data.Float(0), data.Float(0)},
+				data.Array{data.Float(0), data.Float(180)})
+
+			Convey("Then it should return Null", func() {
+				So(err1, ShouldBeNil)
+				So(v1, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(v2, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			_, err1 := geoCentroidFunc.Call(nil, data.Array{data.String("0")}, data.Array{data.Float(0)})
+			_, err2 := geoCentroidFunc.Call(nil, data.Array{data.Float(0)}, data.Array{})
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("geo_centroid", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, geoCentroidFunc)
+		})
+	})
+}
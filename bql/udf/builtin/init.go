@@ -64,22 +64,126 @@ func init() {
 	// time functions
 	udf.RegisterGlobalUDF("distance_us", diffUsFunc)
 	udf.RegisterGlobalUDF("clock_timestamp", clockTimestampFunc)
+	udf.RegisterGlobalUDF("at_time_zone", atTimeZoneFunc)
+	udf.RegisterGlobalUDF("date_part", datePartFunc)
+	udf.RegisterGlobalUDF("date_trunc", dateTruncFunc)
+	udf.RegisterGlobalUDF("extract", datePartFunc)
+	udf.RegisterGlobalUDF("from_unixtime", fromUnixtimeFunc)
+	udf.RegisterGlobalUDF("to_char", toCharFunc)
+	udf.RegisterGlobalUDF("to_timestamp", toTimestampFunc)
+	udf.RegisterGlobalUDF("to_unixtime", toUnixtimeFunc)
 	// array functions
+	udf.RegisterGlobalUDF("array_append", arrayAppendFunc)
+	udf.RegisterGlobalUDF("array_avg", arrayAvgFunc)
+	udf.RegisterGlobalUDF("array_cat", arrayCatFunc)
+	udf.RegisterGlobalUDF("array_contains", arrayContainsFunc)
+	udf.RegisterGlobalUDF("array_distinct", arrayDistinctFunc)
 	udf.RegisterGlobalUDF("array_length", arrayLengthFunc)
+	udf.RegisterGlobalUDF("array_max", arrayMaxFunc)
+	udf.RegisterGlobalUDF("array_min", arrayMinFunc)
+	udf.RegisterGlobalUDF("array_position", arrayPositionFunc)
+	udf.RegisterGlobalUDF("array_remove", arrayRemoveFunc)
+	udf.RegisterGlobalUDF("array_reverse", arrayReverseFunc)
+	udf.RegisterGlobalUDF("array_slice", arraySliceFunc)
+	udf.RegisterGlobalUDF("array_sort", arraySortFunc)
+	udf.RegisterGlobalUDF("array_sum", arraySumFunc)
+	udf.RegisterGlobalUDF("array_zip", arrayZipFunc)
+	udf.RegisterGlobalUDF("generate_series", generateSeriesFunc)
+	// map functions
+	udf.RegisterGlobalUDF("get_path", getPathFunc)
+	udf.RegisterGlobalUDF("map_delete", mapDeleteFunc)
+	udf.RegisterGlobalUDF("map_flatten", mapFlattenFunc)
+	udf.RegisterGlobalUDF("map_has_key", mapHasKeyFunc)
+	udf.RegisterGlobalUDF("map_keys", mapKeysFunc)
+	udf.RegisterGlobalUDF("map_merge", mapMergeFunc)
+	udf.RegisterGlobalUDF("map_pick", mapPickFunc)
+	udf.RegisterGlobalUDF("map_values", mapValuesFunc)
 	// aggregate functions
+	udf.RegisterGlobalUDF("approx_count_distinct", approxCountDistinctFunc)
+	udf.RegisterGlobalUDF("approx_percentile", approxPercentileFunc)
+	udf.RegisterGlobalUDF("approx_top_k", approxTopKFunc)
 	udf.RegisterGlobalUDF("array_agg", arrayAggFunc)
 	udf.RegisterGlobalUDF("avg", avgFunc)
 	udf.RegisterGlobalUDF("count", countFunc)
 	udf.RegisterGlobalUDF("bool_and", boolAndFunc)
 	udf.RegisterGlobalUDF("bool_or", boolOrFunc)
+	udf.RegisterGlobalUDF("corr", corrFunc)
+	udf.RegisterGlobalUDF("covar_pop", covarPopFunc)
+	udf.RegisterGlobalUDF("covar_samp", covarSampFunc)
+	udf.RegisterGlobalUDF("geo_centroid", geoCentroidFunc)
 	udf.RegisterGlobalUDF("json_object_agg", jsonObjectAggFunc)
 	udf.RegisterGlobalUDF("max", maxFunc)
 	udf.RegisterGlobalUDF("median", medianFunc)
 	udf.RegisterGlobalUDF("min", minFunc)
+	udf.RegisterGlobalUDF("mode", modeFunc)
+	udf.RegisterGlobalUDF("percentile_cont", percentileContFunc)
+	udf.RegisterGlobalUDF("percentile_disc", percentileDiscFunc)
+	udf.RegisterGlobalUDF("regr_intercept", regrInterceptFunc)
+	udf.RegisterGlobalUDF("regr_slope", regrSlopeFunc)
+	udf.RegisterGlobalUDF("reservoir_sample", reservoirSampleFunc)
+	udf.RegisterGlobalUDF("stddev_pop", stddevPopFunc)
+	udf.RegisterGlobalUDF("stddev_samp", stddevSampFunc)
+	udf.RegisterGlobalUDF("stratified_sample", stratifiedSampleFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	udf.RegisterGlobalUDF("var_pop", varPopFunc)
+	udf.RegisterGlobalUDF("var_samp", varSampFunc)
+	// sketch functions
+	udf.RegisterGlobalUDF("cms_count", cmsCountFunc)
+	udf.RegisterGlobalUDF("cms_top_k", cmsTopKFunc)
+	udf.RegisterGlobalUDF("hll_count", hllCountFunc)
+	udf.RegisterGlobalUDF("tdigest_quantile", tDigestQuantileFunc)
+	// anomaly detection functions
+	udf.RegisterGlobalUDF("anomaly_score", anomalyScoreFunc)
+	// key-value store functions
+	udf.RegisterGlobalUDF("kv_exists", kvExistsFunc)
+	udf.RegisterGlobalUDF("kv_get", kvGetFunc)
+	udf.RegisterGlobalUDF("kv_size", kvSizeFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
+	// geo functions
+	udf.RegisterGlobalUDF("geo_bbox_contains", geoBBoxContainsFunc)
+	udf.RegisterGlobalUDF("geo_bearing", geoBearingFunc)
+	udf.RegisterGlobalUDF("geo_distance", geoDistanceFunc)
+	udf.RegisterGlobalUDF("geo_within_polygon", geoWithinPolygonFunc)
+	udf.RegisterGlobalUDF("geohash_decode", geohashDecodeFunc)
+	udf.RegisterGlobalUDF("geohash_encode", geohashEncodeFunc)
+	// binary functions
+	udf.RegisterGlobalUDF("bit_get", bitGetFunc)
+	udf.RegisterGlobalUDF("bit_slice", bitSliceFunc)
+	udf.RegisterGlobalUDF("unpack", unpackFunc)
+	// encoding functions
+	udf.RegisterGlobalUDF("base64_decode", base64DecodeFunc)
+	udf.RegisterGlobalUDF("base64_encode", base64EncodeFunc)
+	udf.RegisterGlobalUDF("compress", compressFunc)
+	udf.RegisterGlobalUDF("crc32", crc32Func)
+	udf.RegisterGlobalUDF("decompress", decompressFunc)
+	udf.RegisterGlobalUDF("hex_decode", hexDecodeFunc)
+	udf.RegisterGlobalUDF("hex_encode", hexEncodeFunc)
+	udf.RegisterGlobalUDF("hmac", hmacFunc)
+	udf.RegisterGlobalUDF("url_decode", urlDecodeFunc)
+	udf.RegisterGlobalUDF("url_encode", urlEncodeFunc)
+	udf.RegisterGlobalUDF("uuid_v4", uuidV4Func)
 	// other functions
 	udf.RegisterGlobalUDF("coalesce", coalesceFunc)
+
+	// sketch states
+	udf.MustRegisterGlobalUDSCreator("count_min_sketch", countMinSketchStateCreator)
+	udf.MustRegisterGlobalUDSCreator("hyperloglog", hyperLogLogStateCreator)
+	udf.MustRegisterGlobalUDSCreator("tdigest", tDigestStateCreator)
+
+	// anomaly detection states
+	udf.MustRegisterGlobalUDSCreator("zscore_detector", zscoreDetectorStateCreator)
+
+	// key-value store states
+	udf.MustRegisterGlobalUDSCreator("kv_store", kvStoreStateCreator)
+
+	// signal UDSFs
+	udf.MustRegisterGlobalUDSFCreator("derivative", udf.MustConvertToUDSFCreator(createDerivativeUDSF))
+	udf.MustRegisterGlobalUDSFCreator("ewma", udf.MustConvertToUDSFCreator(createEWMAUDSF))
+	udf.MustRegisterGlobalUDSFCreator("fft", udf.MustConvertToUDSFCreator(createFFTUDSF))
+	udf.MustRegisterGlobalUDSFCreator("resample", udf.MustConvertToUDSFCreator(createResampleUDSF))
+
+	// filtering UDSFs
+	udf.MustRegisterGlobalUDSFCreator("dedup", udf.MustConvertToUDSFCreator(createDedupUDSF))
 }
@@ -0,0 +1,358 @@
+package builtin
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+type kvEntry struct {
+	key     data.Value
+	value   data.Value
+	expires time.Time
+}
+
+// kvStore is a UDS storing values by keys. Tuples written to the state via
+// the uds sink add or update entries. Entries are kept in the order they
+// were last updated so that the least recently updated entry is evicted
+// first when the store is full. Because all entries have the same TTL, the
+// order is also the order in which entries expire.
+type kvStore struct {
+	m         sync.Mutex
+	params    kvStoreParams
+	keyPath   data.Path
+	valuePath data.Path
+	entries   map[string]*list.Element
+	order     *list.List
+
+	// now returns the current time. It can be replaced in tests.
+	now func() time.Time
+}
+
+type kvStoreParams struct {
+	Key     string `bql:",required"`
+	Value   string
+	TTL     float64 `bql:"ttl"`
+	MaxSize int
+}
+
+var (
+	_ core.LoadableSharedState = &kvStore{}
+	_ core.Writer              = &kvStore{}
+)
+
+func newKVStore(params data.Map) (*kvStore, error) {
+	p := kvStoreParams{}
+	if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+		return nil, err
+	}
+	if p.TTL < 0 {
+		return nil, fmt.Errorf("ttl must not be negative: %v", p.TTL)
+	}
+	if p.MaxSize < 0 {
+		return nil, fmt.Errorf("max_size must not be negative: %v", p.MaxSize)
+	}
+
+	s := &kvStore{
+		params:  p,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+	var err error
+	if s.keyPath, err = data.CompilePath(p.Key); err != nil {
+		return nil, err
+	}
+	if p.Value != "" {
+		if s.valuePath, err = data.CompilePath(p.Value); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *kvStore) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (s *kvStore) Write(ctx *core.Context, t *core.Tuple) error {
+	k, err := t.Data.Get(s.keyPath)
+	if err != nil {
+		return err
+	}
+	var v data.Value
+	if s.valuePath == nil {
+		v = t.Data.Copy()
+	} else {
+		if v, err = t.Data.Get(s.valuePath); err != nil {
+			return err
+		}
+		v = copyValue(v)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	now := s.now()
+	s.expire(now)
+	var expires time.Time
+	if s.params.TTL > 0 {
+		expires = now.Add(time.Duration(s.params.TTL * float64(time.Second)))
+	}
+	s.put(&kvEntry{key: k, value: v, expires: expires})
+	return nil
+}
+
+// put adds or updates an entry. The caller must hold the lock.
+func (s *kvStore) put(e *kvEntry) {
+	key := e.key.String()
+	if elem, ok := s.entries[key]; ok {
+		s.order.Remove(elem)
+	}
+	s.entries[key] = s.order.PushBack(e)
+	if s.params.MaxSize > 0 {
+		for s.order.Len() > s.params.MaxSize {
+			s.remove(s.order.Front())
+		}
+	}
+}
+
+func (s *kvStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*kvEntry).key.String())
+}
+
+// expire removes expired entries. The caller must hold the lock.
+func (s *kvStore) expire(now time.Time) {
+	for elem := s.order.Front(); elem != nil; elem = s.order.Front() {
+		e := elem.Value.(*kvEntry)
+		if e.expires.IsZero() || now.Before(e.expires) {
+			return
+		}
+		s.remove(elem)
+	}
+}
+
+// get returns the value of the key. The second return value is false when
+// the store doesn't have the key or the entry has expired.
+func (s *kvStore) get(key data.Value) (data.Value, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.expire(s.now())
+	elem, ok := s.entries[key.String()]
+	if !ok {
+		return nil, false
+	}
+	return elem.Value.(*kvEntry).value, true
+}
+
+func (s *kvStore) size() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.expire(s.now())
+	return s.order.Len()
+}
+
+func (s *kvStore) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.Lock()
+	s.expire(s.now())
+	entries := make(data.Array, 0, s.order.Len())
+	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+		e := elem.Value.(*kvEntry)
+		var expires int64
+		if !e.expires.IsZero() {
+			expires = e.expires.UnixNano()
+		}
+		entries = append(entries, data.Map{
+			"key":     e.key,
+			"value":   e.value,
+			"expires": data.Int(expires),
+		})
+	}
+	p := s.params
+	s.m.Unlock()
+
+	b, err := data.MarshalMsgpack(data.Map{
+		"type": data.String("kv_store"),
+		"params": data.Map{
+			"key":      data.String(p.Key),
+			"value":    data.String(p.Value),
+			"ttl":      data.Float(p.TTL),
+			"max_size": data.Int(p.MaxSize),
+		},
+		"entries": entries,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func (s *kvStore) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	l, err := loadKVStore(r)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.params, s.keyPath, s.valuePath = l.params, l.keyPath, l.valuePath
+	s.entries, s.order = l.entries, l.order
+	s.expire(s.now())
+	return nil
+}
+
+func loadKVStore(r io.Reader) (*kvStore, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved struct {
+		Type    string   `bql:",required"`
+		Params  data.Map `bql:",required"`
+		Entries []struct {
+			Key     data.Value
+			Value   data.Value
+			Expires int64 `bql:",required"`
+		} `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &saved); err != nil {
+		return nil, err
+	}
+	if saved.Type != "kv_store" {
+		return nil, fmt.Errorf("the saved state has a different type: %v", saved.Type)
+	}
+	s, err := newKVStore(saved.Params)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range saved.Entries {
+		entry := &kvEntry{
+			key:   e.Key,
+			value: e.Value,
+		}
+		if entry.key == nil {
+			entry.key = data.Null{}
+		}
+		if entry.value == nil {
+			entry.value = data.Null{}
+		}
+		if e.Expires != 0 {
+			entry.expires = time.Unix(0, e.Expires)
+		}
+		s.put(entry)
+	}
+	s.expire(s.now())
+	return s, nil
+}
+
+// copyValue returns a deep copy of v so that a stored value isn't affected
+// by modifications to the tuple it came from, and vice versa.
+func copyValue(v data.Value) data.Value {
+	return data.Map{"v": v}.Copy()["v"]
+}
+
+type kvStoreCreator struct{}
+
+var _ udf.UDSLoader = &kvStoreCreator{}
+
+// kvStoreStateCreator creates a UDS storing values by keys, for example,
+// the last value of each device. Tuples written to the state via the uds
+// sink add or update entries.
+//
+// It can be used in BQL as `kv_store`.
+//
+// Parameters:
+//
+//	key: the path to the key (required)
+//	value: the path to the value. The whole tuple is stored when it's
+//	       omitted.
+//	ttl: the number of seconds an entry lives after it's last updated. It
+//	     never expires when it's 0 (default).
+//	max_size: the maximum number of entries. The least recently updated
+//	          entry is evicted when the store is full. The size is
+//	          unlimited when it's 0 (default).
+var kvStoreStateCreator udf.UDSCreator = &kvStoreCreator{}
+
+func (c *kvStoreCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	return newKVStore(params)
+}
+
+func (c *kvStoreCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	return loadKVStore(r)
+}
+
+// lookupKVStore returns the kv_store state having the name.
+func lookupKVStore(ctx *core.Context, name string) (*kvStore, error) {
+	st, err := ctx.SharedStates.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*kvStore)
+	if !ok {
+		return nil, fmt.Errorf("state '%v' is not a kv_store", name)
+	}
+	return s, nil
+}
+
+// kvGetFunc(state, key) returns the value of the key in a kv_store state.
+// It returns Null when the state doesn't have the key or the entry has
+// expired.
+//
+// It can be used in BQL as `kv_get`.
+//
+//  Input: String, Any
+//  Return Type: Any
+var kvGetFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string, key data.Value) (data.Value, error) {
+	s, err := lookupKVStore(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := s.get(key)
+	if !ok {
+		return data.Null{}, nil
+	}
+	return copyValue(v), nil
+})
+
+// kvExistsFunc(state, key) returns whether a kv_store state has an
+// unexpired entry of the key.
+//
+// It can be used in BQL as `kv_exists`.
+//
+//  Input: String, Any
+//  Return Type: Bool
+var kvExistsFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string, key data.Value) (bool, error) {
+	s, err := lookupKVStore(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	_, ok := s.get(key)
+	return ok, nil
+})
+
+// kvSizeFunc(state) returns the number of unexpired entries in a kv_store
+// state.
+//
+// It can be used in BQL as `kv_size`.
+//
+//  Input: String
+//  Return Type: Int
+var kvSizeFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string) (int, error) {
+	s, err := lookupKVStore(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return s.size(), nil
+})
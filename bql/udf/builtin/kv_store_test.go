@@ -0,0 +1,185 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func TestKVStore(t *testing.T) {
+	Convey("Given a context having a kv_store state", t, func() {
+		ctx := core.NewContext(nil)
+		reg, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		c, err := reg.Lookup("kv_store")
+		So(err, ShouldBeNil)
+		s, err := c.CreateState(ctx, data.Map{
+			"key":      data.String("device"),
+			"value":    data.String("temp"),
+			"ttl":      data.Int(10),
+			"max_size": data.Int(3),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("kv", "kv_store", s), ShouldBeNil)
+
+		// Loaded states use the actual clock, so now must be close to it.
+		now := time.Now()
+		s.(*kvStore).now = func() time.Time { return now }
+		write := func(key string, v data.Value) {
+			So(s.(core.Writer).Write(ctx, core.NewTuple(data.Map{
+				"device": data.String(key),
+				"temp":   v,
+			})), ShouldBeNil)
+		}
+		get := func(key string) data.Value {
+			v, err := kvGetFunc.Call(ctx, data.String("kv"), data.String(key))
+			So(err, ShouldBeNil)
+			return v
+		}
+		exists := func(key string) data.Value {
+			v, err := kvExistsFunc.Call(ctx, data.String("kv"), data.String(key))
+			So(err, ShouldBeNil)
+			return v
+		}
+		size := func() data.Value {
+			v, err := kvSizeFunc.Call(ctx, data.String("kv"))
+			So(err, ShouldBeNil)
+			return v
+		}
+
+		Convey("When writing tuples to it", func() {
+			write("a", data.Int(1))
+			now = now.Add(time.Second)
+			write("b", data.Int(2))
+			write("a", data.Int(3))
+
+			Convey("Then kv_get should return the last values", func() {
+				So(get("a"), ShouldResemble, data.Int(3))
+				So(get("b"), ShouldResemble, data.Int(2))
+				So(get("c"), ShouldResemble, data.Null{})
+			})
+
+			Convey("Then kv_exists and kv_size should reflect the entries", func() {
+				So(exists("a"), ShouldResemble, data.True)
+				So(exists("c"), ShouldResemble, data.False)
+				So(size(), ShouldResemble, data.Int(2))
+			})
+
+			Convey("Then entries should expire after the ttl", func() {
+				now = now.Add(9 * time.Second)
+				So(size(), ShouldResemble, data.Int(2))
+				write("c", data.Int(4))
+				now = now.Add(time.Second)
+				So(size(), ShouldResemble, data.Int(1))
+				So(get("a"), ShouldResemble, data.Null{})
+				So(get("c"), ShouldResemble, data.Int(4))
+			})
+
+			Convey("Then the least recently updated entry should be evicted", func() {
+				write("c", data.Int(4))
+				write("d", data.Int(5))
+				So(size(), ShouldResemble, data.Int(3))
+				So(exists("b"), ShouldResemble, data.False)
+				So(exists("a"), ShouldResemble, data.True)
+			})
+
+			Convey("Then it should be saved and loaded", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				saved := buf.Bytes()
+
+				l, err := c.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+				So(err, ShouldBeNil)
+				So(l.(*kvStore).params, ShouldResemble, s.(*kvStore).params)
+				So(l.(*kvStore).order.Len(), ShouldEqual, 2)
+				e := l.(*kvStore).order.Front().Value.(*kvEntry)
+				So(e.key, ShouldResemble, data.String("b"))
+				So(e.value, ShouldResemble, data.Int(2))
+				So(e.expires.Equal(now.Add(10*time.Second)), ShouldBeTrue)
+
+				// Load overwrites the existing state
+				write("c", data.Int(4))
+				So(s.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+				s.(*kvStore).now = func() time.Time { return now }
+				So(size(), ShouldResemble, data.Int(2))
+				So(get("a"), ShouldResemble, data.Int(3))
+				So(exists("c"), ShouldResemble, data.False)
+			})
+
+			Convey("Then loading data of another type should fail", func() {
+				ts, err := tDigestStateCreator.CreateState(ctx, data.Map{"field": data.String("v")})
+				So(err, ShouldBeNil)
+				buf := bytes.NewBuffer(nil)
+				So(ts.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				So(s.(core.LoadableSharedState).Load(ctx, buf, data.Map{}), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple without the key", func() {
+			err := s.(core.Writer).Write(ctx, core.NewTuple(data.Map{"temp": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then query functions should fail on a state of another type", func() {
+			ts, err := tDigestStateCreator.CreateState(ctx, data.Map{"field": data.String("v")})
+			So(err, ShouldBeNil)
+			So(ctx.SharedStates.Add("td", "tdigest", ts), ShouldBeNil)
+			_, err = kvGetFunc.Call(ctx, data.String("td"), data.String("a"))
+			So(err, ShouldNotBeNil)
+			_, err = kvSizeFunc.Call(ctx, data.String("td"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a kv_store state without a value path", t, func() {
+		ctx := core.NewContext(nil)
+		s, err := kvStoreStateCreator.CreateState(ctx, data.Map{"key": data.String("id")})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("kv", "kv_store", s), ShouldBeNil)
+
+		Convey("When writing a tuple to it", func() {
+			d := data.Map{"id": data.Int(1), "v": data.String("x")}
+			So(s.(core.Writer).Write(ctx, core.NewTuple(d)), ShouldBeNil)
+
+			Convey("Then kv_get should return the whole tuple", func() {
+				v, err := kvGetFunc.Call(ctx, data.String("kv"), data.Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, d)
+			})
+		})
+	})
+
+	Convey("Given the kv_store creator", t, func() {
+		ctx := core.NewContext(nil)
+
+		Convey("When creating a state with invalid parameters", func() {
+			Convey("Then it should fail", func() {
+				for _, params := range []data.Map{
+					{},
+					{"key": data.String("k"), "ttl": data.Int(-1)},
+					{"key": data.String("k"), "max_size": data.Int(-1)},
+					{"key": data.String("k["), "value": data.String("v")},
+				} {
+					_, err := kvStoreStateCreator.CreateState(ctx, params)
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+
+	Convey("Given key-value store functions", t, func() {
+		Convey("Then they should be registered", func() {
+			for name, arity := range map[string]int{"kv_get": 2, "kv_exists": 2, "kv_size": 1} {
+				_, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, arity)
+				So(err, ShouldBeNil)
+			}
+		})
+	})
+}
@@ -0,0 +1,310 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"sync"
+)
+
+// mapArg converts an argument to a map. The second return value is
+// false if the argument is Null.
+func mapArg(v data.Value) (data.Map, bool, error) {
+	if v.Type() == data.TypeNull {
+		return nil, false, nil
+	} else if v.Type() == data.TypeMap {
+		m, _ := data.AsMap(v)
+		return m, true, nil
+	}
+	return nil, false, fmt.Errorf("%v is not a map", v)
+}
+
+// keyArgs converts arguments to a list of keys. Each argument can be
+// a String or an Array of Strings.
+func keyArgs(args []data.Value) ([]string, error) {
+	keys := make([]string, 0, len(args))
+	for _, arg := range args {
+		vs := []data.Value{arg}
+		if arg.Type() == data.TypeArray {
+			vs, _ = data.AsArray(arg)
+		}
+		for _, v := range vs {
+			if v.Type() != data.TypeString {
+				return nil, fmt.Errorf("cannot interpret %s as a key", v)
+			}
+			k, _ := data.AsString(v)
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func sortedKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mapKeysFunc returns the keys of the given map in ascending order.
+//
+// It can be used in BQL as `map_keys`.
+//
+//  Input: Map
+//  Return Type: Array of Strings
+var mapKeysFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	m, ok, err := mapArg(arg)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		res = append(res, data.String(k))
+	}
+	return res, nil
+})
+
+// mapValuesFunc returns the values of the given map in ascending order
+// of their keys.
+//
+// It can be used in BQL as `map_values`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapValuesFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	m, ok, err := mapArg(arg)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		res = append(res, m[k])
+	}
+	return res, nil
+})
+
+// mapMergeFunc returns a new map having all key-value pairs of the
+// given maps. When more than one map has the same key, the value in
+// the last one is used. Null values are ignored, non-map arguments
+// lead to an error. Nested maps are not merged recursively.
+//
+// It can be used in BQL as `map_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Map{}
+		for _, arg := range args {
+			m, ok, err := mapArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			for k, v := range m {
+				res[k] = v
+			}
+		}
+		return res, nil
+	},
+}
+
+// mapDeleteFunc(m, key, ...) returns a new map having all key-value
+// pairs of m except the ones with the given keys. Keys can also be
+// given as an array of strings.
+//
+// It can be used in BQL as `map_delete`.
+//
+//  Input: Map, n * (String or Array of Strings)
+//  Return Type: Map
+var mapDeleteFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		m, ok, err := mapArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		keys, err := keyArgs(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		res := m.Copy()
+		for _, k := range keys {
+			delete(res, k)
+		}
+		return res, nil
+	},
+}
+
+// mapPickFunc(m, key, ...) returns a new map having only the key-value
+// pairs of m with the given keys. Keys which m doesn't have are ignored.
+// Keys can also be given as an array of strings.
+//
+// It can be used in BQL as `map_pick`.
+//
+//  Input: Map, n * (String or Array of Strings)
+//  Return Type: Map
+var mapPickFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		m, ok, err := mapArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		keys, err := keyArgs(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		res := data.Map{}
+		for _, k := range keys {
+			if v, ok := m[k]; ok {
+				res[k] = v
+			}
+		}
+		return res, nil
+	},
+}
+
+// mapHasKeyFunc(m, key) returns true if the map m has the key.
+//
+// It can be used in BQL as `map_has_key`.
+//
+//  Input: Map, String
+//  Return Type: Bool
+var mapHasKeyFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arg, key data.Value) (data.Value, error) {
+	m, ok, err := mapArg(arg)
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	if key.Type() == data.TypeNull {
+		return data.Null{}, nil
+	} else if key.Type() != data.TypeString {
+		return nil, fmt.Errorf("cannot interpret %s as a key", key)
+	}
+	k, _ := data.AsString(key)
+	_, ok = m[k]
+	return data.Bool(ok), nil
+})
+
+// mapFlattenFunc(m, [separator]) converts nested maps in m to a flat map
+// whose keys are the keys of each level joined by separator, which is "."
+// by default. For example, {"a": {"b": 1}} becomes {"a.b": 1}. Arrays and
+// empty maps are not flattened.
+//
+// It can be used in BQL as `map_flatten`.
+//
+//  Input: Map, [String]
+//  Return Type: Map
+var mapFlattenFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 2 {
+			return nil, fmt.Errorf("function takes one or two arguments")
+		}
+		m, ok, err := mapArg(args[0])
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		sep := "."
+		if len(args) == 2 {
+			if args[1].Type() == data.TypeNull {
+				return data.Null{}, nil
+			} else if args[1].Type() != data.TypeString {
+				return nil, fmt.Errorf("cannot interpret %s as a string", args[1])
+			}
+			sep, _ = data.AsString(args[1])
+		}
+		res := data.Map{}
+		flattenMap(res, "", sep, m)
+		return res, nil
+	},
+}
+
+func flattenMap(dst data.Map, prefix, sep string, m data.Map) {
+	for k, v := range m {
+		if prefix != "" {
+			k = prefix + sep + k
+		}
+		if child, ok := v.(data.Map); ok && len(child) > 0 {
+			flattenMap(dst, k, sep, child)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+const maxCachedPaths = 1024
+
+var (
+	pathCacheMutex sync.RWMutex
+	pathCache      = map[string]data.Path{}
+)
+
+// compilePathCached compiles a JSON Path and caches the result because
+// get_path is usually called with the same path for every tuple.
+func compilePathCached(s string) (data.Path, error) {
+	pathCacheMutex.RLock()
+	p, ok := pathCache[s]
+	pathCacheMutex.RUnlock()
+	if ok {
+		return p, nil
+	}
+
+	p, err := data.CompilePath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	pathCacheMutex.Lock()
+	defer pathCacheMutex.Unlock()
+	if len(pathCache) >= maxCachedPaths {
+		pathCache = map[string]data.Path{}
+	}
+	pathCache[s] = p
+	return p, nil
+}
+
+// getPathFunc(v, path) returns the value at the JSON Path path in the
+// map or the array v. Unlike a path written in a BQL statement, path can
+// be computed at runtime. It returns Null if v doesn't have the path.
+//
+// It can be used in BQL as `get_path`.
+//
+//  Input: Map or Array, String
+//  Return Type: Any
+var getPathFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, v, path data.Value) (data.Value, error) {
+	if v.Type() == data.TypeNull || path.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	if path.Type() != data.TypeString {
+		return nil, fmt.Errorf("cannot interpret %s as a path", path)
+	}
+	s, _ := data.AsString(path)
+	p, err := compilePathCached(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var res data.Value
+	switch v.Type() {
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		res, err = m.Get(p)
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		res, err = a.Get(p)
+	default:
+		return nil, fmt.Errorf("%v is neither a map nor an array", v)
+	}
+	if err != nil {
+		return data.Null{}, nil
+	}
+	return res, nil
+})
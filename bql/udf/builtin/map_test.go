@@ -0,0 +1,210 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func TestUnaryMapFuncs(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+
+	invalidInputs := []udfUnaryTestCaseInput{
+		// NULL input -> NULL output
+		{data.Null{}, data.Null{}},
+		// cannot process the following
+		{data.Array{}, nil},
+		{data.Blob{}, nil},
+		{data.Bool(true), nil},
+		{data.Float(1.0), nil},
+		{data.Int(1), nil},
+		{data.String("hoge"), nil},
+		{data.Timestamp(someTime), nil},
+	}
+
+	m := data.Map{
+		"b": data.Int(2),
+		"a": data.String("x"),
+		"c": data.Map{"d": data.Null{}},
+	}
+	udfUnaryTestCases := []udfUnaryTestCase{
+		{"map_keys", mapKeysFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Array{}},
+			{m, data.Array{data.String("a"), data.String("b"), data.String("c")}},
+		}},
+		{"map_values", mapValuesFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Array{}},
+			{m, data.Array{data.String("x"), data.Int(2), data.Map{"d": data.Null{}}}},
+		}},
+		{"map_flatten", mapFlattenFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Map{}},
+			{m, data.Map{"a": data.String("x"), "b": data.Int(2), "c.d": data.Null{}}},
+			{data.Map{
+				"a": data.Map{
+					"b": data.Map{"c": data.Int(1)},
+					"d": data.Array{data.Map{"e": data.Int(2)}},
+					"f": data.Map{},
+				},
+			}, data.Map{
+				"a.b.c": data.Int(1),
+				"a.d":   data.Array{data.Map{"e": data.Int(2)}},
+				"a.f":   data.Map{},
+			}},
+		}},
+	}
+
+	for _, testCase := range udfUnaryTestCases {
+		f := testCase.f
+		allInputs := append(testCase.inputs, invalidInputs...)
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %s (%T)", i, tc.input, tc.input), func() {
+					val, err := f.Call(nil, tc.input)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 1)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestBinaryMapFuncs(t *testing.T) {
+	invalidInputs := []udfBinaryTestCaseInput{
+		{data.Int(1), data.String("a"), nil},
+		{data.String("hoge"), data.String("a"), nil},
+	}
+
+	m := data.Map{
+		"a": data.Int(1),
+		"b": data.Map{"c": data.Array{data.Int(2), data.Int(3)}},
+	}
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"map_merge", mapMergeFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Null{}, data.Map{}},
+			{m, data.Null{}, m},
+			{m, data.Map{"a": data.Int(2), "d": data.Null{}}, data.Map{
+				"a": data.Int(2),
+				"b": data.Map{"c": data.Array{data.Int(2), data.Int(3)}},
+				"d": data.Null{},
+			}},
+			{m, data.Map{"b": data.Map{"e": data.Int(4)}},
+				data.Map{"a": data.Int(1), "b": data.Map{"e": data.Int(4)}}},
+			{data.Array{}, data.Map{}, nil},
+		}},
+		{"map_delete", mapDeleteFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("a"), data.Null{}},
+			{m, data.String("a"), data.Map{"b": data.Map{"c": data.Array{data.Int(2), data.Int(3)}}}},
+			{m, data.String("x"), m},
+			{m, data.Array{data.String("a"), data.String("b")}, data.Map{}},
+			{data.Array{}, data.String("a"), nil},
+			{m, data.Int(1), nil},
+		}},
+		{"map_pick", mapPickFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("a"), data.Null{}},
+			{m, data.String("a"), data.Map{"a": data.Int(1)}},
+			{m, data.String("x"), data.Map{}},
+			{m, data.Array{data.String("a"), data.String("x")}, data.Map{"a": data.Int(1)}},
+			{data.Array{}, data.String("a"), nil},
+			{m, data.Array{data.Int(1)}, nil},
+		}},
+		{"map_has_key", mapHasKeyFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("a"), data.Null{}},
+			{m, data.Null{}, data.Null{}},
+			{m, data.String("a"), data.Bool(true)},
+			{m, data.String("c"), data.Bool(false)},
+			{data.Map{"n": data.Null{}}, data.String("n"), data.Bool(true)},
+			{data.Array{}, data.String("a"), nil},
+			{m, data.Int(1), nil},
+		}},
+		{"map_flatten", mapFlattenFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("_"), data.Null{}},
+			{m, data.Null{}, data.Null{}},
+			{m, data.String("_"), data.Map{
+				"a":   data.Int(1),
+				"b_c": data.Array{data.Int(2), data.Int(3)},
+			}},
+			{data.Array{}, data.String("a"), nil},
+			{m, data.Int(1), nil},
+		}},
+		{"get_path", getPathFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.String("a"), data.Null{}},
+			{m, data.Null{}, data.Null{}},
+			{m, data.String("a"), data.Int(1)},
+			{m, data.String("b.c[1]"), data.Int(3)},
+			{m, data.String("b.c[:]"), data.Array{data.Int(2), data.Int(3)}},
+			{m, data.String("b.x"), data.Null{}},
+			{m, data.String("b.c[5]"), data.Null{}},
+			{data.Array{m, data.Map{"a": data.Int(2)}}, data.String("[1].a"), data.Int(2)},
+			{m, data.String(""), nil},
+			{m, data.String("b..["), nil},
+			{data.Array{}, data.String("a"), data.Null{}},
+			{m, data.Int(1), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+		allInputs := append(testCase.inputs, invalidInputs...)
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for i, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("[%d] When evaluating it on %s (%T) and %s (%T)", i,
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given the map_delete function", t, func() {
+		Convey("When deleting a key", func() {
+			m := data.Map{"a": data.Int(1), "b": data.Int(2)}
+			_, err := mapDeleteFunc.Call(nil, m, data.String("a"), data.String("b"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the original map should not be modified", func() {
+				So(m, ShouldResemble, data.Map{"a": data.Int(1), "b": data.Int(2)})
+			})
+		})
+	})
+}
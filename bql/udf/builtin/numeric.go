@@ -7,6 +7,8 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"math/rand"
+	"sync"
+	"time"
 )
 
 // singleParamFunc is a template for functions that
@@ -632,6 +634,27 @@ func (f *widthBucketFuncTmpl) Call(ctx *core.Context, args ...data.Value) (val d
 //  Return Type: Int
 var widthBucketFunc udf.UDF = &widthBucketFuncTmpl{}
 
+// randSource is the source of random numbers of random and the sampling
+// functions. setseed seeds it so that their results are reproducible,
+// which the global source of math/rand doesn't guarantee. It must be used
+// while holding randMutex because it isn't safe for concurrent use.
+var (
+	randMutex  sync.Mutex
+	randSource = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func randFloat64() float64 {
+	randMutex.Lock()
+	defer randMutex.Unlock()
+	return randSource.Float64()
+}
+
+func randIntn(n int) int {
+	randMutex.Lock()
+	defer randMutex.Unlock()
+	return randSource.Intn(n)
+}
+
 // randomFunc returns a random number in the range [0,1[.
 // See also: math/rand.Float64()
 //
@@ -639,7 +662,7 @@ var widthBucketFunc udf.UDF = &widthBucketFuncTmpl{}
 //
 //  Input: None
 //  Return Type: Float
-var randomFunc, _ = udf.ConvertGeneric(func() float64 { return rand.Float64() })
+var randomFunc, _ = udf.ConvertGeneric(randFloat64)
 
 type setseedFuncTmpl struct {
 	singleParamFunc
@@ -663,15 +686,18 @@ func (f *setseedFuncTmpl) Call(ctx *core.Context, args ...data.Value) (val data.
 			return nil, fmt.Errorf("seed out of range [-1,1]")
 		}
 		s := int64(d * float64(math.MaxInt64))
-		rand.Seed(s)
+		randMutex.Lock()
+		randSource.Seed(s)
+		randMutex.Unlock()
 		return data.Null{}, nil
 	}
 	return nil, fmt.Errorf("cannot interpret %s as float", arg)
 }
 
-// setseed initializes the seed for subsequent randomFunc calls.
+// setseed initializes the seed for subsequent randomFunc calls and
+// sampling functions such as reservoir_sample.
 // The argument must be a float in the range [-1,1].
-// See also: math/rand.Seed()
+// See also: math/rand.Rand.Seed()
 //
 // It can be used in BQL as `setseed`.
 //
@@ -0,0 +1,164 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// reservoir samples up to k values uniformly with Algorithm R. It keeps
+// the indexes of sampled values so that the sample can be returned in the
+// input order. The random numbers are taken from randSource so that
+// setseed makes samples reproducible.
+type reservoir struct {
+	k       int
+	seen    int
+	indexes []int
+}
+
+func (r *reservoir) add(i int) {
+	r.seen++
+	if len(r.indexes) < r.k {
+		r.indexes = append(r.indexes, i)
+		return
+	}
+	if j := randIntn(r.seen); j < r.k {
+		r.indexes[j] = i
+	}
+}
+
+func sampleSizeArg(v data.Value) (int, error) {
+	k, err := data.AsInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("cannot interpret %s (%T) as an integer", v, v)
+	}
+	if k <= 0 {
+		return 0, fmt.Errorf("k must be positive: %v", k)
+	}
+	return int(k), nil
+}
+
+// pickSample returns the values at the indexes in the input order.
+func pickSample(arr data.Array, indexes []int) data.Array {
+	sort.Ints(indexes)
+	res := make(data.Array, len(indexes))
+	for i, idx := range indexes {
+		res[i] = arr[idx]
+	}
+	return res
+}
+
+type reservoirSampleFuncTmpl struct {
+}
+
+func (f *reservoirSampleFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *reservoirSampleFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *reservoirSampleFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	k, err := sampleSizeArg(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reservoir{k: k}
+	for i, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		r.add(i)
+	}
+	return pickSample(arr, r.indexes), nil
+}
+
+// reservoirSampleFunc(expr, k) is an aggregate function that returns an
+// array of k non-null input values sampled uniformly at random. All
+// values are returned when there are k or fewer. The sampled values keep
+// the input order. Samples are reproducible after calling setseed.
+//
+// It can be used in BQL as `reservoir_sample`.
+//
+//  Input: anything (aggregated), Int
+//  Return Type: Array
+var reservoirSampleFunc udf.UDF = &reservoirSampleFuncTmpl{}
+
+type stratifiedSampleFuncTmpl struct {
+}
+
+func (f *stratifiedSampleFuncTmpl) Accept(arity int) bool {
+	return arity == 3
+}
+
+func (f *stratifiedSampleFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0 || k == 1
+}
+
+func (f *stratifiedSampleFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("function takes exactly three arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	keys, err := data.AsArray(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[1])
+	}
+	if len(arr) != len(keys) {
+		return nil, fmt.Errorf("the number of values and keys must be the same")
+	}
+	k, err := sampleSizeArg(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	// strata are kept in the order they first appear so that the random
+	// numbers are consumed in a deterministic order.
+	strata := map[string]*reservoir{}
+	order := []*reservoir{}
+	for i, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		key := keys[i].String()
+		r, ok := strata[key]
+		if !ok {
+			r = &reservoir{k: k}
+			strata[key] = r
+			order = append(order, r)
+		}
+		r.add(i)
+	}
+
+	indexes := []int{}
+	for _, r := range order {
+		indexes = append(indexes, r.indexes...)
+	}
+	return pickSample(arr, indexes), nil
+}
+
+// stratifiedSampleFunc(expr, key, k) is an aggregate function that returns
+// an array of non-null input values having k values sampled uniformly at
+// random for each key. All values of a key are returned when the key has
+// k or fewer values. The sampled values keep the input order. Samples are
+// reproducible after calling setseed.
+//
+// It can be used in BQL as `stratified_sample`.
+//
+//  Input: anything (aggregated), anything (aggregated), Int
+//  Return Type: Array
+var stratifiedSampleFunc udf.UDF = &stratifiedSampleFuncTmpl{}
@@ -0,0 +1,158 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestReservoirSample(t *testing.T) {
+	ctx := core.NewContext(nil)
+	input := data.Array{}
+	for i := 0; i < 100; i++ {
+		input = append(input, data.Int(i))
+	}
+	input = append(input, data.Null{})
+
+	Convey("Given the reservoir_sample function", t, func() {
+		f := reservoirSampleFunc
+		So(f.Accept(2), ShouldBeTrue)
+		So(f.IsAggregationParameter(0), ShouldBeTrue)
+		So(f.IsAggregationParameter(1), ShouldBeFalse)
+
+		Convey("When sampling fewer values than the input", func() {
+			v, err := f.Call(ctx, input, data.Int(10))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return k values in the input order", func() {
+				arr, err := data.AsArray(v)
+				So(err, ShouldBeNil)
+				So(len(arr), ShouldEqual, 10)
+				prev := int64(-1)
+				for _, e := range arr {
+					i, err := data.AsInt(e)
+					So(err, ShouldBeNil)
+					So(i, ShouldBeGreaterThan, prev)
+					prev = i
+				}
+			})
+		})
+
+		Convey("When sampling more values than the input", func() {
+			v, err := f.Call(ctx, data.Array{data.Int(1), data.Null{}, data.String("a")}, data.Int(5))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return all non-null values", func() {
+				So(v, ShouldResemble, data.Array{data.Int(1), data.String("a")})
+			})
+		})
+
+		Convey("When sampling after setseed", func() {
+			_, err := setseedFunc.Call(ctx, data.Float(0.5))
+			So(err, ShouldBeNil)
+			v1, err := f.Call(ctx, input, data.Int(10))
+			So(err, ShouldBeNil)
+			_, err = setseedFunc.Call(ctx, data.Float(0.5))
+			So(err, ShouldBeNil)
+			v2, err := f.Call(ctx, input, data.Int(10))
+			So(err, ShouldBeNil)
+
+			Convey("Then the samples should be the same", func() {
+				So(v1, ShouldResemble, v2)
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			Convey("Then it should fail", func() {
+				_, err := f.Call(ctx, input, data.Int(0))
+				So(err, ShouldNotBeNil)
+				_, err = f.Call(ctx, input, data.String("a"))
+				So(err, ShouldNotBeNil)
+				_, err = f.Call(ctx, data.Int(1), data.Int(1))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should be registered", func() {
+			g, err := udf.CopyGlobalUDFRegistry(nil).Lookup("reservoir_sample", 2)
+			So(err, ShouldBeNil)
+			So(g, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestStratifiedSample(t *testing.T) {
+	ctx := core.NewContext(nil)
+	input, keys := data.Array{}, data.Array{}
+	for i := 0; i < 30; i++ {
+		input = append(input, data.Int(i))
+		keys = append(keys, data.Int(i%3))
+	}
+	input = append(input, data.Int(30), data.Null{})
+	keys = append(keys, data.Null{}, data.Int(0))
+
+	Convey("Given the stratified_sample function", t, func() {
+		f := stratifiedSampleFunc
+		So(f.Accept(3), ShouldBeTrue)
+		So(f.IsAggregationParameter(0), ShouldBeTrue)
+		So(f.IsAggregationParameter(1), ShouldBeTrue)
+		So(f.IsAggregationParameter(2), ShouldBeFalse)
+
+		Convey("When sampling values", func() {
+			v, err := f.Call(ctx, input, keys, data.Int(2))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return k values of each key in the input order", func() {
+				arr, err := data.AsArray(v)
+				So(err, ShouldBeNil)
+				So(len(arr), ShouldEqual, 7)
+				counts := map[int64]int{}
+				prev := int64(-1)
+				for _, e := range arr {
+					i, err := data.AsInt(e)
+					So(err, ShouldBeNil)
+					So(i, ShouldBeGreaterThan, prev)
+					prev = i
+					counts[i%3]++
+				}
+				// 30 is the only value having the null key
+				So(arr[len(arr)-1], ShouldResemble, data.Int(30))
+				So(counts, ShouldResemble, map[int64]int{0: 3, 1: 2, 2: 2})
+			})
+		})
+
+		Convey("When sampling after setseed", func() {
+			_, err := setseedFunc.Call(ctx, data.Float(-0.25))
+			So(err, ShouldBeNil)
+			v1, err := f.Call(ctx, input, keys, data.Int(3))
+			So(err, ShouldBeNil)
+			_, err = setseedFunc.Call(ctx, data.Float(-0.25))
+			So(err, ShouldBeNil)
+			v2, err := f.Call(ctx, input, keys, data.Int(3))
+			So(err, ShouldBeNil)
+
+			Convey("Then the samples should be the same", func() {
+				So(v1, ShouldResemble, v2)
+			})
+		})
+
+		Convey("When passing invalid arguments", func() {
+			Convey("Then it should fail", func() {
+				_, err := f.Call(ctx, input, keys, data.Int(-1))
+				So(err, ShouldNotBeNil)
+				_, err = f.Call(ctx, input, keys[1:], data.Int(1))
+				So(err, ShouldNotBeNil)
+				_, err = f.Call(ctx, input, data.Int(1), data.Int(1))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should be registered", func() {
+			g, err := udf.CopyGlobalUDFRegistry(nil).Lookup("stratified_sample", 3)
+			So(err, ShouldBeNil)
+			So(g, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
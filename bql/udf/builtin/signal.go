@@ -0,0 +1,504 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"math/cmplx"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSignalKeys is the maximum number of keys for which a signal processing
+// UDSF keeps states. States are never removed, so tuples having a new key
+// are dropped with an error once the UDSF has this number of keys.
+const maxSignalKeys = 100000
+
+// errTooManySignalKeys is returned when a tuple has a new key and the UDSF
+// already has maxSignalKeys keys.
+var errTooManySignalKeys = fmt.Errorf("the number of keys must not exceed %v", maxSignalKeys)
+
+// maxResampleGap is the maximum number of tuples the resample UDSF emits to
+// fill a gap between two input tuples.
+const maxResampleGap = 10000
+
+// signalInput has the configuration common to signal processing UDSFs:
+// the path to the value and the optional path to the key by which states
+// are separated.
+type signalInput struct {
+	valuePath data.Path
+	keyPath   data.Path
+}
+
+func newSignalInput(decl udf.UDSFDeclarer, stream, field string, key []string) (*signalInput, error) {
+	if len(key) > 1 {
+		return nil, fmt.Errorf("only one key can be specified")
+	}
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	s := &signalInput{}
+	p, err := data.CompilePath(field)
+	if err != nil {
+		return nil, err
+	}
+	s.valuePath = p
+	if len(key) == 1 {
+		if s.keyPath, err = data.CompilePath(key[0]); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// key returns the key of the tuple and its string representation used as
+// a key of a Go map. It returns Null when the UDSF isn't keyed.
+func (s *signalInput) key(t *core.Tuple) (data.Value, string, error) {
+	if s.keyPath == nil {
+		return data.Null{}, "", nil
+	}
+	k, err := t.Data.Get(s.keyPath)
+	if err != nil {
+		return nil, "", err
+	}
+	return k, k.String(), nil
+}
+
+// value returns the numeric value of the tuple. The second return value is
+// false if the value is Null.
+func (s *signalInput) value(t *core.Tuple) (float64, bool, error) {
+	v, err := t.Data.Get(s.valuePath)
+	if err != nil {
+		return 0, false, err
+	}
+	if v.Type() == data.TypeNull {
+		return 0, false, nil
+	}
+	fs, err := collectFloats([]data.Value{v})
+	if err != nil {
+		return 0, false, err
+	}
+	return fs[0], true, nil
+}
+
+type ewmaUDSF struct {
+	*signalInput
+	alpha float64
+
+	m     sync.Mutex
+	means map[string]float64
+}
+
+func (e *ewmaUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	_, k, err := e.key(t)
+	if err != nil {
+		return err
+	}
+	v, ok, err := e.value(t)
+	if err != nil || !ok {
+		return err
+	}
+
+	e.m.Lock()
+	mean, ok := e.means[k]
+	if !ok && len(e.means) >= maxSignalKeys {
+		e.m.Unlock()
+		return errTooManySignalKeys
+	}
+	if ok {
+		mean += e.alpha * (v - mean)
+	} else {
+		mean = v
+	}
+	e.means[k] = mean
+	e.m.Unlock()
+
+	out := t.Copy()
+	out.Data["ewma"] = data.Float(mean)
+	return w.Write(ctx, out)
+}
+
+func (e *ewmaUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+// createEWMAUDSF creates a UDSF computing the exponentially weighted moving
+// average of the value at the path field with the smoothing factor alpha.
+// Each input tuple is emitted with the "ewma" field having the average.
+// When the key is given, an average is computed for each value at the key,
+// and up to maxSignalKeys keys are kept. Tuples whose value is Null are
+// dropped.
+//
+// It can be used in BQL as `ewma`.
+//
+//	SELECT RSTREAM * FROM ewma("sensors", "temperature", 0.1, "device_id") [RANGE 1 TUPLES];
+func createEWMAUDSF(decl udf.UDSFDeclarer, stream, field string, alpha float64, key ...string) (udf.UDSF, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("alpha must be in (0, 1]: %v", alpha)
+	}
+	in, err := newSignalInput(decl, stream, field, key)
+	if err != nil {
+		return nil, err
+	}
+	return &ewmaUDSF{
+		signalInput: in,
+		alpha:       alpha,
+		means:       map[string]float64{},
+	}, nil
+}
+
+type derivativeSample struct {
+	value float64
+	ts    time.Time
+}
+
+type derivativeUDSF struct {
+	*signalInput
+
+	m    sync.Mutex
+	last map[string]derivativeSample
+}
+
+func (d *derivativeUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	_, k, err := d.key(t)
+	if err != nil {
+		return err
+	}
+	v, ok, err := d.value(t)
+	if err != nil || !ok {
+		return err
+	}
+
+	d.m.Lock()
+	prev, ok := d.last[k]
+	if !ok && len(d.last) >= maxSignalKeys {
+		d.m.Unlock()
+		return errTooManySignalKeys
+	}
+	d.last[k] = derivativeSample{value: v, ts: t.Timestamp}
+	d.m.Unlock()
+	if !ok {
+		return nil
+	}
+
+	out := t.Copy()
+	out.Data["delta"] = data.Float(v - prev.value)
+	if dt := t.Timestamp.Sub(prev.ts).Seconds(); dt > 0 {
+		out.Data["rate"] = data.Float((v - prev.value) / dt)
+	} else {
+		out.Data["rate"] = data.Null{}
+	}
+	return w.Write(ctx, out)
+}
+
+func (d *derivativeUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+// createDerivativeUDSF creates a UDSF computing the rate of change of the
+// value at the path field. Each input tuple is emitted with the "delta"
+// field having the difference from the previous value and the "rate" field
+// having the derivative per second computed from the timestamps of tuples.
+// "rate" is Null when the timestamp doesn't advance. The first tuple of each
+// key isn't emitted because it doesn't have the previous value. Up to
+// maxSignalKeys keys are kept. Tuples whose value is Null are dropped.
+//
+// It can be used in BQL as `derivative`.
+//
+//	SELECT RSTREAM * FROM derivative("meters", "energy", "meter_id") [RANGE 1 TUPLES];
+func createDerivativeUDSF(decl udf.UDSFDeclarer, stream, field string, key ...string) (udf.UDSF, error) {
+	in, err := newSignalInput(decl, stream, field, key)
+	if err != nil {
+		return nil, err
+	}
+	return &derivativeUDSF{
+		signalInput: in,
+		last:        map[string]derivativeSample{},
+	}, nil
+}
+
+type resampleState struct {
+	value float64
+	ts    int64 // in nanoseconds
+	next  int64 // the next grid time in nanoseconds
+}
+
+type resampleUDSF struct {
+	*signalInput
+	interval int64 // in nanoseconds
+	linear   bool
+
+	m      sync.Mutex
+	states map[string]*resampleState
+}
+
+func (r *resampleUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	key, k, err := r.key(t)
+	if err != nil {
+		return err
+	}
+	v, ok, err := r.value(t)
+	if err != nil || !ok {
+		return err
+	}
+	ts := t.Timestamp.UnixNano()
+
+	// Tuples are computed while holding the lock so that the state stays
+	// consistent, and written after releasing it.
+	var outs []*core.Tuple
+	emit := func(g int64, value float64) {
+		m := data.Map{"value": data.Float(value)}
+		if r.keyPath != nil {
+			m["key"] = key
+		}
+		out := core.NewTuple(m)
+		out.Timestamp = time.Unix(0, g).In(t.Timestamp.Location())
+		out.ProcTimestamp = t.ProcTimestamp
+		outs = append(outs, out)
+	}
+
+	r.m.Lock()
+	s, ok := r.states[k]
+	if !ok && len(r.states) >= maxSignalKeys {
+		r.m.Unlock()
+		return errTooManySignalKeys
+	}
+	if !ok {
+		s = &resampleState{value: v, ts: ts, next: -floorDiv64(-ts, r.interval) * r.interval}
+		r.states[k] = s
+		if s.next == ts {
+			emit(ts, v)
+			s.next += r.interval
+		}
+	} else if ts > s.ts {
+		if n := (ts-s.next)/r.interval + 1; n > maxResampleGap {
+			// The gap is skipped instead of emitting too many tuples.
+			ctx.Log().WithField("key", key).WithField("num_skipped", n).
+				Warning("Skipping a gap too long to be resampled")
+			s.next = -floorDiv64(-ts, r.interval) * r.interval
+		}
+		for ; s.next <= ts; s.next += r.interval {
+			switch {
+			case s.next == ts:
+				emit(s.next, v)
+			case r.linear:
+				emit(s.next, s.value+(v-s.value)*float64(s.next-s.ts)/float64(ts-s.ts))
+			default:
+				emit(s.next, s.value)
+			}
+		}
+		s.value, s.ts = v, ts
+	}
+	// tuples arriving out of order are dropped
+	r.m.Unlock()
+
+	for _, out := range outs {
+		if err := w.Write(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *resampleUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func floorDiv64(a, b int64) int64 {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// createResampleUDSF creates a UDSF resampling the value at the path field
+// to fixed intervals given in seconds. Sampling times are aligned to
+// multiples of the interval since the Unix epoch. Values between two input
+// tuples are filled by linear interpolation when the method is "linear" or
+// by the previous value when the method is "last". Each output tuple has the
+// "value" field and the timestamp of the sampling time. When the key is
+// given, values are resampled for each key, up to maxSignalKeys keys, and
+// output tuples also have the "key" field. Tuples whose value is Null or
+// whose timestamp is older than the previous one are dropped. When a gap
+// between two tuples needs more than maxResampleGap tuples to be filled,
+// the gap is skipped and a warning is logged.
+//
+// It can be used in BQL as `resample`.
+//
+//	SELECT RSTREAM * FROM resample("power", "watts", 1, "linear") [RANGE 1 TUPLES];
+func createResampleUDSF(decl udf.UDSFDeclarer, stream, field string, interval float64,
+	method string, key ...string) (udf.UDSF, error) {
+	iv := int64(interval * float64(time.Second))
+	if iv <= 0 {
+		return nil, fmt.Errorf("interval must be positive: %v", interval)
+	}
+	var linear bool
+	switch strings.ToLower(method) {
+	case "linear":
+		linear = true
+	case "last":
+	default:
+		return nil, fmt.Errorf("method must be linear or last: %v", method)
+	}
+	in, err := newSignalInput(decl, stream, field, key)
+	if err != nil {
+		return nil, err
+	}
+	return &resampleUDSF{
+		signalInput: in,
+		interval:    iv,
+		linear:      linear,
+		states:      map[string]*resampleState{},
+	}, nil
+}
+
+type fftUDSF struct {
+	path       data.Path
+	sampleRate float64
+	window     func(i, n int) float64
+}
+
+func (f *fftUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	v, err := t.Data.Get(f.path)
+	if err != nil {
+		return err
+	}
+	a, err := data.AsArray(v)
+	if err != nil {
+		return fmt.Errorf("cannot interpret %s as an array of samples", v)
+	}
+	if len(a) < 2 {
+		return fmt.Errorf("fft needs at least two samples")
+	}
+	samples := make([]float64, 0, len(a))
+	for _, e := range a {
+		if e.Type() == data.TypeNull {
+			return fmt.Errorf("samples must not have Null")
+		}
+		fs, err := collectFloats([]data.Value{e})
+		if err != nil {
+			return err
+		}
+		samples = append(samples, fs[0])
+	}
+
+	n := 1
+	for n < len(samples) {
+		n <<= 1
+	}
+	x := make([]complex128, n)
+	gain := 0.0
+	for i, s := range samples {
+		wi := f.window(i, len(samples))
+		x[i] = complex(s*wi, 0)
+		gain += wi
+	}
+	fft(x)
+
+	// single-sided amplitude spectrum corrected by the coherent gain of
+	// the window so that a sinusoid of amplitude A has a peak of A
+	freqs := make(data.Array, n/2+1)
+	amps := make(data.Array, n/2+1)
+	for k := range amps {
+		amp := cmplx.Abs(x[k]) / gain
+		if k != 0 && k != n/2 {
+			amp *= 2
+		}
+		freqs[k] = data.Float(float64(k) * f.sampleRate / float64(n))
+		amps[k] = data.Float(amp)
+	}
+
+	out := t.Copy()
+	out.Data["frequency"] = freqs
+	out.Data["amplitude"] = amps
+	return w.Write(ctx, out)
+}
+
+func (f *fftUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+// fft computes the discrete Fourier transform of x in place with the
+// iterative radix-2 Cooley-Tukey algorithm. len(x) must be a power of 2.
+func fft(x []complex128) {
+	n := len(x)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				u := x[start+k]
+				v := x[start+k+size/2] * w
+				x[start+k] = u + v
+				x[start+k+size/2] = u - v
+				w *= step
+			}
+		}
+	}
+}
+
+var fftWindows = map[string]func(i, n int) float64{
+	"rectangular": func(i, n int) float64 {
+		return 1
+	},
+	"hann": func(i, n int) float64 {
+		return 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(n-1))
+	},
+	"hamming": func(i, n int) float64 {
+		return 0.54 - 0.46*math.Cos(2*math.Pi*float64(i)/float64(n-1))
+	},
+}
+
+// createFFTUDSF creates a UDSF computing the amplitude spectrum of samples
+// stored as an array at the path field. sample_rate is the number of
+// samples per second. The window function applied to the samples is
+// "hann" (default), "hamming", or "rectangular". Samples are zero-padded
+// to a power of 2. Each input tuple is emitted with the "frequency" and
+// "amplitude" fields, which are arrays of frequencies in Hz from 0 to the
+// Nyquist frequency and the corresponding single-sided amplitudes.
+//
+// It can be used in BQL as `fft`.
+//
+//	SELECT RSTREAM * FROM fft("vibration", "samples", 1000, "hann") [RANGE 1 TUPLES];
+func createFFTUDSF(decl udf.UDSFDeclarer, stream, field string, sampleRate float64,
+	window ...string) (udf.UDSF, error) {
+	if len(window) > 1 {
+		return nil, fmt.Errorf("only one window function can be specified")
+	}
+	if !(sampleRate > 0) {
+		return nil, fmt.Errorf("sample rate must be positive: %v", sampleRate)
+	}
+	name := "hann"
+	if len(window) == 1 {
+		name = strings.ToLower(window[0])
+	}
+	wf, ok := fftWindows[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported window function: %v", name)
+	}
+	p, err := data.CompilePath(field)
+	if err != nil {
+		return nil, err
+	}
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	return &fftUDSF{
+		path:       p,
+		sampleRate: sampleRate,
+		window:     wf,
+	}, nil
+}
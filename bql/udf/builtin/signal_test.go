@@ -0,0 +1,361 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+	"time"
+)
+
+// createSignalUDSF creates a UDSF registered in the global registry.
+func createSignalUDSF(ctx *core.Context, name string, args ...data.Value) (udf.UDSF, udf.UDSFDeclarer, error) {
+	reg, err := udf.CopyGlobalUDSFCreatorRegistry()
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := reg.Lookup(name, len(args))
+	if err != nil {
+		return nil, nil, err
+	}
+	decl := udf.NewUDSFDeclarer()
+	f, err := c.CreateUDSF(ctx, decl, args...)
+	return f, decl, err
+}
+
+// processSignal sends tuples to a UDSF and returns tuples written by it.
+func processSignal(ctx *core.Context, f udf.UDSF, ts ...*core.Tuple) ([]*core.Tuple, error) {
+	var res []*core.Tuple
+	w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+		res = append(res, t)
+		return nil
+	})
+	for _, t := range ts {
+		if err := f.Process(ctx, t, w); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func signalTuple(sec float64, m data.Map) *core.Tuple {
+	t := core.NewTuple(m)
+	t.Timestamp = time.Unix(0, int64(sec*float64(time.Second))).UTC()
+	return t
+}
+
+func TestEWMAUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given an ewma UDSF with a key", t, func() {
+		f, decl, err := createSignalUDSF(ctx, "ewma", data.String("s"), data.String("v"),
+			data.Float(0.5), data.String("k"))
+		So(err, ShouldBeNil)
+		So(decl.ListInputs(), ShouldContainKey, "s")
+
+		Convey("When processing tuples", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(0, data.Map{"k": data.String("a"), "v": data.Int(10)}),
+				signalTuple(1, data.Map{"k": data.String("b"), "v": data.Int(100)}),
+				signalTuple(2, data.Map{"k": data.String("a"), "v": data.Int(20)}),
+				signalTuple(3, data.Map{"k": data.String("a"), "v": data.Null{}}),
+				signalTuple(4, data.Map{"k": data.String("a"), "v": data.Float(5)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit the average of each key", func() {
+				So(len(res), ShouldEqual, 4)
+				So(res[0].Data["ewma"], ShouldResemble, data.Float(10))
+				So(res[1].Data["ewma"], ShouldResemble, data.Float(100))
+				So(res[2].Data["ewma"], ShouldResemble, data.Float(15))
+				So(res[3].Data["ewma"], ShouldResemble, data.Float(10))
+				So(res[3].Data["v"], ShouldResemble, data.Float(5))
+			})
+		})
+
+		Convey("When processing a tuple having a non-numeric value", func() {
+			_, err := processSignal(ctx, f, signalTuple(0, data.Map{"k": data.String("a"), "v": data.String("1")}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When processing a tuple without the key", func() {
+			_, err := processSignal(ctx, f, signalTuple(0, data.Map{"v": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When processing tuples having too many keys", func() {
+			for i := 0; i < maxSignalKeys; i++ {
+				_, err := processSignal(ctx, f, signalTuple(0, data.Map{"k": data.Int(i), "v": data.Int(1)}))
+				So(err, ShouldBeNil)
+			}
+			_, err1 := processSignal(ctx, f, signalTuple(0, data.Map{"k": data.Int(-1), "v": data.Int(1)}))
+			res, err2 := processSignal(ctx, f, signalTuple(0, data.Map{"k": data.Int(0), "v": data.Int(3)}))
+
+			Convey("Then it should fail only on a new key", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldBeNil)
+				So(res[0].Data["ewma"], ShouldResemble, data.Float(2))
+			})
+		})
+	})
+
+	Convey("Given the ewma UDSF creator", t, func() {
+		Convey("When creating it with invalid arguments", func() {
+			_, _, err1 := createSignalUDSF(ctx, "ewma", data.String("s"), data.String("v"), data.Float(0))
+			_, _, err2 := createSignalUDSF(ctx, "ewma", data.String("s"), data.String("v"), data.Float(1.5))
+			_, _, err3 := createSignalUDSF(ctx, "ewma", data.String("s"), data.String("v[["), data.Float(0.5))
+			_, _, err4 := createSignalUDSF(ctx, "ewma", data.String("s"), data.String("v"), data.Float(0.5),
+				data.String("k1"), data.String("k2"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDerivativeUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a derivative UDSF without a key", t, func() {
+		f, _, err := createSignalUDSF(ctx, "derivative", data.String("s"), data.String("v"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing tuples", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(0, data.Map{"v": data.Int(10)}),
+				signalTuple(2, data.Map{"v": data.Int(20)}),
+				signalTuple(2, data.Map{"v": data.Int(21)}),
+				signalTuple(2.5, data.Map{"v": data.Null{}}),
+				signalTuple(3, data.Map{"v": data.Int(18)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit deltas and rates", func() {
+				So(len(res), ShouldEqual, 3)
+				So(res[0].Data["delta"], ShouldResemble, data.Float(10))
+				So(res[0].Data["rate"], ShouldResemble, data.Float(5))
+				So(res[1].Data["delta"], ShouldResemble, data.Float(1))
+				So(res[1].Data["rate"], ShouldResemble, data.Null{})
+				So(res[2].Data["delta"], ShouldResemble, data.Float(-3))
+				So(res[2].Data["rate"], ShouldResemble, data.Float(-3))
+			})
+		})
+	})
+
+	Convey("Given a derivative UDSF with a key", t, func() {
+		f, _, err := createSignalUDSF(ctx, "derivative", data.String("s"), data.String("v"), data.String("k"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing tuples of different keys", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(0, data.Map{"k": data.Int(1), "v": data.Int(10)}),
+				signalTuple(1, data.Map{"k": data.Int(2), "v": data.Int(100)}),
+				signalTuple(2, data.Map{"k": data.Int(1), "v": data.Int(14)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should compute rates for each key", func() {
+				So(len(res), ShouldEqual, 1)
+				So(res[0].Data["rate"], ShouldResemble, data.Float(2))
+			})
+		})
+	})
+}
+
+func TestResampleUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	input := []*core.Tuple{
+		signalTuple(0.5, data.Map{"v": data.Int(0)}),
+		signalTuple(1.5, data.Map{"v": data.Int(10)}),
+		signalTuple(4, data.Map{"v": data.Int(0)}),
+		signalTuple(3, data.Map{"v": data.Int(100)}), // out of order
+		signalTuple(4.5, data.Map{"v": data.Null{}}),
+		signalTuple(5, data.Map{"v": data.Int(5)}),
+	}
+	timestamps := func(ts []*core.Tuple) []float64 {
+		res := make([]float64, len(ts))
+		for i, t := range ts {
+			res[i] = float64(t.Timestamp.UnixNano()) / float64(time.Second)
+		}
+		return res
+	}
+	values := func(ts []*core.Tuple) []data.Value {
+		res := make([]data.Value, len(ts))
+		for i, t := range ts {
+			res[i] = t.Data["value"]
+		}
+		return res
+	}
+
+	Convey("Given a resample UDSF with linear interpolation", t, func() {
+		f, _, err := createSignalUDSF(ctx, "resample", data.String("s"), data.String("v"),
+			data.Int(1), data.String("linear"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing tuples", func() {
+			res, err := processSignal(ctx, f, input...)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit interpolated values at fixed intervals", func() {
+				So(timestamps(res), ShouldResemble, []float64{1, 2, 3, 4, 5})
+				So(values(res), ShouldResemble, []data.Value{
+					data.Float(5), data.Float(8), data.Float(4), data.Float(0), data.Float(5),
+				})
+				_, ok := res[0].Data["key"]
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given a resample UDSF with the last value", t, func() {
+		f, _, err := createSignalUDSF(ctx, "resample", data.String("s"), data.String("v"),
+			data.Float(2), data.String("last"), data.String("k"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing tuples", func() {
+			keyed := make([]*core.Tuple, len(input))
+			for i, t := range input {
+				keyed[i] = t.Copy()
+				keyed[i].Data["k"] = data.String("a")
+			}
+			res, err := processSignal(ctx, f, append(keyed,
+				signalTuple(6, data.Map{"k": data.String("b"), "v": data.Int(7)}))...)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fill gaps with the last value", func() {
+				So(timestamps(res), ShouldResemble, []float64{2, 4, 6})
+				So(values(res), ShouldResemble, []data.Value{
+					data.Float(10), data.Float(0), data.Float(7),
+				})
+				So(res[0].Data["key"], ShouldResemble, data.String("a"))
+				So(res[2].Data["key"], ShouldResemble, data.String("b"))
+			})
+		})
+	})
+
+	Convey("Given a resample UDSF with a short interval", t, func() {
+		f, _, err := createSignalUDSF(ctx, "resample", data.String("s"), data.String("v"),
+			data.Float(0.001), data.String("last"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing tuples having a long gap", func() {
+			res, err := processSignal(ctx, f,
+				signalTuple(0, data.Map{"v": data.Int(1)}),
+				signalTuple(86400, data.Map{"v": data.Int(2)}),
+				signalTuple(86400.002, data.Map{"v": data.Int(3)}),
+			)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should skip the gap", func() {
+				So(timestamps(res), ShouldResemble, []float64{0, 86400, 86400.001, 86400.002})
+				So(values(res), ShouldResemble, []data.Value{
+					data.Float(1), data.Float(2), data.Float(2), data.Float(3),
+				})
+			})
+		})
+	})
+
+	Convey("Given the resample UDSF creator", t, func() {
+		Convey("When creating it with invalid arguments", func() {
+			_, _, err1 := createSignalUDSF(ctx, "resample", data.String("s"), data.String("v"),
+				data.Int(0), data.String("linear"))
+			_, _, err2 := createSignalUDSF(ctx, "resample", data.String("s"), data.String("v"),
+				data.Int(1), data.String("cubic"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestFFTUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	// 64 samples at 64Hz having a DC offset of 1 and a 8Hz sinusoid of
+	// amplitude 3
+	samples := make(data.Array, 64)
+	for i := range samples {
+		samples[i] = data.Float(1 + 3*math.Sin(2*math.Pi*8*float64(i)/64))
+	}
+
+	Convey("Given an fft UDSF with the rectangular window", t, func() {
+		f, _, err := createSignalUDSF(ctx, "fft", data.String("s"), data.String("samples"),
+			data.Int(64), data.String("rectangular"))
+		So(err, ShouldBeNil)
+
+		Convey("When processing samples", func() {
+			res, err := processSignal(ctx, f, core.NewTuple(data.Map{"samples": samples}))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit the amplitude spectrum", func() {
+				So(len(res), ShouldEqual, 1)
+				freqs, _ := data.AsArray(res[0].Data["frequency"])
+				amps, _ := data.AsArray(res[0].Data["amplitude"])
+				So(len(freqs), ShouldEqual, 33)
+				So(len(amps), ShouldEqual, 33)
+				So(freqs[8], ShouldResemble, data.Float(8))
+				So(freqs[32], ShouldResemble, data.Float(32))
+				So(amps[0], ShouldAlmostEqual, 1, 1e-9)
+				So(amps[8], ShouldAlmostEqual, 3, 1e-9)
+				So(amps[5], ShouldAlmostEqual, 0, 1e-9)
+			})
+		})
+	})
+
+	Convey("Given an fft UDSF with the default window", t, func() {
+		f, _, err := createSignalUDSF(ctx, "fft", data.String("s"), data.String("samples"), data.Int(64))
+		So(err, ShouldBeNil)
+
+		Convey("When processing samples whose length isn't a power of 2", func() {
+			res, err := processSignal(ctx, f, core.NewTuple(data.Map{"samples": samples[:50]}))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should zero-pad the samples", func() {
+				amps, _ := data.AsArray(res[0].Data["amplitude"])
+				So(len(amps), ShouldEqual, 33)
+			})
+		})
+
+		Convey("When processing invalid samples", func() {
+			_, err1 := processSignal(ctx, f, core.NewTuple(data.Map{"samples": data.Array{data.Int(1)}}))
+			_, err2 := processSignal(ctx, f, core.NewTuple(data.Map{"samples": data.Int(1)}))
+			_, err3 := processSignal(ctx, f, core.NewTuple(data.Map{"samples": data.Array{data.Int(1), data.Null{}}}))
+			_, err4 := processSignal(ctx, f, core.NewTuple(data.Map{"x": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given the fft UDSF creator", t, func() {
+		Convey("When creating it with invalid arguments", func() {
+			_, _, err1 := createSignalUDSF(ctx, "fft", data.String("s"), data.String("v"), data.Int(0))
+			_, _, err2 := createSignalUDSF(ctx, "fft", data.String("s"), data.String("v"),
+				data.Int(1), data.String("blackman"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+}
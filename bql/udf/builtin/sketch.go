@@ -0,0 +1,548 @@
+package builtin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+)
+
+// sketch is a fixed-size summary of a stream of values which can
+// answer some queries about the stream approximately.
+type sketch interface {
+	// add inserts a non-null value into the sketch.
+	add(v data.Value) error
+
+	// encode returns a representation of the sketch which can be
+	// restored by the corresponding decode function.
+	encode() data.Map
+}
+
+// mixHash applies the finalizer of MurmurHash3 to a hash value computed
+// by data.Hash so that all bits of the result are well distributed.
+func mixHash(h data.HashValue) uint64 {
+	x := uint64(h)
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// sketchBytes returns the content of a Blob in an encoded sketch. A Blob
+// becomes a String after being serialized with msgpack, so a String is
+// also accepted.
+func sketchBytes(v data.Value) ([]byte, error) {
+	switch v.Type() {
+	case data.TypeBlob:
+		return data.AsBlob(v)
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return []byte(s), nil
+	default:
+		return nil, fmt.Errorf("cannot interpret %s (%T) as a blob", v, v)
+	}
+}
+
+const (
+	hllMinPrecision     = 4
+	hllMaxPrecision     = 18
+	hllDefaultPrecision = 14
+)
+
+// hyperLogLog estimates the number of distinct values. It uses 2^precision
+// registers of one byte each and has a standard error of about
+// 1.04/sqrt(2^precision).
+type hyperLogLog struct {
+	precision uint
+	registers []uint8
+}
+
+func newHyperLogLog(precision int) (*hyperLogLog, error) {
+	if precision < hllMinPrecision || precision > hllMaxPrecision {
+		return nil, fmt.Errorf("precision must be between %v and %v: %v",
+			hllMinPrecision, hllMaxPrecision, precision)
+	}
+	return &hyperLogLog{
+		precision: uint(precision),
+		registers: make([]uint8, 1<<uint(precision)),
+	}, nil
+}
+
+func (h *hyperLogLog) add(v data.Value) error {
+	x := mixHash(data.Hash(v))
+	idx := x >> (64 - h.precision)
+	// the rank is the position of the leftmost 1-bit in the remaining
+	// bits, the guard bit bounds it when all of them are zero
+	w := x<<h.precision | 1<<(h.precision-1)
+	rank := uint8(1)
+	for w&(1<<63) == 0 {
+		rank++
+		w <<= 1
+	}
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+	return nil
+}
+
+func (h *hyperLogLog) count() int64 {
+	m := float64(len(h.registers))
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	est := alpha * m * m / sum
+	if est <= 2.5*m && zeros > 0 {
+		// linear counting is more accurate for small cardinalities
+		est = m * math.Log(m/float64(zeros))
+	}
+	return int64(est + 0.5)
+}
+
+func (h *hyperLogLog) encode() data.Map {
+	return data.Map{
+		"precision": data.Int(h.precision),
+		"registers": data.Blob(h.registers),
+	}
+}
+
+func decodeHyperLogLog(m data.Map) (sketch, error) {
+	var s struct {
+		Precision int        `bql:",required"`
+		Registers data.Value `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &s); err != nil {
+		return nil, err
+	}
+	h, err := newHyperLogLog(s.Precision)
+	if err != nil {
+		return nil, err
+	}
+	registers, err := sketchBytes(s.Registers)
+	if err != nil {
+		return nil, err
+	}
+	if len(registers) != len(h.registers) {
+		return nil, fmt.Errorf("the number of registers must be %v: %v",
+			len(h.registers), len(registers))
+	}
+	copy(h.registers, registers)
+	return h, nil
+}
+
+const tDigestDefaultCompression = 100
+
+type centroid struct {
+	mean  float64
+	count float64
+}
+
+// tDigest estimates quantiles of numeric values. It keeps a sorted list of
+// centroids whose sizes are small near both ends of the distribution, so
+// that extreme quantiles are more accurate than the median. The number of
+// centroids is roughly bounded by the compression parameter.
+type tDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	min         float64
+	max         float64
+}
+
+func newTDigest(compression float64) (*tDigest, error) {
+	if compression < 10 || math.IsInf(compression, 0) {
+		return nil, fmt.Errorf("compression must be at least 10: %v", compression)
+	}
+	return &tDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}, nil
+}
+
+func (t *tDigest) add(v data.Value) error {
+	if v.Type() != data.TypeInt && v.Type() != data.TypeFloat {
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	x, _ := data.ToFloat(v)
+	if math.IsNaN(x) {
+		return fmt.Errorf("cannot add NaN")
+	}
+	t.addCentroid(centroid{mean: x, count: 1})
+	return nil
+}
+
+func (t *tDigest) addCentroid(c centroid) {
+	if c.mean < t.min {
+		t.min = c.mean
+	}
+	if c.mean > t.max {
+		t.max = c.mean
+	}
+	t.buffer = append(t.buffer, c)
+	if len(t.buffer) >= int(5*t.compression) {
+		t.compress()
+	}
+}
+
+// tDigestScale maps a quantile to the scale used to limit the sizes of
+// centroids. A centroid can only cover a range of quantiles whose width
+// in this scale is at most 1.
+func tDigestScale(q, compression float64) float64 {
+	return compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// compress merges buffered values into the centroids.
+func (t *tDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.buffer, t.centroids...)
+	t.buffer = nil
+	sort.Sort(centroidsByMean(all))
+
+	total := 0.0
+	for _, c := range all {
+		total += c.count
+	}
+
+	merged := make([]centroid, 0, len(t.centroids)+1)
+	cur := all[0]
+	soFar := 0.0
+	kLeft := tDigestScale(0, t.compression)
+	for _, next := range all[1:] {
+		kRight := tDigestScale((soFar+cur.count+next.count)/total, t.compression)
+		if kRight-kLeft <= 1 {
+			cur.mean += (next.mean - cur.mean) * next.count / (cur.count + next.count)
+			cur.count += next.count
+			continue
+		}
+		soFar += cur.count
+		kLeft = tDigestScale(soFar/total, t.compression)
+		merged = append(merged, cur)
+		cur = next
+	}
+	t.centroids = append(merged, cur)
+}
+
+func (t *tDigest) count() float64 {
+	t.compress()
+	total := 0.0
+	for _, c := range t.centroids {
+		total += c.count
+	}
+	return total
+}
+
+// quantile returns the estimated value at the given quantile. It returns
+// NaN when no value has been added.
+func (t *tDigest) quantile(q float64) float64 {
+	total := t.count()
+	if total == 0 {
+		return math.NaN()
+	}
+	cs := t.centroids
+	if len(cs) == 1 {
+		return cs[0].mean
+	}
+
+	// each centroid is regarded as being located at the center of the
+	// range of ranks it covers
+	target := q * total
+	if first := cs[0].count / 2; target < first {
+		return t.min + (cs[0].mean-t.min)*target/first
+	}
+	cum := 0.0
+	for i := 0; i < len(cs)-1; i++ {
+		left := cum + cs[i].count/2
+		right := cum + cs[i].count + cs[i+1].count/2
+		if target < right {
+			return cs[i].mean + (cs[i+1].mean-cs[i].mean)*(target-left)/(right-left)
+		}
+		cum += cs[i].count
+	}
+	last := cs[len(cs)-1]
+	left := total - last.count/2
+	if target <= left {
+		return last.mean
+	}
+	return last.mean + (t.max-last.mean)*(target-left)/(total-left)
+}
+
+func (t *tDigest) encode() data.Map {
+	t.compress()
+	cs := make(data.Array, len(t.centroids))
+	for i, c := range t.centroids {
+		cs[i] = data.Array{data.Float(c.mean), data.Float(c.count)}
+	}
+	return data.Map{
+		"compression": data.Float(t.compression),
+		"min":         data.Float(t.min),
+		"max":         data.Float(t.max),
+		"centroids":   cs,
+	}
+}
+
+func decodeTDigest(m data.Map) (sketch, error) {
+	var s struct {
+		Compression float64     `bql:",required"`
+		Min         float64     `bql:",required"`
+		Max         float64     `bql:",required"`
+		Centroids   [][]float64 `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &s); err != nil {
+		return nil, err
+	}
+	t, err := newTDigest(s.Compression)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range s.Centroids {
+		if len(c) != 2 || c[1] <= 0 {
+			return nil, fmt.Errorf("invalid centroid: %v", c)
+		}
+		t.centroids = append(t.centroids, centroid{mean: c[0], count: c[1]})
+	}
+	sort.Sort(centroidsByMean(t.centroids))
+	t.min, t.max = s.Min, s.Max
+	return t, nil
+}
+
+type centroidsByMean []centroid
+
+func (c centroidsByMean) Len() int           { return len(c) }
+func (c centroidsByMean) Less(i, j int) bool { return c[i].mean < c[j].mean }
+func (c centroidsByMean) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+const (
+	cmsDefaultWidth = 2048
+	cmsDefaultDepth = 5
+	cmsDefaultK     = 10
+
+	// cmsMaxCounters is the maximum number of counters, width * depth, a
+	// count-min sketch can have.
+	cmsMaxCounters = 1 << 22
+)
+
+// countMinSketch estimates the frequency of values. An estimate is never
+// less than the actual frequency and exceeds it by at most about
+// 2n/width with probability 1-(1/2)^depth where n is the number of
+// values added. It also tracks the k values having the highest estimated
+// frequencies.
+type countMinSketch struct {
+	width  int
+	depth  int
+	counts [][]uint64
+	total  uint64
+
+	k          int
+	candidates map[data.HashValue]data.Value
+}
+
+func newCountMinSketch(width, depth, k int) (*countMinSketch, error) {
+	if width <= 0 {
+		return nil, fmt.Errorf("width must be positive: %v", width)
+	}
+	if depth <= 0 {
+		return nil, fmt.Errorf("depth must be positive: %v", depth)
+	}
+	// Each of width and depth is checked first so that the product doesn't
+	// overflow.
+	if width > cmsMaxCounters || depth > cmsMaxCounters || width*depth > cmsMaxCounters {
+		return nil, fmt.Errorf("width * depth must not be greater than %v: %v * %v",
+			cmsMaxCounters, width, depth)
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("k must not be negative: %v", k)
+	}
+	counts := make([][]uint64, depth)
+	for i := range counts {
+		counts[i] = make([]uint64, width)
+	}
+	return &countMinSketch{
+		width:      width,
+		depth:      depth,
+		counts:     counts,
+		k:          k,
+		candidates: map[data.HashValue]data.Value{},
+	}, nil
+}
+
+// indices calls f with the column index of h for each row.
+func (c *countMinSketch) indices(h data.HashValue, f func(row, col int)) {
+	h1 := mixHash(h)
+	h2 := mixHash(data.HashValue(h1)) | 1
+	for i := 0; i < c.depth; i++ {
+		f(i, int((h1+uint64(i)*h2)%uint64(c.width)))
+	}
+}
+
+func (c *countMinSketch) add(v data.Value) error {
+	h := data.Hash(v)
+	c.indices(h, func(row, col int) {
+		c.counts[row][col]++
+	})
+	c.total++
+
+	if c.k == 0 {
+		return nil
+	}
+	if _, ok := c.candidates[h]; ok {
+		return nil
+	}
+	if len(c.candidates) < c.k {
+		c.candidates[h] = v
+		return nil
+	}
+	// replace the least frequent candidate if the new value has
+	// become more frequent than it
+	var minHash data.HashValue
+	minCount := uint64(math.MaxUint64)
+	for ch := range c.candidates {
+		if n := c.estimateHash(ch); n < minCount {
+			minHash, minCount = ch, n
+		}
+	}
+	if c.estimateHash(h) > minCount {
+		delete(c.candidates, minHash)
+		c.candidates[h] = v
+	}
+	return nil
+}
+
+func (c *countMinSketch) estimateHash(h data.HashValue) uint64 {
+	est := uint64(math.MaxUint64)
+	c.indices(h, func(row, col int) {
+		if n := c.counts[row][col]; n < est {
+			est = n
+		}
+	})
+	return est
+}
+
+func (c *countMinSketch) estimate(v data.Value) int64 {
+	return int64(c.estimateHash(data.Hash(v)))
+}
+
+// topK returns at most k values having the highest estimated frequencies
+// as an array of maps having "value" and "count" keys, the most frequent
+// value first.
+func (c *countMinSketch) topK(k int) data.Array {
+	entries := make(topKEntries, 0, len(c.candidates))
+	for h, v := range c.candidates {
+		entries = append(entries, topKEntry{value: v, count: c.estimateHash(h)})
+	}
+	sort.Sort(entries)
+	if k < len(entries) {
+		entries = entries[:k]
+	}
+	res := make(data.Array, len(entries))
+	for i, e := range entries {
+		res[i] = data.Map{
+			"value": e.value,
+			"count": data.Int(e.count),
+		}
+	}
+	return res
+}
+
+func (c *countMinSketch) encode() data.Map {
+	counts := make([]byte, 8*c.width*c.depth)
+	for i, row := range c.counts {
+		for j, n := range row {
+			binary.LittleEndian.PutUint64(counts[8*(i*c.width+j):], n)
+		}
+	}
+	candidates := make(data.Array, 0, len(c.candidates))
+	for _, v := range c.candidates {
+		candidates = append(candidates, v)
+	}
+	// sort candidates so that the encoded data doesn't depend on the
+	// iteration order of the map
+	sort.Sort(valuesByOrder(candidates))
+	return data.Map{
+		"width":      data.Int(c.width),
+		"depth":      data.Int(c.depth),
+		"k":          data.Int(c.k),
+		"total":      data.Int(c.total),
+		"counts":     data.Blob(counts),
+		"candidates": candidates,
+	}
+}
+
+func decodeCountMinSketch(m data.Map) (sketch, error) {
+	var s struct {
+		Width      int          `bql:",required"`
+		Depth      int          `bql:",required"`
+		K          int          `bql:",required"`
+		Total      int64        `bql:",required"`
+		Counts     data.Value   `bql:",required"`
+		Candidates []data.Value `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &s); err != nil {
+		return nil, err
+	}
+	c, err := newCountMinSketch(s.Width, s.Depth, s.K)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := sketchBytes(s.Counts)
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) != 8*s.Width*s.Depth {
+		return nil, fmt.Errorf("the size of counts must be %v: %v",
+			8*s.Width*s.Depth, len(counts))
+	}
+	for i, row := range c.counts {
+		for j := range row {
+			row[j] = binary.LittleEndian.Uint64(counts[8*(i*s.Width+j):])
+		}
+	}
+	c.total = uint64(s.Total)
+	for _, v := range s.Candidates {
+		c.candidates[data.Hash(v)] = v
+	}
+	return c, nil
+}
+
+type topKEntry struct {
+	value data.Value
+	count uint64
+}
+
+type topKEntries []topKEntry
+
+func (e topKEntries) Len() int { return len(e) }
+func (e topKEntries) Less(i, j int) bool {
+	if e[i].count != e[j].count {
+		return e[i].count > e[j].count
+	}
+	return data.Less(e[i].value, e[j].value)
+}
+func (e topKEntries) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+
+type valuesByOrder []data.Value
+
+func (v valuesByOrder) Len() int           { return len(v) }
+func (v valuesByOrder) Less(i, j int) bool { return data.Less(v[i], v[j]) }
+func (v valuesByOrder) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
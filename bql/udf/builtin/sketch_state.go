@@ -0,0 +1,343 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+)
+
+// sketchState is a UDS holding a sketch. Tuples written to the state via
+// the uds sink add the value at the configured field to the sketch.
+//
+// The state can be created by the following statement:
+//
+//	CREATE STATE device_ids TYPE hyperloglog WITH field = "device_id";
+//
+// Parameters common to all sketch types:
+//
+//	field: the path to the value to be added to the sketch (required)
+type sketchState struct {
+	m        sync.RWMutex
+	typeName string
+	field    string
+	path     data.Path
+	sketch   sketch
+	decode   func(data.Map) (sketch, error)
+}
+
+var (
+	_ core.LoadableSharedState = &sketchState{}
+	_ core.Writer              = &sketchState{}
+)
+
+func (s *sketchState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (s *sketchState) Write(ctx *core.Context, t *core.Tuple) error {
+	v, err := t.Data.Get(s.path)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.sketch.add(v)
+}
+
+func (s *sketchState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.Lock() // t-digest compresses its buffer on encode
+	m := data.Map{
+		"type":   data.String(s.typeName),
+		"field":  data.String(s.field),
+		"sketch": s.sketch.encode(),
+	}
+	s.m.Unlock()
+
+	b, err := data.MarshalMsgpack(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func (s *sketchState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	l, err := loadSketchState(r, s.typeName, s.decode)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.field, s.path, s.sketch = l.field, l.path, l.sketch
+	return nil
+}
+
+func loadSketchState(r io.Reader, typeName string, decode func(data.Map) (sketch, error)) (*sketchState, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved struct {
+		Type   string   `bql:",required"`
+		Field  string   `bql:",required"`
+		Sketch data.Map `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(m, &saved); err != nil {
+		return nil, err
+	}
+	if saved.Type != typeName {
+		return nil, fmt.Errorf("the saved state has a different type: %v", saved.Type)
+	}
+	path, err := data.CompilePath(saved.Field)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := decode(saved.Sketch)
+	if err != nil {
+		return nil, err
+	}
+	return &sketchState{
+		typeName: typeName,
+		field:    saved.Field,
+		path:     path,
+		sketch:   sk,
+		decode:   decode,
+	}, nil
+}
+
+type sketchStateCreator struct {
+	typeName string
+	create   func(params data.Map) (sketch, error)
+	decode   func(data.Map) (sketch, error)
+}
+
+var _ udf.UDSLoader = &sketchStateCreator{}
+
+func (c *sketchStateCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	var p struct {
+		Field string `bql:",required"`
+	}
+	if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+		return nil, err
+	}
+	path, err := data.CompilePath(p.Field)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := c.create(params)
+	if err != nil {
+		return nil, err
+	}
+	return &sketchState{
+		typeName: c.typeName,
+		field:    p.Field,
+		path:     path,
+		sketch:   sk,
+		decode:   c.decode,
+	}, nil
+}
+
+func (c *sketchStateCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	return loadSketchState(r, c.typeName, c.decode)
+}
+
+// hyperLogLogStateCreator creates a UDS estimating the number of distinct
+// values with HyperLogLog.
+//
+// It can be used in BQL as `hyperloglog`.
+//
+// Parameters:
+//
+//	precision: the number of registers is 2^precision, between 4 and 18
+//	           (default: 14)
+var hyperLogLogStateCreator udf.UDSCreator = &sketchStateCreator{
+	typeName: "hyperloglog",
+	create: func(params data.Map) (sketch, error) {
+		p := struct {
+			Precision int
+		}{
+			Precision: hllDefaultPrecision,
+		}
+		if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+			return nil, err
+		}
+		return newHyperLogLog(p.Precision)
+	},
+	decode: decodeHyperLogLog,
+}
+
+// tDigestStateCreator creates a UDS estimating quantiles of numeric
+// values with t-digest.
+//
+// It can be used in BQL as `tdigest`.
+//
+// Parameters:
+//
+//	compression: the larger the value is, the more accurate and the larger
+//	             the sketch becomes, at least 10 (default: 100)
+var tDigestStateCreator udf.UDSCreator = &sketchStateCreator{
+	typeName: "tdigest",
+	create: func(params data.Map) (sketch, error) {
+		p := struct {
+			Compression float64
+		}{
+			Compression: tDigestDefaultCompression,
+		}
+		if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+			return nil, err
+		}
+		return newTDigest(p.Compression)
+	},
+	decode: decodeTDigest,
+}
+
+// countMinSketchStateCreator creates a UDS estimating frequencies of values
+// with a count-min sketch.
+//
+// It can be used in BQL as `count_min_sketch`.
+//
+// Parameters:
+//
+//	width: the number of counters in each row (default: 2048)
+//	depth: the number of rows (default: 5)
+//	       width * depth must not be greater than 4194304 (2^22).
+//	k: the number of the most frequent values to be tracked (default: 10)
+var countMinSketchStateCreator udf.UDSCreator = &sketchStateCreator{
+	typeName: "count_min_sketch",
+	create: func(params data.Map) (sketch, error) {
+		p := struct {
+			Width int
+			Depth int
+			K     int
+		}{
+			Width: cmsDefaultWidth,
+			Depth: cmsDefaultDepth,
+			K:     cmsDefaultK,
+		}
+		if err := data.NewDecoder(nil).Decode(params, &p); err != nil {
+			return nil, err
+		}
+		return newCountMinSketch(p.Width, p.Depth, p.K)
+	},
+	decode: decodeCountMinSketch,
+}
+
+// lookupSketchState returns the sketch state having the given name. The
+// typeName is only used in error messages.
+func lookupSketchState(ctx *core.Context, name string, typeName string) (*sketchState, error) {
+	st, err := ctx.SharedStates.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*sketchState)
+	if !ok || s.typeName != typeName {
+		return nil, fmt.Errorf("state '%v' is not a %v", name, typeName)
+	}
+	return s, nil
+}
+
+// hllCountFunc(state) returns the estimated number of distinct values
+// added to a hyperloglog state.
+//
+// It can be used in BQL as `hll_count`.
+//
+//  Input: String
+//  Return Type: Int
+var hllCountFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string) (int64, error) {
+	s, err := lookupSketchState(ctx, name, "hyperloglog")
+	if err != nil {
+		return 0, err
+	}
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.sketch.(*hyperLogLog).count(), nil
+})
+
+// tDigestQuantileFunc(state, fraction) returns the estimated value at the
+// given fraction (or array of fractions) of the distribution of values
+// added to a tdigest state.
+//
+// It can be used in BQL as `tdigest_quantile`.
+//
+//  Input: String, Float or Array of Floats
+//  Return Type: Float or Array of Floats (Null if the state is empty)
+var tDigestQuantileFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string, fraction data.Value) (data.Value, error) {
+	s, err := lookupSketchState(ctx, name, "tdigest")
+	if err != nil {
+		return nil, err
+	}
+	fracVals, isArray, err := parseFractions(fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	// quantile compresses the buffer of the t-digest
+	s.m.Lock()
+	defer s.m.Unlock()
+	t := s.sketch.(*tDigest)
+	results := make(data.Array, len(fracVals))
+	for i, frac := range fracVals {
+		if q := t.quantile(frac); math.IsNaN(q) {
+			results[i] = data.Null{}
+		} else {
+			results[i] = data.Float(q)
+		}
+	}
+	if isArray {
+		return results, nil
+	}
+	return results[0], nil
+})
+
+// cmsCountFunc(state, value) returns the estimated number of times the
+// value was added to a count_min_sketch state.
+//
+// It can be used in BQL as `cms_count`.
+//
+//  Input: String, Any
+//  Return Type: Int
+var cmsCountFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string, v data.Value) (int64, error) {
+	s, err := lookupSketchState(ctx, name, "count_min_sketch")
+	if err != nil {
+		return 0, err
+	}
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.sketch.(*countMinSketch).estimate(v), nil
+})
+
+// cmsTopKFunc(state, k) returns at most k most frequent values tracked by
+// a count_min_sketch state as an array of maps having "value" and "count"
+// keys. k cannot be greater than the k parameter of the state.
+//
+// It can be used in BQL as `cms_top_k`.
+//
+//  Input: String, Int
+//  Return Type: Array of Maps
+var cmsTopKFunc = udf.MustConvertGeneric(func(ctx *core.Context, name string, k int) (data.Array, error) {
+	s, err := lookupSketchState(ctx, name, "count_min_sketch")
+	if err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be positive: %v", k)
+	}
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.sketch.(*countMinSketch).topK(k), nil
+})
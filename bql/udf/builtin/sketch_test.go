@@ -0,0 +1,271 @@
+package builtin
+
+import (
+	"bytes"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	Convey("Given a HyperLogLog with the default precision", t, func() {
+		h, err := newHyperLogLog(hllDefaultPrecision)
+		So(err, ShouldBeNil)
+
+		Convey("When adding many distinct values", func() {
+			for i := 0; i < 100000; i++ {
+				h.add(data.String(fmt.Sprintf("device%v", i)))
+			}
+
+			Convey("Then the estimate should be within 3%", func() {
+				So(h.count(), ShouldAlmostEqual, 100000, 3000)
+			})
+
+			Convey("Then adding duplicates shouldn't change the estimate", func() {
+				c := h.count()
+				for i := 0; i < 1000; i++ {
+					h.add(data.String(fmt.Sprintf("device%v", i)))
+				}
+				So(h.count(), ShouldEqual, c)
+			})
+
+			Convey("Then it should be restored from the encoded data", func() {
+				s, err := decodeHyperLogLog(h.encode())
+				So(err, ShouldBeNil)
+				So(s.(*hyperLogLog).count(), ShouldEqual, h.count())
+			})
+		})
+	})
+
+	Convey("Given an invalid precision", t, func() {
+		Convey("When creating a HyperLogLog", func() {
+			_, err1 := newHyperLogLog(3)
+			_, err2 := newHyperLogLog(19)
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestTDigest(t *testing.T) {
+	Convey("Given a t-digest with the default compression", t, func() {
+		td, err := newTDigest(tDigestDefaultCompression)
+		So(err, ShouldBeNil)
+
+		Convey("When adding uniformly distributed values in random order", func() {
+			r := rand.New(rand.NewSource(1))
+			for _, i := range r.Perm(100001) {
+				td.add(data.Int(i))
+			}
+
+			Convey("Then quantiles should be estimated accurately", func() {
+				So(td.quantile(0), ShouldEqual, 0)
+				So(td.quantile(0.01), ShouldAlmostEqual, 1000, 100)
+				So(td.quantile(0.5), ShouldAlmostEqual, 50000, 500)
+				So(td.quantile(0.99), ShouldAlmostEqual, 99000, 100)
+				So(td.quantile(1), ShouldEqual, 100000)
+			})
+
+			Convey("Then the number of centroids should be bounded", func() {
+				td.compress()
+				So(len(td.centroids), ShouldBeLessThanOrEqualTo, tDigestDefaultCompression)
+			})
+
+			Convey("Then it should be restored from the encoded data", func() {
+				s, err := decodeTDigest(td.encode())
+				So(err, ShouldBeNil)
+				So(s.(*tDigest).quantile(0.3), ShouldEqual, td.quantile(0.3))
+			})
+		})
+
+		Convey("When adding nothing", func() {
+			Convey("Then the quantile should be NaN", func() {
+				So(math.IsNaN(td.quantile(0.5)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When adding a non-numeric value", func() {
+			err := td.add(data.String("1"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCountMinSketch(t *testing.T) {
+	Convey("Given a count-min sketch tracking top 3 values", t, func() {
+		c, err := newCountMinSketch(cmsDefaultWidth, cmsDefaultDepth, 3)
+		So(err, ShouldBeNil)
+
+		Convey("When adding values with a skewed distribution", func() {
+			for i := 0; i < 1000; i++ {
+				c.add(data.Int(i))
+				for j := 0; j < i%4; j++ {
+					c.add(data.String(fmt.Sprintf("hot%v", j)))
+				}
+			}
+
+			Convey("Then estimates should not be less than actual counts", func() {
+				So(c.estimate(data.String("hot0")), ShouldBeGreaterThanOrEqualTo, 750)
+				So(c.estimate(data.String("hot0")), ShouldBeLessThan, 760)
+				So(c.estimate(data.Int(5)), ShouldBeGreaterThanOrEqualTo, 1)
+				So(c.estimate(data.String("cold")), ShouldBeLessThan, 5)
+			})
+
+			Convey("Then it should return the most frequent values", func() {
+				res := c.topK(3)
+				So(len(res), ShouldEqual, 3)
+				for i, v := range []string{"hot0", "hot1", "hot2"} {
+					m, _ := data.AsMap(res[i])
+					So(m["value"], ShouldResemble, data.String(v))
+				}
+			})
+
+			Convey("Then it should be restored from the encoded data", func() {
+				s, err := decodeCountMinSketch(c.encode())
+				So(err, ShouldBeNil)
+				So(s.(*countMinSketch).topK(3), ShouldResemble, c.topK(3))
+				So(s.(*countMinSketch).estimate(data.Int(5)), ShouldEqual, c.estimate(data.Int(5)))
+			})
+		})
+	})
+}
+
+func TestSketchStates(t *testing.T) {
+	Convey("Given a context having sketch states", t, func() {
+		ctx := core.NewContext(nil)
+		reg, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		states := map[string]core.SharedState{}
+		for _, typeName := range []string{"hyperloglog", "tdigest", "count_min_sketch"} {
+			c, err := reg.Lookup(typeName)
+			So(err, ShouldBeNil)
+			s, err := c.CreateState(ctx, data.Map{"field": data.String("v")})
+			So(err, ShouldBeNil)
+			So(ctx.SharedStates.Add(typeName, typeName, s), ShouldBeNil)
+			states[typeName] = s
+		}
+
+		Convey("When writing tuples to them", func() {
+			for _, v := range []data.Value{data.Int(1), data.Int(2), data.Int(2),
+				data.Null{}, data.Int(3), data.Int(3), data.Int(3)} {
+				for _, s := range states {
+					So(s.(core.Writer).Write(ctx, core.NewTuple(data.Map{"v": v})), ShouldBeNil)
+				}
+			}
+
+			Convey("Then hll_count should return the number of distinct values", func() {
+				v, err := hllCountFunc.Call(ctx, data.String("hyperloglog"))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Int(3))
+			})
+
+			Convey("Then tdigest_quantile should return quantiles", func() {
+				v, err := tDigestQuantileFunc.Call(ctx, data.String("tdigest"), data.Float(0.5))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(2.5))
+
+				v, err = tDigestQuantileFunc.Call(ctx, data.String("tdigest"),
+					data.Array{data.Int(0), data.Int(1)})
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{data.Float(1), data.Float(3)})
+			})
+
+			Convey("Then cms_count and cms_top_k should return frequencies", func() {
+				v, err := cmsCountFunc.Call(ctx, data.String("count_min_sketch"), data.Int(2))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Int(2))
+
+				v, err = cmsTopKFunc.Call(ctx, data.String("count_min_sketch"), data.Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{
+					data.Map{"value": data.Int(3), "count": data.Int(3)},
+				})
+			})
+
+			Convey("Then query functions should fail on a state of another type", func() {
+				_, err := hllCountFunc.Call(ctx, data.String("tdigest"))
+				So(err, ShouldNotBeNil)
+				_, err = cmsCountFunc.Call(ctx, data.String("hyperloglog"), data.Int(2))
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then they should be saved and loaded", func() {
+				for typeName, s := range states {
+					buf := bytes.NewBuffer(nil)
+					So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+					saved := buf.Bytes()
+
+					c, err := reg.Lookup(typeName)
+					So(err, ShouldBeNil)
+					l, err := c.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+					So(err, ShouldBeNil)
+					So(l.(*sketchState).field, ShouldEqual, "v")
+					So(l.(*sketchState).sketch.encode(), ShouldResemble, s.(*sketchState).sketch.encode())
+
+					// Load overwrites the existing state
+					So(s.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+					So(s.(*sketchState).sketch.encode(), ShouldResemble, l.(*sketchState).sketch.encode())
+				}
+			})
+
+			Convey("Then loading data of another type should fail", func() {
+				buf := bytes.NewBuffer(nil)
+				So(states["tdigest"].(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				err := states["hyperloglog"].(core.LoadableSharedState).Load(ctx, buf, data.Map{})
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple without the field", func() {
+			err := states["hyperloglog"].(core.Writer).Write(ctx, core.NewTuple(data.Map{"w": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given the sketch state creators", t, func() {
+		ctx := core.NewContext(nil)
+
+		Convey("When creating a state without the field parameter", func() {
+			_, err := hyperLogLogStateCreator.CreateState(ctx, data.Map{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating a state with an invalid parameter", func() {
+			_, err1 := hyperLogLogStateCreator.CreateState(ctx, data.Map{
+				"field": data.String("v"), "precision": data.Int(30)})
+			_, err2 := tDigestStateCreator.CreateState(ctx, data.Map{
+				"field": data.String("v"), "compression": data.Int(1)})
+			_, err3 := countMinSketchStateCreator.CreateState(ctx, data.Map{
+				"field": data.String("v"), "width": data.Int(0)})
+			_, err4 := countMinSketchStateCreator.CreateState(ctx, data.Map{
+				"field": data.String("v"), "width": data.Int(1 << 21), "depth": data.Int(3)})
+			_, err5 := countMinSketchStateCreator.CreateState(ctx, data.Map{
+				"field": data.String("v"), "width": data.Int(math.MaxInt64), "depth": data.Int(2)})
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+				So(err4, ShouldNotBeNil)
+				So(err5, ShouldNotBeNil)
+			})
+		})
+	})
+}
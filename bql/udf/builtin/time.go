@@ -5,6 +5,9 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -54,3 +57,455 @@ var diffUsFunc udf.UDF = &diffUsFuncTmpl{}
 var clockTimestampFunc = udf.MustConvertGeneric(func() time.Time {
 	return time.Now().In(time.UTC)
 })
+
+// timeFuncTmpl is a template for time functions taking between
+// minParams and maxParams arguments. They return Null if any of the
+// arguments is Null.
+type timeFuncTmpl struct {
+	minParams int
+	maxParams int
+	timeFun   func(args ...data.Value) (data.Value, error)
+}
+
+func (f *timeFuncTmpl) Accept(arity int) bool {
+	return arity >= f.minParams && arity <= f.maxParams
+}
+
+func (f *timeFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *timeFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function takes between %d and %d arguments",
+			f.minParams, f.maxParams)
+	}
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	return f.timeFun(args...)
+}
+
+func timestampArg(v data.Value) (time.Time, error) {
+	if v.Type() != data.TypeTimestamp {
+		return time.Time{}, fmt.Errorf("cannot interpret %s as timestamp", v)
+	}
+	return data.AsTimestamp(v)
+}
+
+func stringArg(v data.Value) (string, error) {
+	if v.Type() != data.TypeString {
+		return "", fmt.Errorf("cannot interpret %s as a string", v)
+	}
+	return data.AsString(v)
+}
+
+var (
+	locationCacheMutex sync.RWMutex
+	locationCache      = map[string]*time.Location{}
+)
+
+// loadLocation returns the location having the given name. The name can
+// be an IANA Time Zone database name like "Asia/Tokyo", "UTC", or a
+// fixed offset like "+09:00". Locations are cached because loading them
+// from the database on each call is slow.
+func loadLocation(name string) (*time.Location, error) {
+	locationCacheMutex.RLock()
+	loc, ok := locationCache[name]
+	locationCacheMutex.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	var err error
+	if strings.HasPrefix(name, "+") || strings.HasPrefix(name, "-") {
+		loc, err = parseZoneOffset(name)
+	} else if name == "" || name == "Local" {
+		// Local depends on the environment of the server
+		err = fmt.Errorf("unknown timezone: '%v'", name)
+	} else {
+		loc, err = time.LoadLocation(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	locationCacheMutex.Lock()
+	defer locationCacheMutex.Unlock()
+	locationCache[name] = loc
+	return loc, nil
+}
+
+// unixTimeUnit returns the number of nanoseconds in the given unit.
+func unixTimeUnit(v data.Value) (int64, error) {
+	unit, err := stringArg(v)
+	if err != nil {
+		return 0, err
+	}
+	switch strings.ToLower(unit) {
+	case "s", "second", "seconds":
+		return int64(time.Second), nil
+	case "ms", "millisecond", "milliseconds":
+		return int64(time.Millisecond), nil
+	case "us", "microsecond", "microseconds":
+		return int64(time.Microsecond), nil
+	case "ns", "nanosecond", "nanoseconds":
+		return int64(time.Nanosecond), nil
+	}
+	return 0, fmt.Errorf("unknown unit: '%v'", unit)
+}
+
+// dateTruncFunc(field, t) truncates the timestamp t to the precision
+// given by field in the timezone of t, which can be changed by
+// at_time_zone. field is one of "microsecond", "millisecond", "second",
+// "minute", "hour", "day", "week" (starting on Monday), "month",
+// "quarter", "year", "decade", "century" and "millennium".
+// See also: PostgreSQL's `date_trunc`.
+//
+// It can be used in BQL as `date_trunc`.
+//
+//  Input: String, Timestamp
+//  Return Type: Timestamp
+var dateTruncFunc udf.UDF = &timeFuncTmpl{
+	minParams: 2,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		field, err := stringArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		t, err := timestampArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		y, mo, d := t.Date()
+		h, mi, s := t.Clock()
+		ns := t.Nanosecond()
+		switch strings.ToLower(field) {
+		case "microsecond":
+			ns = ns / 1000 * 1000
+		case "millisecond":
+			ns = ns / 1000000 * 1000000
+		case "second":
+			ns = 0
+		case "minute":
+			s, ns = 0, 0
+		case "hour":
+			mi, s, ns = 0, 0, 0
+		case "day":
+			h, mi, s, ns = 0, 0, 0, 0
+		case "week":
+			d -= (int(t.Weekday()) + 6) % 7
+			h, mi, s, ns = 0, 0, 0, 0
+		case "month":
+			d, h, mi, s, ns = 1, 0, 0, 0, 0
+		case "quarter":
+			mo = (mo-1)/3*3 + 1
+			d, h, mi, s, ns = 1, 0, 0, 0, 0
+		case "year", "decade", "century", "millennium":
+			switch strings.ToLower(field) {
+			case "decade":
+				y = floorDiv(y, 10) * 10
+			case "century":
+				// the 21st century starts in 2001
+				y = floorDiv(y-1, 100)*100 + 1
+			case "millennium":
+				y = floorDiv(y-1, 1000)*1000 + 1
+			}
+			mo, d, h, mi, s, ns = time.January, 1, 0, 0, 0, 0
+		default:
+			return nil, fmt.Errorf("unknown field: '%v'", field)
+		}
+		return data.Timestamp(time.Date(y, mo, d, h, mi, s, ns, t.Location())), nil
+	},
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// datePartFunc(field, t) returns the field of the timestamp t in the
+// timezone of t, which can be changed by at_time_zone. field is one of
+// "microseconds" (including seconds), "milliseconds" (including seconds),
+// "second" (including the fraction), "minute", "hour", "day", "dow"
+// (0 for Sunday), "isodow" (7 for Sunday), "doy", "week" (ISO 8601 week
+// number), "month", "quarter", "year", "isoyear", "decade", "century",
+// "millennium", "epoch" (seconds since the Unix epoch) and "timezone"
+// (offset from UTC in seconds).
+// See also: PostgreSQL's `date_part` and `EXTRACT`.
+//
+// It can be used in BQL as `date_part` or `extract`.
+//
+//  Input: String, Timestamp
+//  Return Type: Float for "milliseconds", "second" and "epoch", Int for others
+var datePartFunc udf.UDF = &timeFuncTmpl{
+	minParams: 2,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		field, err := stringArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		t, err := timestampArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(field) {
+		case "microseconds":
+			return data.Int(t.Second()*1000000 + t.Nanosecond()/1000), nil
+		case "milliseconds":
+			return data.Float(float64(t.Second())*1000 + float64(t.Nanosecond())/1e6), nil
+		case "second":
+			return data.Float(float64(t.Second()) + float64(t.Nanosecond())/1e9), nil
+		case "minute":
+			return data.Int(t.Minute()), nil
+		case "hour":
+			return data.Int(t.Hour()), nil
+		case "day":
+			return data.Int(t.Day()), nil
+		case "dow":
+			return data.Int(t.Weekday()), nil
+		case "isodow":
+			return data.Int((int(t.Weekday())+6)%7 + 1), nil
+		case "doy":
+			return data.Int(t.YearDay()), nil
+		case "week":
+			_, w := t.ISOWeek()
+			return data.Int(w), nil
+		case "month":
+			return data.Int(t.Month()), nil
+		case "quarter":
+			return data.Int((int(t.Month())-1)/3 + 1), nil
+		case "year":
+			return data.Int(t.Year()), nil
+		case "isoyear":
+			y, _ := t.ISOWeek()
+			return data.Int(y), nil
+		case "decade":
+			return data.Int(floorDiv(t.Year(), 10)), nil
+		case "century":
+			return data.Int(floorDiv(t.Year()-1, 100) + 1), nil
+		case "millennium":
+			return data.Int(floorDiv(t.Year()-1, 1000) + 1), nil
+		case "epoch":
+			return data.Float(float64(t.Unix()) + float64(t.Nanosecond())/1e9), nil
+		case "timezone":
+			_, offset := t.Zone()
+			return data.Int(offset), nil
+		}
+		return nil, fmt.Errorf("unknown field: '%v'", field)
+	},
+}
+
+// toCharFunc(t, format) formats the timestamp t according to format in the
+// timezone of t. The following patterns are replaced in format:
+//
+//	YYYY: year (4 digits), YY: last 2 digits of year
+//	MM: month (01-12), MONTH/Month/month: month name, MON/Mon/mon: abbreviated
+//	  month name (e.g. JAN/Jan/jan)
+//	DD: day of month (01-31), DDD: day of year (001-366)
+//	DAY/Day/day: day name, DY/Dy/dy: abbreviated day name
+//	HH24: hour of day (00-23), HH12 or HH: hour of day (01-12), AM/PM:
+//	  meridiem indicator
+//	MI: minute (00-59), SS: second (00-59), MS: millisecond (000-999),
+//	  US: microsecond (000000-999999)
+//	TZ: timezone abbreviation, OF: offset from UTC (e.g. +09:00)
+//
+// Patterns other than month and day names are case-insensitive. Text
+// enclosed by double quotes is output as it is.
+// See also: PostgreSQL's `to_char`.
+//
+// It can be used in BQL as `to_char`.
+//
+//  Input: Timestamp, String
+//  Return Type: String
+var toCharFunc udf.UDF = &timeFuncTmpl{
+	minParams: 2,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		t, err := timestampArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		format, err := stringArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		items, err := compileTimePattern(format)
+		if err != nil {
+			return nil, err
+		}
+		return data.String(formatTime(t, items)), nil
+	},
+}
+
+// toTimestampFunc(value) converts a number of seconds since the Unix
+// epoch or an RFC3339 string to a timestamp in the same way as CAST does.
+//
+// toTimestampFunc(str, format, [tz]) parses str according to format,
+// which accepts the same patterns as to_char. TZ in format parses a name
+// of a timezone like "Asia/Tokyo" and OF parses an offset like "+09:00"
+// or "Z". When format has neither of them, str is interpreted in the
+// timezone tz, or in UTC if tz is not given. Fields which don't appear
+// in format default to the beginning of the year 1.
+// See also: PostgreSQL's `to_timestamp`.
+//
+// It can be used in BQL as `to_timestamp`.
+//
+//  Input: Int, Float or String  or  String, String, [String]
+//  Return Type: Timestamp (in UTC)
+var toTimestampFunc udf.UDF = &timeFuncTmpl{
+	minParams: 1,
+	maxParams: 3,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) == 1 {
+			switch args[0].Type() {
+			case data.TypeInt, data.TypeFloat, data.TypeString, data.TypeTimestamp:
+			default:
+				return nil, fmt.Errorf("cannot interpret %s as timestamp", args[0])
+			}
+			t, err := data.ToTimestamp(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return data.Timestamp(t.In(time.UTC)), nil
+		}
+
+		str, err := stringArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		format, err := stringArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		loc := time.UTC
+		if len(args) == 3 {
+			name, err := stringArg(args[2])
+			if err != nil {
+				return nil, err
+			}
+			if loc, err = loadLocation(name); err != nil {
+				return nil, err
+			}
+		}
+		items, err := compileTimePattern(format)
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseTime(str, items, loc)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t.In(time.UTC)), nil
+	},
+}
+
+// fromUnixtimeFunc(value, [unit]) converts a number of units since the
+// Unix epoch to a timestamp. unit is one of "s" (default), "ms", "us" and
+// "ns".
+//
+// It can be used in BQL as `from_unixtime`.
+//
+//  Input: Int or Float, [String]
+//  Return Type: Timestamp (in UTC)
+var fromUnixtimeFunc udf.UDF = &timeFuncTmpl{
+	minParams: 1,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		unit := int64(time.Second)
+		if len(args) == 2 {
+			u, err := unixTimeUnit(args[1])
+			if err != nil {
+				return nil, err
+			}
+			unit = u
+		}
+		perSecond := int64(time.Second) / unit
+
+		switch args[0].Type() {
+		case data.TypeInt:
+			i, _ := data.AsInt(args[0])
+			// time.Unix normalizes a negative number of nanoseconds
+			return data.Timestamp(time.Unix(i/perSecond, i%perSecond*unit).In(time.UTC)), nil
+		case data.TypeFloat:
+			f, _ := data.AsFloat(args[0])
+			if math.IsNaN(f) || f < data.MinConvFloat64 || f > data.MaxConvFloat64 {
+				return nil, fmt.Errorf("%v is out of bounds for timestamp conversion", f)
+			}
+			// split the value in the unit first so that an integral
+			// value is converted without loss of precision
+			intPart := math.Floor(f)
+			i := int64(intPart)
+			ns := i%perSecond*unit + int64(math.Floor((f-intPart)*float64(unit)+0.5))
+			return data.Timestamp(time.Unix(i/perSecond, ns).In(time.UTC)), nil
+		}
+		return nil, fmt.Errorf("cannot interpret %s as a number", args[0])
+	},
+}
+
+// toUnixtimeFunc(t, [unit]) returns the number of units since the Unix
+// epoch. unit is one of "s" (default), "ms", "us" and "ns". The result is
+// rounded down to an integer.
+//
+// It can be used in BQL as `to_unixtime`.
+//
+//  Input: Timestamp, [String]
+//  Return Type: Int
+var toUnixtimeFunc udf.UDF = &timeFuncTmpl{
+	minParams: 1,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		t, err := timestampArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		unit := int64(time.Second)
+		if len(args) == 2 {
+			u, err := unixTimeUnit(args[1])
+			if err != nil {
+				return nil, err
+			}
+			unit = u
+		}
+		perSecond := int64(time.Second) / unit
+		return data.Int(t.Unix()*perSecond + int64(t.Nanosecond())/unit), nil
+	},
+}
+
+// atTimeZoneFunc(t, tz) returns the timestamp t in the timezone tz, which
+// can be a name like "Asia/Tokyo" or an offset like "+09:00". The result
+// represents the same instant as t, but functions like date_trunc,
+// date_part and to_char use the timezone to compute their results.
+// Note that the timezone is lost when the timestamp is serialized.
+//
+// It can be used in BQL as `at_time_zone`.
+//
+//  Input: Timestamp, String
+//  Return Type: Timestamp
+var atTimeZoneFunc udf.UDF = &timeFuncTmpl{
+	minParams: 2,
+	maxParams: 2,
+	timeFun: func(args ...data.Value) (data.Value, error) {
+		t, err := timestampArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		name, err := stringArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		loc, err := loadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t.In(loc)), nil
+	},
+}
@@ -0,0 +1,371 @@
+package builtin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// timePatternItem is either a template pattern like "YYYY" or a literal
+// string in a format string given to to_char or to_timestamp.
+type timePatternItem struct {
+	pattern string
+	literal string
+}
+
+// timePatterns is the list of supported template patterns. A longer
+// pattern must come before its prefixes. Patterns are case-insensitive
+// except for the ones having names of months or days, whose case is
+// used as the case of the output.
+var timePatterns = []string{
+	"HH24", "HH12", "HH", "MI", "SS", "MS", "US", "AM", "PM",
+	"YYYY", "YY", "MONTH", "Month", "month", "MON", "Mon", "mon", "MM",
+	"DDD", "DD", "DAY", "Day", "day", "DY", "Dy", "dy", "TZ", "OF",
+}
+
+// compileTimePattern splits a format string into template patterns and
+// literals. A text enclosed by double quotes is always regarded as a
+// literal.
+func compileTimePattern(format string) ([]timePatternItem, error) {
+	items := []timePatternItem{}
+	appendLiteral := func(s string) {
+		if n := len(items); n > 0 && items[n-1].pattern == "" {
+			items[n-1].literal += s
+			return
+		}
+		items = append(items, timePatternItem{literal: s})
+	}
+
+	for i := 0; i < len(format); {
+		if format[i] == '"' {
+			end := strings.IndexByte(format[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted string in the format: %v", format)
+			}
+			appendLiteral(format[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+
+		matched := ""
+		for _, p := range timePatterns {
+			if !strings.HasPrefix(strings.ToUpper(format[i:]), strings.ToUpper(p)) {
+				continue
+			}
+			if isCaseSensitiveTimePattern(p) && !strings.HasPrefix(format[i:], p) {
+				continue
+			}
+			matched = p
+			break
+		}
+		if matched == "" {
+			appendLiteral(format[i : i+1])
+			i++
+			continue
+		}
+		// the case of names of months and days is kept as it's used in
+		// the output
+		if !isCaseSensitiveTimePattern(matched) {
+			matched = strings.ToUpper(matched)
+		}
+		items = append(items, timePatternItem{pattern: matched})
+		i += len(matched)
+	}
+	return items, nil
+}
+
+func isCaseSensitiveTimePattern(p string) bool {
+	switch strings.ToUpper(p) {
+	case "MONTH", "MON", "DAY", "DY":
+		return true
+	}
+	return false
+}
+
+// formatTimeName converts a name of a month or a day to have the same case
+// as the pattern.
+func formatTimeName(name string, pattern string, abbreviate bool) string {
+	if abbreviate {
+		name = name[:3]
+	}
+	switch {
+	case pattern == strings.ToUpper(pattern):
+		return strings.ToUpper(name)
+	case pattern == strings.ToLower(pattern):
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// formatTime formats a time according to the compiled format.
+func formatTime(t time.Time, items []timePatternItem) string {
+	buf := make([]byte, 0, 32)
+	for _, item := range items {
+		switch strings.ToUpper(item.pattern) {
+		case "":
+			buf = append(buf, item.literal...)
+		case "YYYY":
+			buf = append(buf, fmt.Sprintf("%04d", t.Year())...)
+		case "YY":
+			buf = append(buf, fmt.Sprintf("%02d", t.Year()%100)...)
+		case "MM":
+			buf = append(buf, fmt.Sprintf("%02d", int(t.Month()))...)
+		case "MONTH":
+			buf = append(buf, formatTimeName(t.Month().String(), item.pattern, false)...)
+		case "MON":
+			buf = append(buf, formatTimeName(t.Month().String(), item.pattern, true)...)
+		case "DDD":
+			buf = append(buf, fmt.Sprintf("%03d", t.YearDay())...)
+		case "DD":
+			buf = append(buf, fmt.Sprintf("%02d", t.Day())...)
+		case "DAY":
+			buf = append(buf, formatTimeName(t.Weekday().String(), item.pattern, false)...)
+		case "DY":
+			buf = append(buf, formatTimeName(t.Weekday().String(), item.pattern, true)...)
+		case "HH24":
+			buf = append(buf, fmt.Sprintf("%02d", t.Hour())...)
+		case "HH12", "HH":
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			buf = append(buf, fmt.Sprintf("%02d", h)...)
+		case "MI":
+			buf = append(buf, fmt.Sprintf("%02d", t.Minute())...)
+		case "SS":
+			buf = append(buf, fmt.Sprintf("%02d", t.Second())...)
+		case "MS":
+			buf = append(buf, fmt.Sprintf("%03d", t.Nanosecond()/1000000)...)
+		case "US":
+			buf = append(buf, fmt.Sprintf("%06d", t.Nanosecond()/1000)...)
+		case "AM", "PM":
+			if t.Hour() < 12 {
+				buf = append(buf, "AM"...)
+			} else {
+				buf = append(buf, "PM"...)
+			}
+		case "TZ":
+			name, _ := t.Zone()
+			buf = append(buf, name...)
+		case "OF":
+			_, offset := t.Zone()
+			sign := '+'
+			if offset < 0 {
+				sign = '-'
+				offset = -offset
+			}
+			buf = append(buf, fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)...)
+		}
+	}
+	return string(buf)
+}
+
+// timeParser holds fields parsed by parseTime.
+type timeParser struct {
+	s string
+
+	year, month, day, yearDay int
+	hour, minute, second, ns  int
+	hour12, pm                bool
+	loc                       *time.Location
+}
+
+// number reads a decimal number having at most maxDigits digits.
+func (p *timeParser) number(maxDigits int) (int, int, error) {
+	n, digits := 0, 0
+	for digits < maxDigits && digits < len(p.s) && '0' <= p.s[digits] && p.s[digits] <= '9' {
+		n = n*10 + int(p.s[digits]-'0')
+		digits++
+	}
+	if digits == 0 {
+		return 0, 0, fmt.Errorf("a number is expected at '%v'", p.s)
+	}
+	p.s = p.s[digits:]
+	return n, digits, nil
+}
+
+// name reads one of the given names case-insensitively and returns its
+// index.
+func (p *timeParser) name(names []string, abbreviate bool) (int, error) {
+	for i, n := range names {
+		if abbreviate {
+			n = n[:3]
+		}
+		if len(p.s) >= len(n) && strings.EqualFold(p.s[:len(n)], n) {
+			p.s = p.s[len(n):]
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("a name of a month or a day is expected at '%v'", p.s)
+}
+
+func (p *timeParser) parse(pattern string) (err error) {
+	switch strings.ToUpper(pattern) {
+	case "YYYY":
+		p.year, _, err = p.number(4)
+	case "YY":
+		p.year, _, err = p.number(2)
+		p.year += 2000
+	case "MM":
+		p.month, _, err = p.number(2)
+	case "MONTH", "MON":
+		p.month, err = p.name(monthNames, strings.ToUpper(pattern) == "MON")
+		p.month++
+	case "DDD":
+		p.yearDay, _, err = p.number(3)
+	case "DD":
+		p.day, _, err = p.number(2)
+	case "DAY", "DY":
+		// the day of the week is parsed but ignored
+		_, err = p.name(dayNames, strings.ToUpper(pattern) == "DY")
+	case "HH24":
+		p.hour, _, err = p.number(2)
+	case "HH12", "HH":
+		p.hour, _, err = p.number(2)
+		p.hour12 = true
+	case "MI":
+		p.minute, _, err = p.number(2)
+	case "SS":
+		p.second, _, err = p.number(2)
+	case "MS", "US":
+		// the number is a fraction of a second, so "SS.MS" parses "1.5"
+		// as 1.5 seconds
+		maxDigits := 3
+		if strings.ToUpper(pattern) == "US" {
+			maxDigits = 6
+		}
+		var n, digits int
+		n, digits, err = p.number(maxDigits)
+		for ; digits < 9; digits++ {
+			n *= 10
+		}
+		p.ns = n
+	case "AM", "PM":
+		if len(p.s) >= 2 && strings.EqualFold(p.s[:2], "AM") {
+			p.pm = false
+		} else if len(p.s) >= 2 && strings.EqualFold(p.s[:2], "PM") {
+			p.pm = true
+		} else {
+			return fmt.Errorf("AM or PM is expected at '%v'", p.s)
+		}
+		p.s = p.s[2:]
+	case "TZ":
+		end := strings.IndexFunc(p.s, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("/_-+", r)
+		})
+		if end < 0 {
+			end = len(p.s)
+		}
+		p.loc, err = loadLocation(p.s[:end])
+		p.s = p.s[end:]
+	case "OF":
+		end := strings.IndexFunc(p.s, func(r rune) bool {
+			return !unicode.IsDigit(r) && !strings.ContainsRune("Z+-:", r)
+		})
+		if end < 0 {
+			end = len(p.s)
+		}
+		p.loc, err = parseZoneOffset(p.s[:end])
+		p.s = p.s[end:]
+	}
+	return err
+}
+
+var (
+	monthNames = []string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"}
+	dayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday",
+		"Friday", "Saturday"}
+)
+
+// parseTime parses a string according to the compiled format. Fields
+// which don't appear in the format default to the beginning of the year
+// 1, which is the same as PostgreSQL's to_timestamp. When the format
+// doesn't contain a timezone, loc is used.
+func parseTime(s string, items []timePatternItem, loc *time.Location) (time.Time, error) {
+	p := &timeParser{
+		s:     s,
+		year:  1,
+		month: 1,
+		day:   1,
+		loc:   loc,
+	}
+	hasField := false
+	for _, item := range items {
+		if item.pattern == "" {
+			if !strings.HasPrefix(p.s, item.literal) {
+				return time.Time{}, fmt.Errorf("'%v' is expected at '%v'", item.literal, p.s)
+			}
+			p.s = p.s[len(item.literal):]
+			continue
+		}
+		hasField = true
+		if err := p.parse(item.pattern); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if !hasField {
+		return time.Time{}, fmt.Errorf("the format doesn't have any field")
+	}
+	if p.s != "" {
+		return time.Time{}, fmt.Errorf("the input has extra text: '%v'", p.s)
+	}
+
+	if p.hour12 {
+		if p.hour < 1 || p.hour > 12 {
+			return time.Time{}, fmt.Errorf("hour must be between 1 and 12: %v", p.hour)
+		}
+		p.hour %= 12
+	}
+	if p.pm {
+		p.hour += 12
+	}
+	if p.month < 1 || p.month > 12 {
+		return time.Time{}, fmt.Errorf("month must be between 1 and 12: %v", p.month)
+	}
+	if p.hour > 23 || p.minute > 59 || p.second > 59 {
+		return time.Time{}, fmt.Errorf("invalid time: %02d:%02d:%02d", p.hour, p.minute, p.second)
+	}
+
+	if p.yearDay > 0 {
+		t := time.Date(p.year, time.January, p.yearDay, p.hour, p.minute, p.second, p.ns, p.loc)
+		if t.Year() != p.year {
+			return time.Time{}, fmt.Errorf("invalid day of the year: %v", p.yearDay)
+		}
+		return t, nil
+	}
+	t := time.Date(p.year, time.Month(p.month), p.day, p.hour, p.minute, p.second, p.ns, p.loc)
+	if t.Day() != p.day {
+		return time.Time{}, fmt.Errorf("invalid date: %04d-%02d-%02d", p.year, p.month, p.day)
+	}
+	return t, nil
+}
+
+// parseZoneOffset parses a timezone offset like "+09:00", "-0530", "+09"
+// or "Z".
+func parseZoneOffset(s string) (*time.Location, error) {
+	if s == "Z" {
+		return time.UTC, nil
+	}
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return nil, fmt.Errorf("invalid timezone offset: '%v'", s)
+	}
+	digits := strings.Replace(s[1:], ":", "", 1)
+	if len(digits) == 2 {
+		digits += "00"
+	}
+	if len(digits) != 4 || strings.IndexFunc(digits, func(r rune) bool {
+		return r < '0' || r > '9'
+	}) >= 0 {
+		return nil, fmt.Errorf("invalid timezone offset: '%v'", s)
+	}
+	hours, _ := strconv.Atoi(digits[:2])
+	minutes, _ := strconv.Atoi(digits[2:])
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(s, offset), nil
+}
@@ -52,6 +52,7 @@ func TestClockTimestampFunc(t *testing.T) {
 func TestBinaryDateFuncs(t *testing.T) {
 	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
 	nextTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+	richTime := time.Date(2016, time.February, 28, 15, 4, 5, 123456789, time.UTC)
 
 	invalidInputs := []udfBinaryTestCaseInput{
 		// NULL input -> NULL output
@@ -75,6 +76,131 @@ func TestBinaryDateFuncs(t *testing.T) {
 			{data.Timestamp(time.Date(2015, time.May, 1, 14, 28, 0, 0, time.UTC)), data.Timestamp(someTime),
 				data.Int(-60 * 1000 * 1000)},
 		}},
+		{"date_trunc", dateTruncFunc, []udfBinaryTestCaseInput{
+			{data.String("microsecond"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123456000, time.UTC))},
+			{data.String("millisecond"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123000000, time.UTC))},
+			{data.String("second"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 0, time.UTC))},
+			{data.String("minute"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 0, 0, time.UTC))},
+			{data.String("HOUR"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 0, 0, 0, time.UTC))},
+			{data.String("day"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 28, 0, 0, 0, 0, time.UTC))},
+			// 2016-02-28 is Sunday
+			{data.String("week"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 22, 0, 0, 0, 0, time.UTC))},
+			{data.String("month"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("quarter"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("year"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("decade"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("century"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("millennium"), data.Timestamp(richTime),
+				data.Timestamp(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			{data.String("fortnight"), data.Timestamp(richTime), nil},
+			{data.String("day"), data.String("2016-02-28T15:04:05Z"), nil},
+		}},
+		{"date_part", datePartFunc, []udfBinaryTestCaseInput{
+			{data.String("microseconds"), data.Timestamp(richTime), data.Int(5123456)},
+			{data.String("milliseconds"), data.Timestamp(richTime), data.Float(5123.456789)},
+			{data.String("second"), data.Timestamp(richTime), data.Float(5.123456789)},
+			{data.String("minute"), data.Timestamp(richTime), data.Int(4)},
+			{data.String("hour"), data.Timestamp(richTime), data.Int(15)},
+			{data.String("day"), data.Timestamp(richTime), data.Int(28)},
+			{data.String("dow"), data.Timestamp(richTime), data.Int(0)},
+			{data.String("isodow"), data.Timestamp(richTime), data.Int(7)},
+			{data.String("doy"), data.Timestamp(richTime), data.Int(59)},
+			{data.String("week"), data.Timestamp(richTime), data.Int(8)},
+			{data.String("Month"), data.Timestamp(richTime), data.Int(2)},
+			{data.String("quarter"), data.Timestamp(richTime), data.Int(1)},
+			{data.String("year"), data.Timestamp(richTime), data.Int(2016)},
+			{data.String("isoyear"), data.Timestamp(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				data.Int(2015)},
+			{data.String("decade"), data.Timestamp(richTime), data.Int(201)},
+			{data.String("century"), data.Timestamp(richTime), data.Int(21)},
+			{data.String("millennium"), data.Timestamp(richTime), data.Int(3)},
+			{data.String("epoch"), data.Timestamp(richTime), data.Float(1456671845.123456789)},
+			{data.String("timezone"), data.Timestamp(richTime), data.Int(0)},
+			{data.String("fortnight"), data.Timestamp(richTime), nil},
+		}},
+		{"to_char", toCharFunc, []udfBinaryTestCaseInput{
+			{data.Timestamp(richTime), data.String("YYYY-MM-DD HH24:MI:SS.MS"),
+				data.String("2016-02-28 15:04:05.123")},
+			{data.Timestamp(richTime), data.String("yy/mm/dd hh12:mi:ss.us am"),
+				data.String("16/02/28 03:04:05.123456 PM")},
+			{data.Timestamp(richTime), data.String("Day, DD Month YYYY (DDD) TZ OF"),
+				data.String("Sunday, 28 February 2016 (059) UTC +00:00")},
+			{data.Timestamp(richTime), data.String("DY dy MON mon"),
+				data.String("SUN sun FEB feb")},
+			{data.Timestamp(richTime), data.String(`"Day" Dy "at" HH`),
+				data.String("Day Sun at 03")},
+			{data.Timestamp(richTime), data.String(`"unterminated`), nil},
+			{data.String("2016-02-28"), data.String("YYYY"), nil},
+		}},
+		{"to_timestamp", toTimestampFunc, []udfBinaryTestCaseInput{
+			{data.String("2016-02-28 15:04:05.123"), data.String("YYYY-MM-DD HH24:MI:SS.MS"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123000000, time.UTC))},
+			{data.String("28/Feb/2016:03:04:05 pm +0900"), data.String("DD/Mon/YYYY:HH12:MI:SS AM OF"),
+				data.Timestamp(time.Date(2016, time.February, 28, 6, 4, 5, 0, time.UTC))},
+			{data.String("2016-02-28T15:04:05.5Z"), data.String(`YYYY-MM-DD"T"HH24:MI:SS.USOF`),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 500000000, time.UTC))},
+			{data.String("2016.059 Asia/Tokyo"), data.String("YYYY.DDD TZ"),
+				data.Timestamp(time.Date(2016, time.February, 27, 15, 0, 0, 0, time.UTC))},
+			{data.String("Sunday February 28 16"), data.String("Day Month DD YY"),
+				data.Timestamp(time.Date(2016, time.February, 28, 0, 0, 0, 0, time.UTC))},
+			{data.String("10:30"), data.String("HH24:MI"),
+				data.Timestamp(time.Date(1, time.January, 1, 10, 30, 0, 0, time.UTC))},
+			// invalid dates
+			{data.String("2015-02-29"), data.String("YYYY-MM-DD"), nil},
+			{data.String("2015-13-01"), data.String("YYYY-MM-DD"), nil},
+			{data.String("25:00"), data.String("HH24:MI"), nil},
+			{data.String("13:00 AM"), data.String("HH:MI AM"), nil},
+			// mismatches
+			{data.String("2015/02/28"), data.String("YYYY-MM-DD"), nil},
+			{data.String("2015-02-28 extra"), data.String("YYYY-MM-DD"), nil},
+			{data.String("Foo 28"), data.String("Mon DD"), nil},
+			{data.String("2015 JST"), data.String("YYYY TZ"), nil},
+		}},
+		{"from_unixtime", fromUnixtimeFunc, []udfBinaryTestCaseInput{
+			{data.Int(1456671845), data.String("s"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 0, time.UTC))},
+			{data.Int(1456671845123), data.String("ms"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123000000, time.UTC))},
+			{data.Int(1456671845123456), data.String("us"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123456000, time.UTC))},
+			{data.Int(1456671845123456789), data.String("ns"), data.Timestamp(richTime)},
+			{data.Int(-1), data.String("ms"),
+				data.Timestamp(time.Date(1969, time.December, 31, 23, 59, 59, 999000000, time.UTC))},
+			{data.Float(1456671845.5), data.String("seconds"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 500000000, time.UTC))},
+			{data.Float(1456671845123.0), data.String("ms"),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 123000000, time.UTC))},
+			{data.Int(1), data.String("minutes"), nil},
+			{data.Float(math.Inf(1)), data.String("s"), nil},
+			{data.String("1"), data.String("s"), nil},
+		}},
+		{"to_unixtime", toUnixtimeFunc, []udfBinaryTestCaseInput{
+			{data.Timestamp(richTime), data.String("s"), data.Int(1456671845)},
+			{data.Timestamp(richTime), data.String("ms"), data.Int(1456671845123)},
+			{data.Timestamp(richTime), data.String("us"), data.Int(1456671845123456)},
+			{data.Timestamp(richTime), data.String("ns"), data.Int(1456671845123456789)},
+			{data.Timestamp(time.Date(1969, time.December, 31, 23, 59, 59, 999500000, time.UTC)),
+				data.String("ms"), data.Int(-1)},
+			{data.Timestamp(richTime), data.String("hours"), nil},
+		}},
+		{"at_time_zone", atTimeZoneFunc, []udfBinaryTestCaseInput{
+			{data.Timestamp(richTime), data.String("UTC"), data.Timestamp(richTime)},
+			{data.Timestamp(richTime), data.String("Mars/Olympus_Mons"), nil},
+			{data.Timestamp(richTime), data.String("Local"), nil},
+			{data.Timestamp(richTime), data.String("+9"), nil},
+		}},
 	}
 
 	for _, testCase := range udfBinaryTestCases {
@@ -123,3 +249,122 @@ func TestBinaryDateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestUnaryDateFuncs(t *testing.T) {
+	udfUnaryTestCases := []udfUnaryTestCase{
+		{"to_timestamp", toTimestampFunc, []udfUnaryTestCaseInput{
+			{data.Null{}, data.Null{}},
+			{data.Int(1456671845),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 0, time.UTC))},
+			{data.Float(1456671845.5),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 500000000, time.UTC))},
+			{data.String("2016-02-28T15:04:05+09:00"),
+				data.Timestamp(time.Date(2016, time.February, 28, 6, 4, 5, 0, time.UTC))},
+			{data.String("2016-02-28"), nil},
+			{data.Bool(true), nil},
+		}},
+		{"from_unixtime", fromUnixtimeFunc, []udfUnaryTestCaseInput{
+			{data.Null{}, data.Null{}},
+			{data.Int(1456671845),
+				data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 0, time.UTC))},
+			{data.String("1456671845"), nil},
+		}},
+		{"to_unixtime", toUnixtimeFunc, []udfUnaryTestCaseInput{
+			{data.Null{}, data.Null{}},
+			{data.Timestamp(time.Date(2016, time.February, 28, 15, 4, 5, 999999999, time.UTC)),
+				data.Int(1456671845)},
+			{data.Int(1456671845), nil},
+		}},
+	}
+
+	for _, testCase := range udfUnaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T)", tc.input, tc.input), func() {
+					val, err := f.Call(nil, tc.input)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 1)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestTimeZoneConversion(t *testing.T) {
+	someTime := time.Date(2016, time.February, 28, 15, 4, 5, 0, time.UTC)
+
+	Convey("Given a timestamp converted to Asia/Tokyo", t, func() {
+		v, err := atTimeZoneFunc.Call(nil, data.Timestamp(someTime), data.String("Asia/Tokyo"))
+		So(err, ShouldBeNil)
+
+		Convey("Then it should represent the same instant", func() {
+			ts, _ := data.AsTimestamp(v)
+			So(ts.Equal(someTime), ShouldBeTrue)
+		})
+
+		Convey("Then date_part should return fields in the timezone", func() {
+			h, err := datePartFunc.Call(nil, data.String("hour"), v)
+			So(err, ShouldBeNil)
+			So(h, ShouldResemble, data.Int(0))
+			d, err := datePartFunc.Call(nil, data.String("day"), v)
+			So(err, ShouldBeNil)
+			So(d, ShouldResemble, data.Int(29))
+			z, err := datePartFunc.Call(nil, data.String("timezone"), v)
+			So(err, ShouldBeNil)
+			So(z, ShouldResemble, data.Int(9*3600))
+		})
+
+		Convey("Then date_trunc should truncate in the timezone", func() {
+			d, err := dateTruncFunc.Call(nil, data.String("day"), v)
+			So(err, ShouldBeNil)
+			ts, _ := data.AsTimestamp(d)
+			So(ts.Equal(time.Date(2016, time.February, 28, 15, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		})
+
+		Convey("Then to_char should format it in the timezone", func() {
+			s, err := toCharFunc.Call(nil, v, data.String("YYYY-MM-DD HH24:MI:SS TZ OF"))
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, data.String("2016-02-29 00:04:05 JST +09:00"))
+		})
+	})
+
+	Convey("Given a timestamp converted to a fixed offset", t, func() {
+		v, err := atTimeZoneFunc.Call(nil, data.Timestamp(someTime), data.String("-05:30"))
+		So(err, ShouldBeNil)
+
+		Convey("Then to_char should format it with the offset", func() {
+			s, err := toCharFunc.Call(nil, v, data.String("HH24:MI OF"))
+			So(err, ShouldBeNil)
+			So(s, ShouldResemble, data.String("09:34 -05:30"))
+		})
+	})
+
+	Convey("Given a local time string and its timezone", t, func() {
+		v, err := toTimestampFunc.Call(nil, data.String("2016-02-29 00:04:05"),
+			data.String("YYYY-MM-DD HH24:MI:SS"), data.String("Asia/Tokyo"))
+
+		Convey("Then to_timestamp should return the timestamp in UTC", func() {
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, data.Timestamp(someTime))
+		})
+	})
+}
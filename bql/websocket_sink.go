@@ -0,0 +1,205 @@
+package bql
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"golang.org/x/net/websocket"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// WebSocketStreamer is implemented by sinks which stream tuples to WebSocket
+// clients. The server serves such a sink at
+// /api/v1/topologies/:topologyName/sinks/:sinkName/wsstream.
+type WebSocketStreamer interface {
+	// ServeWebSocket handles a WebSocket handshake request and streams
+	// tuples written to the sink through the connection until the client
+	// disconnects or the sink is closed.
+	ServeWebSocket(w http.ResponseWriter, r *http.Request)
+}
+
+const (
+	// wsDropOldest drops the oldest tuple in the buffer of a slow client.
+	wsDropOldest = "oldest"
+
+	// wsDropNewest drops a new tuple when the buffer of a client is full.
+	wsDropNewest = "newest"
+
+	// wsDropDisconnect disconnects a client whose buffer is full.
+	wsDropDisconnect = "disconnect"
+)
+
+// websocketSinkClient is a client connected to a websocketSink.
+type websocketSinkClient struct {
+	// buf has JSON encoded tuples which haven't been sent to the client.
+	buf chan []byte
+
+	// done is closed when the client should be disconnected.
+	done chan struct{}
+}
+
+// websocketSink broadcasts tuples to all connected WebSocket clients. Each
+// tuple is sent as a JSON object in a text message. Because each client has
+// its own buffer, a slow client doesn't block the sink or other clients.
+// When the buffer of a client is full, dropPolicy decides what to do.
+type websocketSink struct {
+	ctx        *core.Context
+	ioParams   *IOParams
+	bufferSize int
+	dropPolicy string
+
+	m          sync.Mutex
+	clients    map[*websocketSinkClient]struct{}
+	numDropped int64
+	closed     bool
+}
+
+var (
+	_ WebSocketStreamer = &websocketSink{}
+	_ core.Statuser     = &websocketSink{}
+)
+
+func (s *websocketSink) Write(ctx *core.Context, t *core.Tuple) error {
+	msg := []byte(t.Data.String())
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	for c := range s.clients {
+		s.enqueue(c, msg)
+	}
+	return nil
+}
+
+// enqueue adds a message to the buffer of the client. The caller must hold
+// the lock.
+func (s *websocketSink) enqueue(c *websocketSinkClient, msg []byte) {
+	select {
+	case c.buf <- msg:
+		return
+	default:
+	}
+
+	s.numDropped++
+	switch s.dropPolicy {
+	case wsDropOldest:
+		// Because only Write adds messages while holding the lock, the
+		// buffer has room after removing one unless the client has drained
+		// it in the meantime, in which case msg can be added anyway.
+		select {
+		case <-c.buf:
+		default:
+		}
+		c.buf <- msg
+	case wsDropNewest:
+	case wsDropDisconnect:
+		delete(s.clients, c)
+		close(c.done)
+	}
+}
+
+func (s *websocketSink) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	websocket.Handler(s.serve).ServeHTTP(w, r)
+}
+
+func (s *websocketSink) serve(conn *websocket.Conn) {
+	c := &websocketSinkClient{
+		buf:  make(chan []byte, s.bufferSize),
+		done: make(chan struct{}),
+	}
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return
+	}
+	s.clients[c] = struct{}{}
+	s.m.Unlock()
+	defer func() {
+		s.m.Lock()
+		defer s.m.Unlock()
+		delete(s.clients, c)
+	}()
+
+	// Messages from the client are discarded. Reading them is required to
+	// detect that the client has disconnected.
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		io.Copy(ioutil.Discard, conn)
+	}()
+
+	for {
+		select {
+		case msg := <-c.buf:
+			if err := websocket.Message.Send(conn, string(msg)); err != nil {
+				s.ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					WithField("remote_addr", conn.Request().RemoteAddr).
+					Warning("Cannot send a tuple to the WebSocket client")
+				return
+			}
+		case <-c.done:
+			return
+		case <-readDone:
+			return
+		}
+	}
+}
+
+func (s *websocketSink) Status() data.Map {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Map{
+		"num_clients": data.Int(len(s.clients)),
+		"num_dropped": data.Int(s.numDropped),
+	}
+}
+
+func (s *websocketSink) Close(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for c := range s.clients {
+		close(c.done)
+	}
+	s.clients = nil
+	return nil
+}
+
+func createWebSocketSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	v := &struct {
+		BufferSize int
+		DropPolicy string
+	}{
+		BufferSize: 1024,
+		DropPolicy: wsDropOldest,
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+	if v.BufferSize <= 0 {
+		return nil, fmt.Errorf("'buffer_size' parameter must be positive: %v", v.BufferSize)
+	}
+	switch v.DropPolicy {
+	case wsDropOldest, wsDropNewest, wsDropDisconnect:
+	default:
+		return nil, fmt.Errorf("'drop_policy' parameter must be oldest, newest, or disconnect: %v", v.DropPolicy)
+	}
+
+	return &websocketSink{
+		ctx:        ctx,
+		ioParams:   ioParams,
+		bufferSize: v.BufferSize,
+		dropPolicy: v.DropPolicy,
+		clients:    map[*websocketSinkClient]struct{}{},
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalSinkCreator("websocket", SinkCreatorFunc(createWebSocketSink))
+}
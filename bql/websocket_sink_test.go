@@ -0,0 +1,171 @@
+package bql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/websocket"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// numWebSocketClients returns the number of clients after waiting until the
+// sink has n clients or one second passes.
+func numWebSocketClients(s *websocketSink, n int) int {
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.m.Lock()
+		l := len(s.clients)
+		s.m.Unlock()
+		if l == n || time.Now().After(deadline) {
+			return l
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWebSocketSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a websocket sink", t, func() {
+		params := data.Map{}
+		create := func() *websocketSink {
+			s, err := createWebSocketSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			return s.(*websocketSink)
+		}
+		tuple := func(i int) *core.Tuple {
+			return core.NewTuple(data.Map{"i": data.Int(i)})
+		}
+
+		Convey("When clients are connected", func() {
+			s := create()
+			server := httptest.NewServer(http.HandlerFunc(s.ServeWebSocket))
+			Reset(server.Close)
+			url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+			conns := make([]*websocket.Conn, 2)
+			for i := range conns {
+				conn, err := websocket.Dial(url, "", server.URL)
+				So(err, ShouldBeNil)
+				Reset(func() {
+					conn.Close()
+				})
+				conns[i] = conn
+			}
+			So(numWebSocketClients(s, 2), ShouldEqual, 2)
+
+			So(s.Write(ctx, tuple(1)), ShouldBeNil)
+			So(s.Write(ctx, tuple(2)), ShouldBeNil)
+
+			Convey("Then all clients should receive tuples as JSON", func() {
+				for _, conn := range conns {
+					for _, expected := range []string{`{"i":1}`, `{"i":2}`} {
+						var msg string
+						So(websocket.Message.Receive(conn, &msg), ShouldBeNil)
+						So(msg, ShouldEqual, expected)
+					}
+				}
+			})
+
+			Convey("Then the status should have the number of clients", func() {
+				So(s.Status()["num_clients"], ShouldEqual, data.Int(2))
+			})
+
+			Convey("Then a disconnected client should be removed", func() {
+				conns[0].Close()
+				So(numWebSocketClients(s, 1), ShouldEqual, 1)
+			})
+
+			Convey("Then closing the sink should disconnect clients", func() {
+				So(s.Close(ctx), ShouldBeNil)
+				for _, conn := range conns {
+					var msg string
+					var err error
+					for err == nil {
+						err = websocket.Message.Receive(conn, &msg)
+					}
+					So(err, ShouldNotBeNil)
+				}
+				So(numWebSocketClients(s, 0), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the buffer of a client gets full", func() {
+			params["buffer_size"] = data.Int(2)
+			received := func(c *websocketSinkClient) []string {
+				res := []string{}
+				for len(c.buf) > 0 {
+					res = append(res, string(<-c.buf))
+				}
+				return res
+			}
+			addClient := func(s *websocketSink) *websocketSinkClient {
+				c := &websocketSinkClient{
+					buf:  make(chan []byte, s.bufferSize),
+					done: make(chan struct{}),
+				}
+				s.clients[c] = struct{}{}
+				return c
+			}
+
+			Convey("Then the oldest tuple should be dropped by default", func() {
+				s := create()
+				c := addClient(s)
+				for i := 1; i <= 3; i++ {
+					So(s.Write(ctx, tuple(i)), ShouldBeNil)
+				}
+				So(received(c), ShouldResemble, []string{`{"i":2}`, `{"i":3}`})
+				So(s.Status()["num_dropped"], ShouldEqual, data.Int(1))
+			})
+
+			Convey("Then the newest tuple should be dropped with the newest policy", func() {
+				params["drop_policy"] = data.String("newest")
+				s := create()
+				c := addClient(s)
+				for i := 1; i <= 3; i++ {
+					So(s.Write(ctx, tuple(i)), ShouldBeNil)
+				}
+				So(received(c), ShouldResemble, []string{`{"i":1}`, `{"i":2}`})
+			})
+
+			Convey("Then the client should be disconnected with the disconnect policy", func() {
+				params["drop_policy"] = data.String("disconnect")
+				s := create()
+				c := addClient(s)
+				for i := 1; i <= 3; i++ {
+					So(s.Write(ctx, tuple(i)), ShouldBeNil)
+				}
+				closed := false
+				select {
+				case <-c.done:
+					closed = true
+				default:
+				}
+				So(closed, ShouldBeTrue)
+				So(s.clients, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When creating the sink with invalid parameters", func() {
+			Convey("Then non-positive buffer_size should result in an error", func() {
+				params["buffer_size"] = data.Int(0)
+				_, err := createWebSocketSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an unknown drop_policy should result in an error", func() {
+				params["drop_policy"] = data.String("block")
+				_, err := createWebSocketSink(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,200 @@
+package bql
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// websocketSource connects to a WebSocket server and emits records received
+// from it. A message can have a JSON object, a JSON array of objects, or
+// JSON Lines, so the source can receive tuples from the websocket sink of
+// another SensorBee server. When the connection is lost, the source
+// reconnects to the server after reconnectInterval.
+type websocketSource struct {
+	config            *websocket.Config
+	reconnectInterval time.Duration
+	tsField           data.Path
+	ioParams          *IOParams
+
+	m       sync.Mutex
+	conn    *websocket.Conn
+	stopped bool
+	stopCh  chan struct{}
+}
+
+func (s *websocketSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for {
+		err := s.receive(ctx, w)
+		if e, ok := err.(*socketReadError); ok {
+			if s.isStopped() {
+				return nil
+			}
+			ctx.ErrLog(e.err).WithField("node_name", s.ioParams.Name).
+				WithField("url", s.config.Location.String()).
+				Warning("The WebSocket connection was closed. Reconnecting to the server")
+		} else if err != nil {
+			return err
+		}
+
+		select {
+		case <-s.stopCh:
+			return nil
+		case <-time.After(s.reconnectInterval):
+		}
+	}
+}
+
+func (s *websocketSource) isStopped() bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.stopped
+}
+
+// receive connects to the server and writes received records until the
+// connection is closed. Errors other than ones returned from the Writer are
+// wrapped in socketReadError.
+func (s *websocketSource) receive(ctx *core.Context, w core.Writer) error {
+	conn, err := websocket.DialConfig(s.config)
+	if err != nil {
+		return &socketReadError{err}
+	}
+	defer conn.Close()
+
+	s.m.Lock()
+	if s.stopped {
+		s.m.Unlock()
+		return nil
+	}
+	s.conn = conn
+	s.m.Unlock()
+	defer func() {
+		s.m.Lock()
+		defer s.m.Unlock()
+		s.conn = nil
+	}()
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			return &socketReadError{err}
+		}
+		ms, err := DecodePushedRecords(bytes.NewReader(msg))
+		if err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("body", string(msg)).
+				Warning("Ignoring the message due to a parse error")
+			continue
+		}
+
+		for _, m := range ms {
+			t := core.NewTuple(m)
+			if s.tsField != nil {
+				if v, err := t.Data.Get(s.tsField); err == nil {
+					if ts, err := data.ToTimestamp(v); err != nil {
+						ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+							WithField("timestamp_field", s.tsField).
+							WithField("timestamp_field_value", v).
+							Warning("Cannot convert a value in timestamp_field to a timestamp")
+					} else {
+						t.Timestamp = ts
+					}
+				}
+			}
+			if err := w.Write(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *websocketSource) Stop(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	close(s.stopCh)
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
+
+func createWebSocketSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		URL               string `bql:"url,required"`
+		Origin            string
+		Headers           map[string]string
+		ReconnectInterval time.Duration
+		Timeout           time.Duration
+		TimestampField    string
+	}{
+		ReconnectInterval: time.Second,
+		Timeout:           10 * time.Second,
+	}
+	if err := data.NewDecoder(nil).Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(v.URL)
+	if err != nil {
+		return nil, fmt.Errorf("'url' parameter has an invalid URL: %v", err)
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, fmt.Errorf("'url' parameter must be a ws or wss URL: %v", v.URL)
+	}
+	if v.Origin == "" {
+		// The origin is required by the WebSocket handshake. The server's
+		// own origin is used by default.
+		o := *u
+		o.Scheme = "http"
+		if u.Scheme == "wss" {
+			o.Scheme = "https"
+		}
+		o.Path, o.RawQuery = "/", ""
+		v.Origin = o.String()
+	}
+	if v.ReconnectInterval <= 0 {
+		return nil, fmt.Errorf("'reconnect_interval' parameter must be positive: %v", v.ReconnectInterval)
+	}
+	if v.Timeout <= 0 {
+		return nil, fmt.Errorf("'timeout' parameter must be positive: %v", v.Timeout)
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+		}
+	}
+
+	config, err := websocket.NewConfig(v.URL, v.Origin)
+	if err != nil {
+		return nil, err
+	}
+	for k, h := range v.Headers {
+		config.Header.Set(k, h)
+	}
+	config.Dialer = &net.Dialer{Timeout: v.Timeout}
+
+	return core.ImplementSourceStop(&websocketSource{
+		config:            config,
+		reconnectInterval: v.ReconnectInterval,
+		tsField:           tsField,
+		ioParams:          ioParams,
+		stopCh:            make(chan struct{}),
+	}), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("websocket", SourceCreatorFunc(createWebSocketSource))
+}
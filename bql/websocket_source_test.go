@@ -0,0 +1,95 @@
+package bql
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/websocket"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestWebSocketSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a WebSocket server", t, func() {
+		var m sync.Mutex
+		numConns := 0
+		server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+			m.Lock()
+			numConns++
+			n := numConns
+			m.Unlock()
+
+			if n == 1 {
+				// The first connection is closed after sending messages.
+				for _, msg := range []string{`{"i":1}`, `broken`, `[{"i":2},{"i":3}]`, "{\"i\":4}\n{\"i\":5}\n"} {
+					websocket.Message.Send(conn, msg)
+				}
+				return
+			}
+			websocket.Message.Send(conn, `{"i":6}`)
+			var msg string
+			websocket.Message.Receive(conn, &msg) // wait until the client closes
+		}))
+		Reset(server.Close)
+
+		params := data.Map{
+			"url":                data.String("ws" + strings.TrimPrefix(server.URL, "http")),
+			"reconnect_interval": data.Float(0.01),
+		}
+		c := &tupleCollector{}
+
+		Convey("When the source runs", func() {
+			s, err := createWebSocketSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, c)
+			}()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			Convey("Then it should emit received records and reconnect", func() {
+				So(c.ints(6), ShouldResemble, []int64{1, 2, 3, 4, 5, 6})
+			})
+
+			Convey("Then it should stop while it's connected", func() {
+				So(c.ints(6), ShouldHaveLength, 6)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+
+		Convey("When creating the source with invalid parameters", func() {
+			cases := []struct {
+				title string
+				key   string
+				value data.Value
+			}{
+				{"non-ws url", "url", data.String("http://localhost/")},
+				{"non-positive reconnect_interval", "reconnect_interval", data.Int(0)},
+				{"non-positive timeout", "timeout", data.Int(0)},
+				{"invalid timestamp_field", "timestamp_field", data.String("/this/isnt/a/xpath")},
+			}
+			for _, c := range cases {
+				c := c
+				Convey("Then "+c.title+" should result in an error", func() {
+					params[c.key] = c.value
+					_, err := createWebSocketSource(ctx, &IOParams{}, params)
+					So(err, ShouldNotBeNil)
+				})
+			}
+
+			Convey("Then missing url should result in an error", func() {
+				delete(params, "url")
+				_, err := createWebSocketSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
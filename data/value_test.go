@@ -1,10 +1,12 @@
 package data
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/ugorji/go/codec"
+	"io"
 	"math"
 	"testing"
 	"time"
@@ -210,6 +212,35 @@ func TestMarshalMsgpack(t *testing.T) {
 	})
 }
 
+func TestMsgpackDecoder(t *testing.T) {
+	Convey("Given a stream of msgpack serialized Maps", t, func() {
+		buf := bytes.NewBuffer(nil)
+		for _, m := range []Map{{"a": Int(1)}, {"b": String("x")}} {
+			b, err := MarshalMsgpack(m)
+			So(err, ShouldBeNil)
+			buf.Write(b)
+		}
+
+		Convey("When decoding it", func() {
+			dec := NewMsgpackDecoder(buf)
+
+			Convey("Then it should return Maps in order", func() {
+				m, err := dec.Decode()
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, Map{"a": Int(1)})
+				m, err = dec.Decode()
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, Map{"b": String("x")})
+
+				Convey("And it should return io.EOF at the end", func() {
+					_, err := dec.Decode()
+					So(err, ShouldEqual, io.EOF)
+				})
+			})
+		})
+	})
+}
+
 func TestValue(t *testing.T) {
 	var testData = Map{
 		"bool":   Bool(true),
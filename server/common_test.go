@@ -0,0 +1,160 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// do sends a request to the API server and decodes the response as JSON.
+func do(s *testutil.Server, method, path string, body io.Reader) (*http.Response, map[string]interface{}, error) {
+	req, err := http.NewRequest(method, s.URL()+"/api/v1"+path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := s.HTTPClient().Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	var js map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&js); err != nil {
+		return nil, nil, err
+	}
+	return res, js, nil
+}
+
+// doJSON sends a request having a JSON body to the API server.
+func doJSON(s *testutil.Server, method, path string, body interface{}) (*http.Response, map[string]interface{}, error) {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+	return do(s, method, path, r)
+}
+
+// setUpTopology creates a topology and issues BQL statements to it.
+func setUpTopology(s *testutil.Server, name string, queries string) error {
+	res, js, err := doJSON(s, "POST", "/topologies", map[string]interface{}{
+		"name": name,
+	})
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot create the topology: %v", js)
+	}
+
+	return issueQueries(s, name, queries)
+}
+
+// issueQueries issues BQL statements to a topology.
+func issueQueries(s *testutil.Server, topology string, queries string) error {
+	res, js, err := doJSON(s, "POST", "/topologies/"+topology+"/queries", map[string]interface{}{
+		"queries": queries,
+	})
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot issue the statements: %v", js)
+	}
+	return nil
+}
+
+// waitPushable waits until the source accepts pushed tuples or one second
+// passes. Because a source starts running asynchronously, tuples cannot be
+// pushed right after it's created. The pushed tuples are empty.
+func waitPushable(s *testutil.Server, topology string, source string) bool {
+	deadline := time.Now().Add(time.Second)
+	for {
+		res, _, err := do(s, "POST", "/topologies/"+topology+"/sources/"+source+"/tuples",
+			strings.NewReader("{}"))
+		if err == nil && res.StatusCode == http.StatusOK {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// collectorSink collects tuples written to it. Sinks created by BQL can be
+// obtained by collector.
+type collectorSink struct {
+	m  sync.Mutex
+	ts []*core.Tuple
+}
+
+func (c *collectorSink) Write(ctx *core.Context, t *core.Tuple) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.ts = append(c.ts, t)
+	return nil
+}
+
+func (c *collectorSink) Close(ctx *core.Context) error {
+	return nil
+}
+
+// ints returns values of the field "i" of collected tuples after waiting
+// until the sink has n tuples or one second passes.
+func (c *collectorSink) ints(n int) []int64 {
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.m.Lock()
+		l := len(c.ts)
+		c.m.Unlock()
+		if l >= n || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	res := []int64{}
+	for _, t := range c.ts {
+		i, _ := data.AsInt(t.Data["i"])
+		res = append(res, i)
+	}
+	return res
+}
+
+var (
+	collectorsM sync.Mutex
+	collectors  = map[string]*collectorSink{}
+)
+
+// collector returns the collector sink created last with the name.
+func collector(name string) *collectorSink {
+	collectorsM.Lock()
+	defer collectorsM.Unlock()
+	return collectors[name]
+}
+
+func createCollectorSink(ctx *core.Context, ioParams *bql.IOParams, params data.Map) (core.Sink, error) {
+	c := &collectorSink{}
+	collectorsM.Lock()
+	defer collectorsM.Unlock()
+	collectors[ioParams.Name] = c
+	return c, nil
+}
+
+func init() {
+	bql.MustRegisterGlobalSinkCreator("server_test_collector", bql.SinkCreatorFunc(createCollectorSink))
+}
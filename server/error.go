@@ -25,4 +25,12 @@ const (
 	// nonWebSocketRequestErrorCode is returned when a requested action only
 	// supports WebSocket and a request is a regular HTTP request.
 	nonWebSocketRequestErrorCode = "E0008"
+
+	// nonTuplePusherErrorCode is returned when tuples are pushed to a source
+	// which doesn't accept them.
+	nonTuplePusherErrorCode = "E0009"
+
+	// nonWebSocketStreamerErrorCode is returned when a client requests a
+	// WebSocket stream of a sink which doesn't stream tuples.
+	nonWebSocketStreamerErrorCode = "E0010"
 )
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"fmt"
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"net/http"
+	"strings"
 )
 
 type sinks struct {
@@ -18,6 +21,7 @@ func setUpSinksRouter(prefix string, router *web.Router) {
 	root.Middleware((*sinks).fetchSink)
 	root.Get("/", (*sinks).Index)
 	root.Get("/:sinkName", (*sinks).Show)
+	root.Get("/:sinkName/wsstream", (*sinks).WebSocketStream)
 }
 
 func (sc *sinks) fetchSink(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +67,30 @@ func (sc *sinks) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
+// WebSocketStream streams tuples written to the sink through a WebSocket
+// connection. The sink must implement bql.WebSocketStreamer.
+func (sc *sinks) WebSocketStream(rw web.ResponseWriter, req *web.Request) {
+	if !strings.EqualFold(req.Header.Get("Upgrade"), "WebSocket") {
+		err := fmt.Errorf("the request isn't a WebSocket request")
+		sc.Log().Error(err)
+		sc.RenderError(jasco.NewError(nonWebSocketRequestErrorCode, "This action only accepts WebSocket connections",
+			http.StatusBadRequest, err))
+		return
+	}
+
+	s, ok := sc.sink.Sink().(bql.WebSocketStreamer)
+	if !ok {
+		err := fmt.Errorf("the sink doesn't stream tuples")
+		sc.ErrLog(err).Error("Cannot stream tuples from the sink")
+		sc.RenderError(jasco.NewError(nonWebSocketStreamerErrorCode,
+			"The sink doesn't stream tuples", http.StatusBadRequest, err))
+		return
+	}
+
+	sc.Log().Info("Begin WebSocket stream")
+	defer sc.Log().Info("End WebSocket stream")
+	s.ServeWebSocket(rw, req.Request)
+}
+
 // TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
 // done by queries.
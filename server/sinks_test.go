@@ -0,0 +1,198 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/websocket"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sinkStatus returns the value at the path in the status of a sink.
+func sinkStatus(s *testutil.Server, topology, sink, path string) interface{} {
+	_, js, err := do(s, "GET", "/topologies/"+topology+"/sinks/"+sink, nil)
+	if err != nil {
+		return nil
+	}
+	return testutil.JScan(js, "/sink/status"+path)
+}
+
+// waitSinkStatus waits until the number at the path in the status of a sink
+// becomes at least min or five seconds pass.
+func waitSinkStatus(s *testutil.Server, topology, sink, path string, min float64) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if v, ok := sinkStatus(s, topology, sink, path).(float64); ok && v >= min {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// receiveInts receives tuples from a WebSocket connection and returns values
+// of the field "i". It returns when the connection is closed or no tuple
+// arrives for a second.
+func receiveInts(conn *websocket.Conn) ([]int, error) {
+	res := []int{}
+	for {
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		var msg string
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			return res, err
+		}
+		var t struct {
+			I int `json:"i"`
+		}
+		if err := json.Unmarshal([]byte(msg), &t); err != nil {
+			return res, err
+		}
+		res = append(res, t.I)
+	}
+}
+
+func isTimeout(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
+
+func TestWebSocketStream(t *testing.T) {
+	// WebSocket requires a real connection.
+	orig := testutil.TestAPIWithRealHTTPServer
+	testutil.TestAPIWithRealHTTPServer = true
+	s := testutil.NewServer()
+	testutil.TestAPIWithRealHTTPServer = orig
+	defer s.Close()
+
+	Convey("Given an API server with a topology having an http source", t, func() {
+		So(setUpTopology(s, "test_topology", `CREATE SOURCE src TYPE http;`), ShouldBeNil)
+		Reset(func() {
+			doJSON(s, "DELETE", "/topologies/test_topology", nil)
+		})
+		So(waitPushable(s, "test_topology", "src"), ShouldBeTrue)
+
+		// connect creates a websocket sink having the parameters and connects
+		// to it.
+		connect := func(params string) *websocket.Conn {
+			So(issueQueries(s, "test_topology", fmt.Sprintf(`
+				CREATE SINK ws TYPE websocket %v;
+				INSERT INTO ws FROM src;
+			`, params)), ShouldBeNil)
+			url := "ws" + strings.TrimPrefix(s.URL(), "http") +
+				"/api/v1/topologies/test_topology/sinks/ws/wsstream"
+			conn, err := websocket.Dial(url, "", "http://localhost/")
+			So(err, ShouldBeNil)
+			Reset(func() {
+				conn.Close()
+			})
+			So(waitSinkStatus(s, "test_topology", "ws", "/sink/num_clients", 1), ShouldBeTrue)
+			return conn
+		}
+
+		// pushMany pushes n large tuples so that they don't fit in the socket
+		// buffers of a client which doesn't read them, and waits until the
+		// sink receives all of them.
+		pushMany := func(n int) {
+			p := strings.Repeat("x", 100*1024)
+			for i := 0; i < n; i += 20 {
+				b := bytes.NewBuffer(nil)
+				for j := i; j < i+20 && j < n; j++ {
+					fmt.Fprintf(b, "{\"i\":%v,\"p\":%q}\n", j, p)
+				}
+				res, _, err := do(s, "POST", "/topologies/test_topology/sources/src/tuples", b)
+				So(err, ShouldBeNil)
+				So(res.StatusCode, ShouldEqual, http.StatusOK)
+			}
+			So(waitSinkStatus(s, "test_topology", "ws", "/input_stats/num_received_total", float64(n)), ShouldBeTrue)
+			// The last tuple can still be being written after it's received.
+			time.Sleep(100 * time.Millisecond)
+		}
+		const n = 400
+
+		Convey("When a client connects to the sink", func() {
+			conn := connect("")
+			res, _, err := do(s, "POST", "/topologies/test_topology/sources/src/tuples",
+				strings.NewReader("{\"i\":1}\n{\"i\":2}\n{\"i\":3}\n"))
+			So(err, ShouldBeNil)
+			So(res.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then it should receive tuples", func() {
+				is, err := receiveInts(conn)
+				So(isTimeout(err), ShouldBeTrue)
+				So(is, ShouldResemble, []int{1, 2, 3})
+			})
+		})
+
+		Convey("When a slow client connects to the sink dropping the oldest tuples", func() {
+			conn := connect(`WITH buffer_size=4, drop_policy="oldest"`)
+			pushMany(n)
+
+			Convey("Then it should receive the latest tuples", func() {
+				So(sinkStatus(s, "test_topology", "ws", "/sink/num_dropped"), ShouldBeGreaterThan, 0.0)
+				is, err := receiveInts(conn)
+				So(isTimeout(err), ShouldBeTrue)
+				So(is, ShouldNotBeEmpty)
+				So(len(is), ShouldBeLessThan, n)
+				So(is[len(is)-1], ShouldEqual, n-1)
+			})
+		})
+
+		Convey("When a slow client connects to the sink dropping the newest tuples", func() {
+			conn := connect(`WITH buffer_size=4, drop_policy="newest"`)
+			pushMany(n)
+
+			Convey("Then it should receive the earliest tuples", func() {
+				So(sinkStatus(s, "test_topology", "ws", "/sink/num_dropped"), ShouldBeGreaterThan, 0.0)
+				is, err := receiveInts(conn)
+				So(isTimeout(err), ShouldBeTrue)
+				So(is, ShouldNotBeEmpty)
+				So(len(is), ShouldBeLessThan, n)
+				So(is[0], ShouldEqual, 0)
+				So(is[len(is)-1], ShouldBeLessThan, n-1)
+			})
+		})
+
+		Convey("When a slow client connects to the sink disconnecting slow clients", func() {
+			conn := connect(`WITH buffer_size=4, drop_policy="disconnect"`)
+			pushMany(n)
+
+			Convey("Then it should be disconnected", func() {
+				So(sinkStatus(s, "test_topology", "ws", "/sink/num_clients"), ShouldEqual, 0.0)
+				is, err := receiveInts(conn)
+				So(err, ShouldNotBeNil)
+				So(isTimeout(err), ShouldBeFalse)
+				So(len(is), ShouldBeLessThan, n)
+			})
+		})
+
+		Convey("When a client requests the stream without WebSocket", func() {
+			So(issueQueries(s, "test_topology", `CREATE SINK ws TYPE websocket;`), ShouldBeNil)
+			res, js, err := do(s, "GET", "/topologies/test_topology/sinks/ws/wsstream", nil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldBeNil)
+				So(res.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(js["error"], ShouldNotBeNil)
+			})
+		})
+
+		Convey("When a client requests the stream of a sink which doesn't stream tuples", func() {
+			So(issueQueries(s, "test_topology", `CREATE SINK snk TYPE server_test_collector;`), ShouldBeNil)
+			url := "ws" + strings.TrimPrefix(s.URL(), "http") +
+				"/api/v1/topologies/test_topology/sinks/snk/wsstream"
+			_, err := websocket.Dial(url, "", "http://localhost/")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"fmt"
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"net/http"
@@ -18,6 +20,7 @@ func setUpSourcesRouter(prefix string, router *web.Router) {
 	root.Middleware((*sources).fetchSource)
 	root.Get("/", (*sources).Index)
 	root.Get("/:sourceName", (*sources).Show)
+	root.Post("/:sourceName/tuples", (*sources).PushTuples)
 }
 
 func (sc *sources) fetchSource(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +66,45 @@ func (sc *sources) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
+// PushTuples emits records in the request body from the source. The source
+// must implement bql.TuplePusher. The body can be a JSON object, a JSON array
+// of objects, or JSON Lines, and must not be larger than
+// bql.MaxPushedRecordsSize bytes.
+func (sc *sources) PushTuples(rw web.ResponseWriter, req *web.Request) {
+	p, ok := sc.src.Source().(bql.TuplePusher)
+	if !ok {
+		err := fmt.Errorf("the source doesn't accept tuples")
+		sc.ErrLog(err).Error("Cannot push tuples to the source")
+		sc.RenderError(jasco.NewError(nonTuplePusherErrorCode,
+			"The source doesn't accept tuples", http.StatusBadRequest, err))
+		return
+	}
+
+	ms, err := bql.DecodePushedRecords(req.Body)
+	if err != nil {
+		sc.ErrLog(err).Error("Cannot parse the request body")
+		status := http.StatusBadRequest
+		if err == bql.ErrPushedRecordsTooLarge {
+			status = http.StatusRequestEntityTooLarge
+		}
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			status, err)
+		e.Meta["body"] = []string{err.Error()}
+		sc.RenderError(e)
+		return
+	}
+
+	if err := p.Push(sc.topology.Topology().Context(), ms); err != nil {
+		sc.ErrLog(err).Error("Cannot push tuples to the source")
+		sc.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+	sc.Render(map[string]interface{}{
+		"topology": sc.topologyName,
+		"source":   sc.src.Name(),
+		"count":    len(ms),
+	})
+}
+
 // TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
 // done by queries.
@@ -0,0 +1,104 @@
+package server_test
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPushTuples(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+
+	Convey("Given an API server with a topology having an http source", t, func() {
+		So(setUpTopology(s, "test_topology", `
+			CREATE SOURCE src TYPE http;
+			CREATE SOURCE dt TYPE dropped_tuples;
+		`), ShouldBeNil)
+		Reset(func() {
+			doJSON(s, "DELETE", "/topologies/test_topology", nil)
+		})
+		So(waitPushable(s, "test_topology", "src"), ShouldBeTrue)
+		So(issueQueries(s, "test_topology", `
+			CREATE SINK snk TYPE server_test_collector;
+			INSERT INTO snk FROM src;
+		`), ShouldBeNil)
+		snk := collector("snk")
+		So(snk, ShouldNotBeNil)
+
+		push := func(src string, body string) (*http.Response, map[string]interface{}) {
+			res, js, err := do(s, "POST", "/topologies/test_topology/sources/"+src+"/tuples",
+				strings.NewReader(body))
+			So(err, ShouldBeNil)
+			return res, js
+		}
+
+		Convey("When pushing records in JSON Lines", func() {
+			res, js := push("src", "{\"i\":1}\n{\"i\":2}\n")
+
+			Convey("Then they should be emitted from the source", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusOK)
+				So(js["count"], ShouldEqual, 2.0)
+				So(snk.ints(2), ShouldResemble, []int64{1, 2})
+			})
+		})
+
+		Convey("When pushing records in a JSON array", func() {
+			res, js := push("src", `[{"i":1},{"i":2},{"i":3}]`)
+
+			Convey("Then they should be emitted from the source", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusOK)
+				So(js["count"], ShouldEqual, 3.0)
+				So(snk.ints(3), ShouldResemble, []int64{1, 2, 3})
+			})
+		})
+
+		Convey("When pushing a body larger than the limit", func() {
+			res, _ := push("src", `{"i":1,"p":"`+strings.Repeat("x", bql.MaxPushedRecordsSize)+`"}`)
+
+			Convey("Then it should fail with 413", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusRequestEntityTooLarge)
+				So(snk.ints(1), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When pushing a malformed record", func() {
+			res, js := push("src", "{\"i\":1}\n{\"i\":\n")
+
+			Convey("Then it should fail without emitting any tuple", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(js["error"], ShouldNotBeNil)
+				So(snk.ints(1), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When pushing a record which isn't an object", func() {
+			res, _ := push("src", `[{"i":1},2]`)
+
+			Convey("Then it should fail without emitting any tuple", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(snk.ints(1), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When pushing records to a nonexistent source", func() {
+			res, _ := push("no_such_source", `{"i":1}`)
+
+			Convey("Then it should fail with 404", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When pushing records to a source which isn't an http source", func() {
+			res, js := push("dt", `{"i":1}`)
+
+			Convey("Then it should fail with 400", func() {
+				So(res.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(js["error"], ShouldNotBeNil)
+			})
+		})
+	})
+}